@@ -56,6 +56,7 @@ val services = mapOf(
     "deploy" to ServiceSpec("CodeDeploy"),
     "detective" to ServiceSpec("Detective"),
     "devicefarm" to ServiceSpec("Device Farm"),
+    "devopsguru" to ServiceSpec("DevOps Guru"),
     "directconnect" to ServiceSpec("Direct Connect", vpcLock = true),
     "dlm" to ServiceSpec("DLM (Data Lifecycle Manager)"),
     "dms" to ServiceSpec("DMS (Database Migration)", vpcLock = true),
@@ -90,6 +91,8 @@ val services = mapOf(
     "grafana" to ServiceSpec("Managed Grafana"),
     "greengrass" to ServiceSpec("IoT Greengrass"),
     "guardduty" to ServiceSpec("GuardDuty"),
+    "health" to ServiceSpec("Health"),
+    "healthlake" to ServiceSpec("HealthLake"),
     "iam" to ServiceSpec("IAM (Identity & Access Management)"),
     "identitystore" to ServiceSpec("SSO Identity Store"),
     "imagebuilder" to ServiceSpec("EC2 Image Builder"),
@@ -113,8 +116,10 @@ val services = mapOf(
     "lightsail" to ServiceSpec("Lightsail"),
     "location" to ServiceSpec("Location"),
     "logs" to ServiceSpec("CloudWatch Logs"),
+    "m2" to ServiceSpec("Mainframe Modernization"),
     "macie" to ServiceSpec("Macie Classic"),
     "macie2" to ServiceSpec("Macie"),
+    "managedblockchain" to ServiceSpec("Managed Blockchain"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
     "medialive" to ServiceSpec("Elemental MediaLive"),
@@ -126,12 +131,14 @@ val services = mapOf(
     "neptune" to ServiceSpec("Neptune"),
     "networkfirewall" to ServiceSpec("Network Firewall", vpcLock = true),
     "networkmanager" to ServiceSpec("Network Manager"),
+    "oam" to ServiceSpec("CloudWatch Observability Access Manager"),
     "opensearch" to ServiceSpec("OpenSearch"),
     "opensearchserverless" to ServiceSpec("OpenSearch Serverless"),
     "opsworks" to ServiceSpec("OpsWorks", vpcLock = true),
     "organizations" to ServiceSpec("Organizations"),
     "outposts" to ServiceSpec("Outposts"),
     "pinpoint" to ServiceSpec("Pinpoint"),
+    "pinpointsmsvoicev2" to ServiceSpec("End User Messaging SMS"),
     "pipes" to ServiceSpec("EventBridge Pipes"),
     "pricing" to ServiceSpec("Pricing Calculator"),
     "qldb" to ServiceSpec("QLDB (Quantum Ledger Database)"),
@@ -141,6 +148,7 @@ val services = mapOf(
     "redshift" to ServiceSpec("Redshift", vpcLock = true),
     "redshiftdata" to ServiceSpec("Redshift Data"),
     "redshiftserverless" to ServiceSpec("Redshift Serverless"),
+    "resiliencehub" to ServiceSpec("Resilience Hub"),
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
@@ -159,6 +167,7 @@ val services = mapOf(
     "schemas" to ServiceSpec("EventBridge Schemas"),
     "secretsmanager" to ServiceSpec("Secrets Manager"),
     "securityhub" to ServiceSpec("Security Hub"),
+    "securitylake" to ServiceSpec("Security Lake"),
     "serverlessrepo" to ServiceSpec("Serverless Application Repository"),
     "servicecatalog" to ServiceSpec("Service Catalog"),
     "servicediscovery" to ServiceSpec("Cloud Map", vpcLock = true),
@@ -169,12 +178,15 @@ val services = mapOf(
     "shield" to ServiceSpec("Shield"),
     "signer" to ServiceSpec("Signer"),
     "simpledb" to ServiceSpec("SDB (SimpleDB)"),
+    "snowball" to ServiceSpec("Snow Family"),
     "sns" to ServiceSpec("SNS (Simple Notification)"),
     "sqs" to ServiceSpec("SQS (Simple Queue)"),
     "ssm" to ServiceSpec("SSM (Systems Manager)", vpcLock = true),
     "ssoadmin" to ServiceSpec("SSO Admin"),
     "storagegateway" to ServiceSpec("Storage Gateway", vpcLock = true),
     "sts" to ServiceSpec("STS (Security Token)"),
+    "support" to ServiceSpec("Support"),
+    "supportapp" to ServiceSpec("Support App"),
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
     "timestreamwrite" to ServiceSpec("Timestream Write"),
@@ -185,5 +197,6 @@ val services = mapOf(
     "wafv2" to ServiceSpec("WAF"),
     "worklink" to ServiceSpec("WorkLink"),
     "workspaces" to ServiceSpec("WorkSpaces", vpcLock = true),
+    "workspacesweb" to ServiceSpec("WorkSpaces Web"),
     "xray" to ServiceSpec("X-Ray"),
 )
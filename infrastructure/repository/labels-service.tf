@@ -180,6 +180,7 @@ variable "service_labels" {
     "lookoutequipment",
     "lookoutmetrics",
     "lookoutvision",
+    "m2",
     "machinelearning",
     "macie",
     "macie2",
@@ -211,6 +212,7 @@ variable "service_labels" {
     "networkfirewall",
     "networkmanager",
     "nimble",
+    "oam",
     "opensearch",
     "opensearchserverless",
     "opsworks",
@@ -225,6 +227,7 @@ variable "service_labels" {
     "pinpoint",
     "pinpointemail",
     "pinpointsmsvoice",
+    "pinpointsmsvoicev2",
     "pipes",
     "polly",
     "pricing",
@@ -266,6 +269,7 @@ variable "service_labels" {
     "schemas",
     "secretsmanager",
     "securityhub",
+    "securitylake",
     "serverlessrepo",
     "servicecatalog",
     "servicecatalogappregistry",
@@ -291,6 +295,7 @@ variable "service_labels" {
     "storagegateway",
     "sts",
     "support",
+    "supportapp",
     "swf",
     "synthetics",
     "textract",
@@ -301,6 +306,7 @@ variable "service_labels" {
     "transfer",
     "transitgateway",
     "translate",
+    "verifiedaccess",
     "voiceid",
     "vpc",
     "vpnclient",
@@ -0,0 +1,341 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const (
+	auditLogRedactedValue = "<sensitive>"
+	auditLogOmittedValue  = "<omitted>"
+
+	auditLogPathPIDPlaceholder       = "{pid}"
+	auditLogPathTimestampPlaceholder = "{timestamp}"
+	auditLogPathTimestampFormat      = "20060102T150405Z"
+)
+
+// readOnlyOperationPrefixes are the operation name prefixes of AWS API operations that do not mutate resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Check",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	"Test",
+	"Validate",
+}
+
+// sensitiveFieldNameParts are parts of the names of AWS API input fields that hold secrets.
+// Many such fields, e.g. RDS MasterUserPassword and ElastiCache AuthToken, are not tagged as sensitive by the AWS SDK for Go v1,
+// and AWS SDK for Go v2 API inputs do not tag sensitive fields at all.
+var sensitiveFieldNameParts = []string{
+	"AccessToken",
+	"AuthToken",
+	"Passphrase",
+	"Password",
+	"PrivateKey",
+	"RefreshToken",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"SessionToken",
+}
+
+// sensitiveFieldNames are the names of AWS API input fields that hold secrets, qualified by input type
+// where the same field name does not hold a secret in other inputs.
+var sensitiveFieldNames = map[string]struct{}{
+	"Cak":                         {},
+	"ClientSecret":                {},
+	"ssm.PutParameterInput.Value": {},
+}
+
+// auditLogEntry is a single line of the API call audit log.
+type auditLogEntry struct {
+	Time       string      `json:"time"`
+	Service    string      `json:"service"`
+	Operation  string      `json:"operation"`
+	Region     string      `json:"region,omitempty"`
+	Parameters interface{} `json:"parameters,omitempty"`
+	StatusCode int         `json:"status_code,omitempty"`
+	RequestID  string      `json:"request_id,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// auditLogger appends an entry for every mutating AWS API call to a JSON Lines file.
+type auditLogger struct {
+	path string
+	lock sync.Mutex
+}
+
+// newAuditLogger returns a logger writing to path, with any {pid} and {timestamp} placeholders expanded.
+// The file is created up front so that an unusable path is reported when the provider is configured.
+func newAuditLogger(path string) (*auditLogger, error) {
+	l := &auditLogger{
+		path: expandAuditLogPath(path, os.Getpid(), time.Now()),
+	}
+
+	file, err := l.open()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func expandAuditLogPath(path string, pid int, now time.Time) string {
+	return strings.NewReplacer(
+		auditLogPathPIDPlaceholder, strconv.Itoa(pid),
+		auditLogPathTimestampPlaceholder, now.UTC().Format(auditLogPathTimestampFormat),
+	).Replace(path)
+}
+
+func (l *auditLogger) open() (*os.File, error) {
+	return os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+}
+
+// handler returns an AWS SDK for Go v1 request handler that records completed mutating requests.
+func (l *auditLogger) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.AuditLogHandler",
+		Fn:   l.logRequest,
+	}
+}
+
+// apiOption is an AWS SDK for Go v2 API option that records completed mutating operations.
+// AWS SDK for Go v2 API inputs do not identify sensitive fields, so their parameters are redacted by field name only.
+func (l *auditLogger) apiOption(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("terraform-provider-aws.AuditLogMiddleware", l.handleInitialize), middleware.After)
+}
+
+func (l *auditLogger) logRequest(r *request.Request) {
+	if r.Operation == nil || !isMutatingOperation(r.Operation.Name) {
+		return
+	}
+
+	entry := auditLogEntry{
+		Time:       time.Now().UTC().Format(time.RFC3339Nano),
+		Service:    r.ClientInfo.ServiceID,
+		Operation:  r.Operation.Name,
+		Parameters: redactSensitiveValues(reflect.ValueOf(r.Params)),
+		RequestID:  r.RequestID,
+	}
+
+	if r.Config.Region != nil {
+		entry.Region = *r.Config.Region
+	}
+
+	if r.HTTPResponse != nil {
+		entry.StatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if r.Error != nil {
+		entry.Error = r.Error.Error()
+	}
+
+	if err := l.write(entry); err != nil {
+		log.Printf("[WARN] writing audit log entry for %s %s: %s", entry.Service, entry.Operation, err)
+	}
+}
+
+func (l *auditLogger) handleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	if !isMutatingOperation(awsmiddleware.GetOperationName(ctx)) {
+		return out, metadata, err
+	}
+
+	entry := auditLogEntry{
+		Time:       time.Now().UTC().Format(time.RFC3339Nano),
+		Service:    awsmiddleware.GetServiceID(ctx),
+		Operation:  awsmiddleware.GetOperationName(ctx),
+		Region:     awsmiddleware.GetRegion(ctx),
+		Parameters: redactSensitiveValues(reflect.ValueOf(in.Parameters)),
+	}
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		entry.RequestID = v
+	}
+
+	if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+		entry.StatusCode = v.StatusCode
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+
+		var respErr *awshttp.ResponseError
+
+		if errors.As(err, &respErr) {
+			entry.StatusCode = respErr.HTTPStatusCode()
+			entry.RequestID = respErr.ServiceRequestID()
+		}
+	}
+
+	if err := l.write(entry); err != nil {
+		log.Printf("[WARN] writing audit log entry for %s %s: %s", entry.Service, entry.Operation, err)
+	}
+
+	return out, metadata, err
+}
+
+func (l *auditLogger) write(entry auditLogEntry) error {
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	file, err := l.open()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(append(b, '\n')); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
+
+func isMutatingOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isSensitiveField returns whether the specified field of an AWS API input struct may hold a secret.
+func isSensitiveField(t reflect.Type, f reflect.StructField) bool {
+	if f.Tag.Get("sensitive") == "true" {
+		return true
+	}
+
+	if _, ok := sensitiveFieldNames[f.Name]; ok {
+		return true
+	}
+
+	if _, ok := sensitiveFieldNames[t.String()+"."+f.Name]; ok {
+		return true
+	}
+
+	// Flags, e.g. PasswordResetRequired, do not hold secrets.
+	if ft := f.Type; ft.Kind() == reflect.Bool || (ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Bool) {
+		return false
+	}
+
+	for _, part := range sensitiveFieldNameParts {
+		if strings.Contains(f.Name, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactSensitiveValues returns a JSON-serializable copy of an AWS API input with the values
+// of any fields tagged or named as sensitive, and any binary or streamed payloads, replaced.
+// Unset fields, including AWS SDK for Go v2 API input fields with zero values, are omitted.
+func redactSensitiveValues(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		// Streamed payloads, e.g. io.ReadSeeker request bodies.
+		if _, ok := v.Interface().(io.Reader); ok {
+			return auditLogOmittedValue
+		}
+
+		return redactSensitiveValues(v.Elem())
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return t.UTC().Format(time.RFC3339Nano)
+		}
+
+		m := make(map[string]interface{})
+
+		for i, t := 0, v.Type(); i < t.NumField(); i++ {
+			f := t.Field(i)
+
+			if f.PkgPath != "" {
+				continue
+			}
+
+			fv := v.Field(i)
+
+			if fv.IsZero() {
+				continue
+			}
+
+			if isSensitiveField(t, f) {
+				m[f.Name] = auditLogRedactedValue
+				continue
+			}
+
+			m[f.Name] = redactSensitiveValues(fv)
+		}
+
+		return m
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return auditLogOmittedValue
+		}
+
+		s := make([]interface{}, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			s[i] = redactSensitiveValues(v.Index(i))
+		}
+
+		return s
+	case reflect.Map:
+		m := make(map[string]interface{}, v.Len())
+
+		for iter := v.MapRange(); iter.Next(); {
+			m[fmt.Sprint(iter.Key().Interface())] = redactSensitiveValues(iter.Value())
+		}
+
+		return m
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,314 @@
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestIsMutatingOperation(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Expected bool
+	}{
+		{Name: "CreateBucket", Expected: true},
+		{Name: "DeleteRole", Expected: true},
+		{Name: "PutObject", Expected: true},
+		{Name: "DescribeInstances", Expected: false},
+		{Name: "GetCallerIdentity", Expected: false},
+		{Name: "ListTagsForResource", Expected: false},
+		{Name: "BatchGetItem", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isMutatingOperation(testCase.Name); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandAuditLogPath(t *testing.T) {
+	now := time.Date(2023, time.January, 10, 12, 30, 45, 0, time.FixedZone("test", 3600))
+
+	testCases := []struct {
+		Path     string
+		Expected string
+	}{
+		{Path: "audit.jsonl", Expected: "audit.jsonl"},
+		{Path: "audit-{pid}.jsonl", Expected: "audit-1234.jsonl"},
+		{Path: "logs/{timestamp}-{pid}.jsonl", Expected: "logs/20230110T113045Z-1234.jsonl"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Path, func(t *testing.T) {
+			if got := expandAuditLogPath(testCase.Path, 1234, now); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAuditLoggerAPIOption(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLogger, err := newAuditLogger(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	input := &rds_sdkv2.ModifyDBClusterInput{
+		ApplyImmediately:    true,
+		DBClusterIdentifier: aws_sdkv2.String("test"),
+		MasterUserPassword:  aws_sdkv2.String("hunter2"),
+	}
+
+	for _, operation := range []string{"DescribeDBClusters", "ModifyDBCluster"} {
+		stack := middleware.NewStack(operation, smithyhttp.NewStackRequest)
+
+		if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+			Region:        "us-west-2", //lintignore:AWSAT003
+			ServiceID:     "RDS",
+			OperationName: operation,
+		}, middleware.Before); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := auditLogger.apiOption(stack); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := awsmiddleware.AddRawResponseToMetadata(stack); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+			var metadata middleware.Metadata
+			awsmiddleware.SetRequestIDMetadata(&metadata, "request-id")
+
+			return &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusNoContent}}, metadata, nil
+		}), stack)
+
+		if _, _, err := handler.Handle(context.Background(), input); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer file.Close()
+
+	var entries []auditLogEntry
+
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		var entry auditLogEntry
+
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if got, expected := len(entries), 1; got != expected {
+		t.Fatalf("got %d entries, expected %d", got, expected)
+	}
+
+	entry := entries[0]
+	entry.Time = ""
+	expected := auditLogEntry{
+		Service:   "RDS",
+		Operation: "ModifyDBCluster",
+		Region:    "us-west-2", //lintignore:AWSAT003
+		Parameters: map[string]interface{}{
+			"ApplyImmediately":    true,
+			"DBClusterIdentifier": "test",
+			"MasterUserPassword":  auditLogRedactedValue,
+		},
+		StatusCode: http.StatusNoContent,
+		RequestID:  "request-id",
+	}
+
+	if !reflect.DeepEqual(entry, expected) {
+		t.Errorf("got %#v, expected %#v", entry, expected)
+	}
+}
+
+func TestRedactSensitiveValues(t *testing.T) {
+	input := &iam.CreateLoginProfileInput{
+		Password:              aws.String("hunter2"),
+		PasswordResetRequired: aws.Bool(true),
+		UserName:              aws.String("test"),
+	}
+
+	got := redactSensitiveValues(reflect.ValueOf(input))
+	expected := map[string]interface{}{
+		"Password":              auditLogRedactedValue,
+		"PasswordResetRequired": true,
+		"UserName":              "test",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+}
+
+func TestRedactSensitiveValues_untagged(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name: "rds CreateDBInstance",
+			Input: &rds.CreateDBInstanceInput{
+				DBInstanceClass:      aws.String("db.t3.micro"),
+				DBInstanceIdentifier: aws.String("test"),
+				Engine:               aws.String("mysql"),
+				MasterUserPassword:   aws.String("hunter2"),
+				MasterUsername:       aws.String("admin"),
+			},
+			Expected: map[string]interface{}{
+				"DBInstanceClass":      "db.t3.micro",
+				"DBInstanceIdentifier": "test",
+				"Engine":               "mysql",
+				"MasterUserPassword":   auditLogRedactedValue,
+				"MasterUsername":       "admin",
+			},
+		},
+		{
+			Name: "rds ModifyDBCluster",
+			Input: &rds.ModifyDBClusterInput{
+				ApplyImmediately:    aws.Bool(true),
+				DBClusterIdentifier: aws.String("test"),
+				MasterUserPassword:  aws.String("hunter2"),
+			},
+			Expected: map[string]interface{}{
+				"ApplyImmediately":    true,
+				"DBClusterIdentifier": "test",
+				"MasterUserPassword":  auditLogRedactedValue,
+			},
+		},
+		{
+			Name: "elasticache CreateReplicationGroup",
+			Input: &elasticache.CreateReplicationGroupInput{
+				AuthToken:                   aws.String("hunter2hunter2hunter2"),
+				ReplicationGroupDescription: aws.String("test"),
+				ReplicationGroupId:          aws.String("test"),
+				TransitEncryptionEnabled:    aws.Bool(true),
+			},
+			Expected: map[string]interface{}{
+				"AuthToken":                   auditLogRedactedValue,
+				"ReplicationGroupDescription": "test",
+				"ReplicationGroupId":          "test",
+				"TransitEncryptionEnabled":    true,
+			},
+		},
+		{
+			Name: "elasticache ModifyReplicationGroup",
+			Input: &elasticache.ModifyReplicationGroupInput{
+				AuthToken:               aws.String("hunter2hunter2hunter2"),
+				AuthTokenUpdateStrategy: aws.String(elasticache.AuthTokenUpdateStrategyTypeRotate),
+				ReplicationGroupId:      aws.String("test"),
+			},
+			Expected: map[string]interface{}{
+				"AuthToken":               auditLogRedactedValue,
+				"AuthTokenUpdateStrategy": auditLogRedactedValue,
+				"ReplicationGroupId":      "test",
+			},
+		},
+		{
+			Name: "ssm PutParameter",
+			Input: &ssm.PutParameterInput{
+				Name:  aws.String("/test"),
+				Type:  aws.String(ssm.ParameterTypeSecureString),
+				Value: aws.String("hunter2"),
+			},
+			Expected: map[string]interface{}{
+				"Name":  "/test",
+				"Type":  ssm.ParameterTypeSecureString,
+				"Value": auditLogRedactedValue,
+			},
+		},
+		{
+			Name: "ssm AddTagsToResource",
+			Input: &ssm.AddTagsToResourceInput{
+				ResourceId:   aws.String("/test"),
+				ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+				Tags: []*ssm.Tag{{
+					Key:   aws.String("Name"),
+					Value: aws.String("test"),
+				}},
+			},
+			Expected: map[string]interface{}{
+				"ResourceId":   "/test",
+				"ResourceType": ssm.ResourceTypeForTaggingParameter,
+				"Tags": []interface{}{
+					map[string]interface{}{
+						"Key":   "Name",
+						"Value": "test",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := redactSensitiveValues(reflect.ValueOf(testCase.Input))
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRedactSensitiveValues_body(t *testing.T) {
+	input := &s3.PutObjectInput{
+		Body:   strings.NewReader("secret"),
+		Bucket: aws.String("test"),
+		Key:    aws.String("key"),
+		Metadata: map[string]*string{
+			"owner": aws.String("test"),
+		},
+	}
+
+	got := redactSensitiveValues(reflect.ValueOf(input))
+	expected := map[string]interface{}{
+		"Body":   auditLogOmittedValue,
+		"Bucket": "test",
+		"Key":    "key",
+		"Metadata": map[string]interface{}{
+			"owner": "test",
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+}
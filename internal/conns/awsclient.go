@@ -3,6 +3,9 @@ package conns
 import (
 	"context"
 	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 )
 
 // InitContext creates context.
@@ -23,3 +26,15 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// RegionalSession returns a copy of the provider's AWS SDK for Go v1 session for calls to the specified service in the specified Region.
+// API clients created from the session use any custom endpoint and client-side rate limit configured for the service.
+func (client *AWSClient) RegionalSession(service, region string) *session.Session {
+	config := aws.NewConfig().WithRegion(region)
+
+	if endpoint := client.endpoints[service]; endpoint != "" {
+		config = config.WithEndpoint(endpoint)
+	}
+
+	return client.rateLimitSession(service, client.Session.Copy(config))
+}
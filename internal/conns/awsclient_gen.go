@@ -196,6 +196,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutequipment"
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
+	"github.com/aws/aws-sdk-go/service/m2"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
 	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
@@ -226,6 +227,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/oam"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
@@ -239,6 +241,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/aws/aws-sdk-go/service/pinpointemail"
 	"github.com/aws/aws-sdk-go/service/pinpointsmsvoice"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
 	"github.com/aws/aws-sdk-go/service/polly"
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
@@ -274,6 +277,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/schemas"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/securitylake"
 	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
@@ -296,6 +300,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/support"
+	"github.com/aws/aws-sdk-go/service/supportapp"
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/aws/aws-sdk-go/service/textract"
@@ -334,6 +339,10 @@ type AWSClient struct {
 	Session                   *session.Session
 	TerraformVersion          string
 
+	endpoints    map[string]string
+	rateLimiters map[string]*rateLimiter
+	readCache    readCache
+
 	ec2Client       lazyClient[*ec2_sdkv2.Client]
 	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
 	rdsClient       lazyClient[*rds_sdkv2.Client]
@@ -516,6 +525,7 @@ type AWSClient struct {
 	LookoutEquipmentConn             *lookoutequipment.LookoutEquipment
 	LookoutMetricsConn               *lookoutmetrics.LookoutMetrics
 	LookoutVisionConn                *lookoutforvision.LookoutForVision
+	M2Conn                           *m2.M2
 	MQConn                           *mq.MQ
 	MTurkConn                        *mturk.MTurk
 	MWAAConn                         *mwaa.MWAA
@@ -546,6 +556,7 @@ type AWSClient struct {
 	NetworkFirewallConn              *networkfirewall.NetworkFirewall
 	NetworkManagerConn               *networkmanager.NetworkManager
 	NimbleConn                       *nimblestudio.NimbleStudio
+	ObservabilityAccessManagerConn   *oam.OAM
 	OpenSearchConn                   *opensearchservice.OpenSearchService
 	OpenSearchServerlessClient       *opensearchserverless.Client
 	OpsWorksConn                     *opsworks.OpsWorks
@@ -560,6 +571,7 @@ type AWSClient struct {
 	PinpointConn                     *pinpoint.Pinpoint
 	PinpointEmailConn                *pinpointemail.PinpointEmail
 	PinpointSMSVoiceConn             *pinpointsmsvoice.PinpointSMSVoice
+	PinpointSMSVoiceV2Conn           *pinpointsmsvoicev2.PinpointSMSVoiceV2
 	PipesClient                      *pipes.Client
 	PollyConn                        *polly.Polly
 	PricingConn                      *pricing.Pricing
@@ -615,6 +627,7 @@ type AWSClient struct {
 	SchemasConn                      *schemas.Schemas
 	SecretsManagerConn               *secretsmanager.SecretsManager
 	SecurityHubConn                  *securityhub.SecurityHub
+	SecurityLakeConn                 *securitylake.SecurityLake
 	ServerlessRepoConn               *serverlessapplicationrepository.ServerlessApplicationRepository
 	ServiceCatalogConn               *servicecatalog.ServiceCatalog
 	ServiceCatalogAppRegistryConn    *appregistry.AppRegistry
@@ -627,6 +640,7 @@ type AWSClient struct {
 	SnowballConn                     *snowball.Snowball
 	StorageGatewayConn               *storagegateway.StorageGateway
 	SupportConn                      *support.Support
+	SupportAppConn                   *supportapp.SupportApp
 	SyntheticsConn                   *synthetics.Synthetics
 	TextractConn                     *textract.Textract
 	TimestreamQueryConn              *timestreamquery.TimestreamQuery
@@ -2,6 +2,11 @@ package conns
 
 import (
 	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -77,3 +82,45 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientRegionalSession(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(endpoints.UsWest2RegionID)})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &AWSClient{
+		Session: sess,
+		endpoints: map[string]string{
+			names.ACM: "https://acm.example.com",
+		},
+		rateLimiters: map[string]*rateLimiter{
+			names.ACM: newRateLimiter(RateLimit{RequestsPerSecond: 1}),
+		},
+	}
+
+	regionalSess := client.RegionalSession(names.ACM, endpoints.EuWest1RegionID)
+
+	if got, expected := aws.StringValue(regionalSess.Config.Region), endpoints.EuWest1RegionID; got != expected {
+		t.Errorf("got Region %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(regionalSess.Config.Endpoint), "https://acm.example.com"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+
+	if got, expected := regionalSess.Handlers.Sign.Len(), sess.Handlers.Sign.Len()+1; got != expected {
+		t.Errorf("got %d sign handlers, expected %d", got, expected)
+	}
+
+	regionalSess = client.RegionalSession(names.EC2, endpoints.EuWest1RegionID)
+
+	if got := aws.StringValue(regionalSess.Config.Endpoint); got != "" {
+		t.Errorf("got endpoint %s, expected none", got)
+	}
+
+	if got, expected := regionalSess.Handlers.Sign.Len(), sess.Handlers.Sign.Len(); got != expected {
+		t.Errorf("got %d sign handlers, expected %d", got, expected)
+	}
+}
@@ -0,0 +1,60 @@
+package conns
+
+import (
+	"sync"
+)
+
+// readCache memoizes the results of read-only API calls made by data sources
+// for the lifetime of the provider instance, i.e. a single plan or apply.
+type readCache struct {
+	entries map[string]*readCacheEntry
+	lock    sync.Mutex
+}
+
+type readCacheEntry struct {
+	done  chan struct{}
+	err   error
+	value any
+}
+
+// CachedRead returns the result of calling f, deduplicating calls with the same key.
+// Concurrent calls with the same key wait for the first call to complete.
+// Errors are not cached, so a subsequent call with the same key calls f again.
+// Cached values are shared between callers and must not be modified.
+func CachedRead[T any](client *AWSClient, key string, f func() (T, error)) (T, error) {
+	c := &client.readCache
+
+	c.lock.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]*readCacheEntry)
+	}
+
+	if entry, ok := c.entries[key]; ok {
+		c.lock.Unlock()
+		<-entry.done
+
+		if entry.err != nil {
+			var zero T
+			return zero, entry.err
+		}
+
+		return entry.value.(T), nil
+	}
+
+	entry := &readCacheEntry{done: make(chan struct{})}
+	c.entries[key] = entry
+	c.lock.Unlock()
+
+	value, err := f()
+
+	if err != nil {
+		c.lock.Lock()
+		delete(c.entries, key)
+		c.lock.Unlock()
+	}
+
+	entry.value, entry.err = value, err
+	close(entry.done)
+
+	return value, err
+}
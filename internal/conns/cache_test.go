@@ -0,0 +1,85 @@
+package conns
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCachedRead(t *testing.T) {
+	client := &AWSClient{}
+	var calls int32
+
+	f := func() (string, error) {
+		atomic.AddInt32(&calls, 1)
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			got, err := CachedRead(client, "key", f)
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if expected := "value"; got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got, expected := atomic.LoadInt32(&calls), int32(1); got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+
+	if _, err := CachedRead(client, "other", f); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if got, expected := atomic.LoadInt32(&calls), int32(2); got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+}
+
+func TestCachedRead_error(t *testing.T) {
+	client := &AWSClient{}
+	calls := 0
+
+	f := func() (string, error) {
+		calls++
+
+		if calls == 1 {
+			return "", errors.New("test")
+		}
+
+		return "value", nil
+	}
+
+	if _, err := CachedRead(client, "key", f); err == nil {
+		t.Fatal("expected error")
+	}
+
+	got, err := CachedRead(client, "key", f)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "value"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if expected := 2; calls != expected {
+		t.Errorf("got %d calls, expected %d", calls, expected)
+	}
+}
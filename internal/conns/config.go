@@ -48,6 +48,7 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogPath                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -60,6 +61,7 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	RateLimits                     map[string]RateLimit
 	Region                         string
 	S3UsePathStyle                 bool
 	SecretKey                      string
@@ -181,12 +183,24 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
+	client.endpoints = c.Endpoints
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
+	client.rateLimiters = c.rateLimiters()
+
+	if c.AuditLogPath != "" {
+		auditLogger, err := newAuditLogger(c.AuditLogPath)
+		if err != nil {
+			return nil, diag.Errorf("opening audit log (%s): %s", c.AuditLogPath, err)
+		}
+
+		sess.Handlers.Complete.PushBackNamed(auditLogger.handler())
+		cfg.APIOptions = append(cfg.APIOptions, auditLogger.apiOption)
+	}
 
 	// API clients (generated).
 	c.sdkv1Conns(client, sess)
@@ -202,17 +216,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	if c.STSRegion != "" {
 		stsConfig.Region = aws.String(c.STSRegion)
 	}
-	client.STSConn = sts.New(sess.Copy(stsConfig))
+	client.STSConn = sts.New(client.rateLimitSession(names.STS, sess.Copy(stsConfig)))
 
 	// Services that require multiple client configurations.
 	s3Config := &aws.Config{
 		Endpoint:         aws.String(c.Endpoints[names.S3]),
 		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
 	}
-	client.S3Conn = s3.New(sess.Copy(s3Config))
+	client.S3Conn = s3.New(client.rateLimitSession(names.S3, sess.Copy(s3Config)))
 
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.S3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
+	client.S3ConnURICleaningDisabled = s3.New(client.rateLimitSession(names.S3, sess.Copy(s3Config)))
 
 	// "Global" services that require customizations.
 	globalAcceleratorConfig := &aws.Config{
@@ -250,11 +264,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
-	client.GlobalAcceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.Route53Conn = route53.New(sess.Copy(route53Config))
-	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
-	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
-	client.ShieldConn = shield.New(sess.Copy(shieldConfig))
+	client.GlobalAcceleratorConn = globalaccelerator.New(client.rateLimitSession(names.GlobalAccelerator, sess.Copy(globalAcceleratorConfig)))
+	client.Route53Conn = route53.New(client.rateLimitSession(names.Route53, sess.Copy(route53Config)))
+	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(client.rateLimitSession(names.Route53RecoveryControlConfig, sess.Copy(route53RecoveryControlConfigConfig)))
+	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(client.rateLimitSession(names.Route53RecoveryReadiness, sess.Copy(route53RecoveryReadinessConfig)))
+	client.ShieldConn = shield.New(client.rateLimitSession(names.Shield, sess.Copy(shieldConfig)))
 
 	client.APIGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
 		// Many operations can return an error such as:
@@ -522,7 +536,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
 			o.Region = endpoints.UsEast1RegionID
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.Route53Domains)...)
 	})
 
+	if err := client.checkRateLimits(); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	return client, nil
 }
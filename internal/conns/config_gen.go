@@ -196,6 +196,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutequipment"
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
+	"github.com/aws/aws-sdk-go/service/m2"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
 	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
@@ -226,6 +227,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/oam"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
@@ -239,6 +241,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/aws/aws-sdk-go/service/pinpointemail"
 	"github.com/aws/aws-sdk-go/service/pinpointsmsvoice"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
 	"github.com/aws/aws-sdk-go/service/polly"
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
@@ -270,6 +273,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/schemas"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/securitylake"
 	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
@@ -290,6 +294,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssooidc"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/support"
+	"github.com/aws/aws-sdk-go/service/supportapp"
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/aws/aws-sdk-go/service/textract"
@@ -315,288 +320,293 @@ import (
 
 // sdkv1Conns initializes AWS SDK for Go v1 clients.
 func (c *Config) sdkv1Conns(client *AWSClient, sess *session.Session) {
-	client.ACMConn = acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACM])}))
-	client.ACMPCAConn = acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACMPCA])}))
-	client.AMPConn = prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AMP])}))
-	client.APIGatewayConn = apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGateway])}))
-	client.APIGatewayManagementAPIConn = apigatewaymanagementapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayManagementAPI])}))
-	client.APIGatewayV2Conn = apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayV2])}))
-	client.AccessAnalyzerConn = accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AccessAnalyzer])}))
-	client.AccountConn = account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Account])}))
-	client.AlexaForBusinessConn = alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AlexaForBusiness])}))
-	client.AmplifyConn = amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Amplify])}))
-	client.AmplifyBackendConn = amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyBackend])}))
-	client.AmplifyUIBuilderConn = amplifyuibuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyUIBuilder])}))
-	client.AppAutoScalingConn = applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppAutoScaling])}))
-	client.AppConfigConn = appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfig])}))
-	client.AppConfigDataConn = appconfigdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfigData])}))
-	client.AppFlowConn = appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppFlow])}))
-	client.AppIntegrationsConn = appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppIntegrations])}))
-	client.AppMeshConn = appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppMesh])}))
-	client.AppRunnerConn = apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppRunner])}))
-	client.AppStreamConn = appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppStream])}))
-	client.AppSyncConn = appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppSync])}))
-	client.ApplicationCostProfilerConn = applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationCostProfiler])}))
-	client.ApplicationInsightsConn = applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationInsights])}))
-	client.AthenaConn = athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Athena])}))
-	client.AutoScalingConn = autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScaling])}))
-	client.AutoScalingPlansConn = autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScalingPlans])}))
-	client.BackupConn = backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Backup])}))
-	client.BackupGatewayConn = backupgateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BackupGateway])}))
-	client.BatchConn = batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Batch])}))
-	client.BillingConductorConn = billingconductor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BillingConductor])}))
-	client.BraketConn = braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Braket])}))
-	client.BudgetsConn = budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Budgets])}))
-	client.CEConn = costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CE])}))
-	client.CURConn = costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CUR])}))
-	client.ChimeConn = chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Chime])}))
-	client.ChimeSDKIdentityConn = chimesdkidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKIdentity])}))
-	client.ChimeSDKMeetingsConn = chimesdkmeetings.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMeetings])}))
-	client.ChimeSDKMessagingConn = chimesdkmessaging.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMessaging])}))
-	client.Cloud9Conn = cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Cloud9])}))
-	client.CloudDirectoryConn = clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudDirectory])}))
-	client.CloudFormationConn = cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFormation])}))
-	client.CloudFrontConn = cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFront])}))
-	client.CloudHSMV2Conn = cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudHSMV2])}))
-	client.CloudSearchConn = cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearch])}))
-	client.CloudSearchDomainConn = cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearchDomain])}))
-	client.CloudTrailConn = cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudTrail])}))
-	client.CloudWatchConn = cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudWatch])}))
-	client.CodeArtifactConn = codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeArtifact])}))
-	client.CodeBuildConn = codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeBuild])}))
-	client.CodeCommitConn = codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeCommit])}))
-	client.CodeGuruProfilerConn = codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruProfiler])}))
-	client.CodeGuruReviewerConn = codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruReviewer])}))
-	client.CodePipelineConn = codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodePipeline])}))
-	client.CodeStarConn = codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStar])}))
-	client.CodeStarConnectionsConn = codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarConnections])}))
-	client.CodeStarNotificationsConn = codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarNotifications])}))
-	client.CognitoIDPConn = cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIDP])}))
-	client.CognitoIdentityConn = cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIdentity])}))
-	client.CognitoSyncConn = cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoSync])}))
-	client.ComprehendMedicalConn = comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComprehendMedical])}))
-	client.ConfigServiceConn = configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConfigService])}))
-	client.ConnectConn = connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Connect])}))
-	client.ConnectContactLensConn = connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectContactLens])}))
-	client.ConnectParticipantConn = connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectParticipant])}))
-	client.ControlTowerConn = controltower.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ControlTower])}))
-	client.CustomerProfilesConn = customerprofiles.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CustomerProfiles])}))
-	client.DAXConn = dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DAX])}))
-	client.DLMConn = dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DLM])}))
-	client.DMSConn = databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DMS])}))
-	client.DRSConn = drs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DRS])}))
-	client.DSConn = directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DS])}))
-	client.DataBrewConn = gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataBrew])}))
-	client.DataExchangeConn = dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataExchange])}))
-	client.DataPipelineConn = datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataPipeline])}))
-	client.DataSyncConn = datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataSync])}))
-	client.DeployConn = codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Deploy])}))
-	client.DetectiveConn = detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Detective])}))
-	client.DevOpsGuruConn = devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DevOpsGuru])}))
-	client.DeviceFarmConn = devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DeviceFarm])}))
-	client.DirectConnectConn = directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DirectConnect])}))
-	client.DiscoveryConn = applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Discovery])}))
-	client.DocDBConn = docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DocDB])}))
-	client.DynamoDBConn = dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDB])}))
-	client.DynamoDBStreamsConn = dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDBStreams])}))
-	client.EBSConn = ebs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EBS])}))
-	client.EC2Conn = ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2])}))
-	client.EC2InstanceConnectConn = ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2InstanceConnect])}))
-	client.ECRConn = ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECR])}))
-	client.ECRPublicConn = ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECRPublic])}))
-	client.ECSConn = ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECS])}))
-	client.EFSConn = efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EFS])}))
-	client.EKSConn = eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EKS])}))
-	client.ELBConn = elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELB])}))
-	client.ELBV2Conn = elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELBV2])}))
-	client.EMRConn = emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMR])}))
-	client.EMRContainersConn = emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRContainers])}))
-	client.EMRServerlessConn = emrserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRServerless])}))
-	client.ElastiCacheConn = elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElastiCache])}))
-	client.ElasticBeanstalkConn = elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticBeanstalk])}))
-	client.ElasticInferenceConn = elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticInference])}))
-	client.ElasticTranscoderConn = elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticTranscoder])}))
-	client.ElasticsearchConn = elasticsearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Elasticsearch])}))
-	client.EventsConn = eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Events])}))
-	client.EvidentlyConn = cloudwatchevidently.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Evidently])}))
-	client.FMSConn = fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FMS])}))
-	client.FSxConn = fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FSx])}))
-	client.FinSpaceConn = finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpace])}))
-	client.FinSpaceDataConn = finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpaceData])}))
-	client.FirehoseConn = firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Firehose])}))
-	client.ForecastConn = forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Forecast])}))
-	client.ForecastQueryConn = forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ForecastQuery])}))
-	client.FraudDetectorConn = frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FraudDetector])}))
-	client.GameLiftConn = gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GameLift])}))
-	client.GlacierConn = glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glacier])}))
-	client.GlueConn = glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glue])}))
-	client.GrafanaConn = managedgrafana.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Grafana])}))
-	client.GreengrassConn = greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Greengrass])}))
-	client.GreengrassV2Conn = greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GreengrassV2])}))
-	client.GroundStationConn = groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GroundStation])}))
-	client.GuardDutyConn = guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GuardDuty])}))
-	client.HealthConn = health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Health])}))
-	client.HealthLakeConn = healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.HealthLake])}))
-	client.HoneycodeConn = honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Honeycode])}))
-	client.IAMConn = iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IAM])}))
-	client.IVSConn = ivs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IVS])}))
-	client.ImageBuilderConn = imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ImageBuilder])}))
-	client.InspectorConn = inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector])}))
-	client.IoTConn = iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT])}))
-	client.IoT1ClickDevicesConn = iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickDevices])}))
-	client.IoT1ClickProjectsConn = iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickProjects])}))
-	client.IoTAnalyticsConn = iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTAnalytics])}))
-	client.IoTDataConn = iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTData])}))
-	client.IoTDeviceAdvisorConn = iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTDeviceAdvisor])}))
-	client.IoTEventsConn = iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEvents])}))
-	client.IoTEventsDataConn = ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEventsData])}))
-	client.IoTFleetHubConn = iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTFleetHub])}))
-	client.IoTJobsDataConn = iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTJobsData])}))
-	client.IoTSecureTunnelingConn = iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSecureTunneling])}))
-	client.IoTSiteWiseConn = iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSiteWise])}))
-	client.IoTThingsGraphConn = iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTThingsGraph])}))
-	client.IoTTwinMakerConn = iottwinmaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTTwinMaker])}))
-	client.IoTWirelessConn = iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTWireless])}))
-	client.KMSConn = kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KMS])}))
-	client.KafkaConn = kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kafka])}))
-	client.KafkaConnectConn = kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KafkaConnect])}))
-	client.KeyspacesConn = keyspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Keyspaces])}))
-	client.KinesisConn = kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kinesis])}))
-	client.KinesisAnalyticsConn = kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalytics])}))
-	client.KinesisAnalyticsV2Conn = kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalyticsV2])}))
-	client.KinesisVideoConn = kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideo])}))
-	client.KinesisVideoArchivedMediaConn = kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoArchivedMedia])}))
-	client.KinesisVideoMediaConn = kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoMedia])}))
-	client.KinesisVideoSignalingConn = kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoSignaling])}))
-	client.LakeFormationConn = lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LakeFormation])}))
-	client.LambdaConn = lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lambda])}))
-	client.LexModelsConn = lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModels])}))
-	client.LexModelsV2Conn = lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModelsV2])}))
-	client.LexRuntimeConn = lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntime])}))
-	client.LexRuntimeV2Conn = lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntimeV2])}))
-	client.LicenseManagerConn = licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LicenseManager])}))
-	client.LightsailConn = lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lightsail])}))
-	client.LocationConn = locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Location])}))
-	client.LogsConn = cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Logs])}))
-	client.LookoutEquipmentConn = lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutEquipment])}))
-	client.LookoutMetricsConn = lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutMetrics])}))
-	client.LookoutVisionConn = lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutVision])}))
-	client.MQConn = mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MQ])}))
-	client.MTurkConn = mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MTurk])}))
-	client.MWAAConn = mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MWAA])}))
-	client.MachineLearningConn = machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MachineLearning])}))
-	client.MacieConn = macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie])}))
-	client.Macie2Conn = macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie2])}))
-	client.ManagedBlockchainConn = managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ManagedBlockchain])}))
-	client.MarketplaceCatalogConn = marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCatalog])}))
-	client.MarketplaceCommerceAnalyticsConn = marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCommerceAnalytics])}))
-	client.MarketplaceEntitlementConn = marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceEntitlement])}))
-	client.MarketplaceMeteringConn = marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceMetering])}))
-	client.MediaConnectConn = mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConnect])}))
-	client.MediaConvertConn = mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConvert])}))
-	client.MediaPackageConn = mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackage])}))
-	client.MediaPackageVODConn = mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackageVOD])}))
-	client.MediaStoreConn = mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStore])}))
-	client.MediaStoreDataConn = mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStoreData])}))
-	client.MediaTailorConn = mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaTailor])}))
-	client.MemoryDBConn = memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MemoryDB])}))
-	client.MgHConn = migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MgH])}))
-	client.MgnConn = mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mgn])}))
-	client.MigrationHubConfigConn = migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubConfig])}))
-	client.MigrationHubRefactorSpacesConn = migrationhubrefactorspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubRefactorSpaces])}))
-	client.MigrationHubStrategyConn = migrationhubstrategyrecommendations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubStrategy])}))
-	client.MobileConn = mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mobile])}))
-	client.NeptuneConn = neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Neptune])}))
-	client.NetworkFirewallConn = networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkFirewall])}))
-	client.NetworkManagerConn = networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkManager])}))
-	client.NimbleConn = nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Nimble])}))
-	client.OpenSearchConn = opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearch])}))
-	client.OpsWorksConn = opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorks])}))
-	client.OpsWorksCMConn = opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorksCM])}))
-	client.OrganizationsConn = organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Organizations])}))
-	client.OutpostsConn = outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Outposts])}))
-	client.PIConn = pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PI])}))
-	client.PanoramaConn = panorama.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Panorama])}))
-	client.PersonalizeConn = personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Personalize])}))
-	client.PersonalizeEventsConn = personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeEvents])}))
-	client.PersonalizeRuntimeConn = personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeRuntime])}))
-	client.PinpointConn = pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pinpoint])}))
-	client.PinpointEmailConn = pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointEmail])}))
-	client.PinpointSMSVoiceConn = pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointSMSVoice])}))
-	client.PollyConn = polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Polly])}))
-	client.PricingConn = pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pricing])}))
-	client.ProtonConn = proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Proton])}))
-	client.QLDBConn = qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDB])}))
-	client.QLDBSessionConn = qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDBSession])}))
-	client.QuickSightConn = quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QuickSight])}))
-	client.RAMConn = ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RAM])}))
-	client.RBinConn = recyclebin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RBin])}))
-	client.RDSConn = rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDS])}))
-	client.RDSDataConn = rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDSData])}))
-	client.RUMConn = cloudwatchrum.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RUM])}))
-	client.RedshiftConn = redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Redshift])}))
-	client.RedshiftDataConn = redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftData])}))
-	client.RedshiftServerlessConn = redshiftserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftServerless])}))
-	client.RekognitionConn = rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Rekognition])}))
-	client.ResilienceHubConn = resiliencehub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResilienceHub])}))
-	client.ResourceGroupsConn = resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroups])}))
-	client.ResourceGroupsTaggingAPIConn = resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroupsTaggingAPI])}))
-	client.RoboMakerConn = robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RoboMaker])}))
-	client.Route53RecoveryClusterConn = route53recoverycluster.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53RecoveryCluster])}))
-	client.Route53ResolverConn = route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53Resolver])}))
-	client.S3ControlConn = s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Control])}))
-	client.S3OutpostsConn = s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Outposts])}))
-	client.SESConn = ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SES])}))
-	client.SFNConn = sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SFN])}))
-	client.SMSConn = sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SMS])}))
-	client.SNSConn = sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SNS])}))
-	client.SQSConn = sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SQS])}))
-	client.SSMConn = ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSM])}))
-	client.SSMContactsConn = ssmcontacts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSMContacts])}))
-	client.SSOConn = sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSO])}))
-	client.SSOAdminConn = ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOAdmin])}))
-	client.SSOOIDCConn = ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOOIDC])}))
-	client.SWFConn = swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SWF])}))
-	client.SageMakerConn = sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMaker])}))
-	client.SageMakerA2IRuntimeConn = augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerA2IRuntime])}))
-	client.SageMakerEdgeConn = sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerEdge])}))
-	client.SageMakerFeatureStoreRuntimeConn = sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerFeatureStoreRuntime])}))
-	client.SageMakerRuntimeConn = sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerRuntime])}))
-	client.SavingsPlansConn = savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SavingsPlans])}))
-	client.SchemasConn = schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Schemas])}))
-	client.SecretsManagerConn = secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecretsManager])}))
-	client.SecurityHubConn = securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecurityHub])}))
-	client.ServerlessRepoConn = serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServerlessRepo])}))
-	client.ServiceCatalogConn = servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalog])}))
-	client.ServiceCatalogAppRegistryConn = appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalogAppRegistry])}))
-	client.ServiceDiscoveryConn = servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceDiscovery])}))
-	client.ServiceQuotasConn = servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceQuotas])}))
-	client.SignerConn = signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Signer])}))
-	client.SimpleDBConn = simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SimpleDB])}))
-	client.SnowDeviceManagementConn = snowdevicemanagement.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SnowDeviceManagement])}))
-	client.SnowballConn = snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Snowball])}))
-	client.StorageGatewayConn = storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.StorageGateway])}))
-	client.SupportConn = support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Support])}))
-	client.SyntheticsConn = synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Synthetics])}))
-	client.TextractConn = textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Textract])}))
-	client.TimestreamQueryConn = timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamQuery])}))
-	client.TimestreamWriteConn = timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamWrite])}))
-	client.TranscribeStreamingConn = transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TranscribeStreaming])}))
-	client.TransferConn = transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transfer])}))
-	client.TranslateConn = translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Translate])}))
-	client.VoiceIDConn = voiceid.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.VoiceID])}))
-	client.WAFConn = waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAF])}))
-	client.WAFRegionalConn = wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFRegional])}))
-	client.WAFV2Conn = wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFV2])}))
-	client.WellArchitectedConn = wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WellArchitected])}))
-	client.WisdomConn = connectwisdomservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Wisdom])}))
-	client.WorkDocsConn = workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkDocs])}))
-	client.WorkLinkConn = worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkLink])}))
-	client.WorkMailConn = workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMail])}))
-	client.WorkMailMessageFlowConn = workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMailMessageFlow])}))
-	client.WorkSpacesConn = workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpaces])}))
-	client.WorkSpacesWebConn = workspacesweb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpacesWeb])}))
-	client.XRayConn = xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.XRay])}))
+	client.ACMConn = acm.New(client.rateLimitSession(names.ACM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACM])})))
+	client.ACMPCAConn = acmpca.New(client.rateLimitSession(names.ACMPCA, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACMPCA])})))
+	client.AMPConn = prometheusservice.New(client.rateLimitSession(names.AMP, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AMP])})))
+	client.APIGatewayConn = apigateway.New(client.rateLimitSession(names.APIGateway, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGateway])})))
+	client.APIGatewayManagementAPIConn = apigatewaymanagementapi.New(client.rateLimitSession(names.APIGatewayManagementAPI, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayManagementAPI])})))
+	client.APIGatewayV2Conn = apigatewayv2.New(client.rateLimitSession(names.APIGatewayV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayV2])})))
+	client.AccessAnalyzerConn = accessanalyzer.New(client.rateLimitSession(names.AccessAnalyzer, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AccessAnalyzer])})))
+	client.AccountConn = account.New(client.rateLimitSession(names.Account, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Account])})))
+	client.AlexaForBusinessConn = alexaforbusiness.New(client.rateLimitSession(names.AlexaForBusiness, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AlexaForBusiness])})))
+	client.AmplifyConn = amplify.New(client.rateLimitSession(names.Amplify, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Amplify])})))
+	client.AmplifyBackendConn = amplifybackend.New(client.rateLimitSession(names.AmplifyBackend, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyBackend])})))
+	client.AmplifyUIBuilderConn = amplifyuibuilder.New(client.rateLimitSession(names.AmplifyUIBuilder, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyUIBuilder])})))
+	client.AppAutoScalingConn = applicationautoscaling.New(client.rateLimitSession(names.AppAutoScaling, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppAutoScaling])})))
+	client.AppConfigConn = appconfig.New(client.rateLimitSession(names.AppConfig, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfig])})))
+	client.AppConfigDataConn = appconfigdata.New(client.rateLimitSession(names.AppConfigData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfigData])})))
+	client.AppFlowConn = appflow.New(client.rateLimitSession(names.AppFlow, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppFlow])})))
+	client.AppIntegrationsConn = appintegrationsservice.New(client.rateLimitSession(names.AppIntegrations, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppIntegrations])})))
+	client.AppMeshConn = appmesh.New(client.rateLimitSession(names.AppMesh, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppMesh])})))
+	client.AppRunnerConn = apprunner.New(client.rateLimitSession(names.AppRunner, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppRunner])})))
+	client.AppStreamConn = appstream.New(client.rateLimitSession(names.AppStream, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppStream])})))
+	client.AppSyncConn = appsync.New(client.rateLimitSession(names.AppSync, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppSync])})))
+	client.ApplicationCostProfilerConn = applicationcostprofiler.New(client.rateLimitSession(names.ApplicationCostProfiler, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationCostProfiler])})))
+	client.ApplicationInsightsConn = applicationinsights.New(client.rateLimitSession(names.ApplicationInsights, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationInsights])})))
+	client.AthenaConn = athena.New(client.rateLimitSession(names.Athena, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Athena])})))
+	client.AutoScalingConn = autoscaling.New(client.rateLimitSession(names.AutoScaling, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScaling])})))
+	client.AutoScalingPlansConn = autoscalingplans.New(client.rateLimitSession(names.AutoScalingPlans, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScalingPlans])})))
+	client.BackupConn = backup.New(client.rateLimitSession(names.Backup, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Backup])})))
+	client.BackupGatewayConn = backupgateway.New(client.rateLimitSession(names.BackupGateway, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BackupGateway])})))
+	client.BatchConn = batch.New(client.rateLimitSession(names.Batch, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Batch])})))
+	client.BillingConductorConn = billingconductor.New(client.rateLimitSession(names.BillingConductor, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BillingConductor])})))
+	client.BraketConn = braket.New(client.rateLimitSession(names.Braket, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Braket])})))
+	client.BudgetsConn = budgets.New(client.rateLimitSession(names.Budgets, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Budgets])})))
+	client.CEConn = costexplorer.New(client.rateLimitSession(names.CE, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CE])})))
+	client.CURConn = costandusagereportservice.New(client.rateLimitSession(names.CUR, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CUR])})))
+	client.ChimeConn = chime.New(client.rateLimitSession(names.Chime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Chime])})))
+	client.ChimeSDKIdentityConn = chimesdkidentity.New(client.rateLimitSession(names.ChimeSDKIdentity, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKIdentity])})))
+	client.ChimeSDKMeetingsConn = chimesdkmeetings.New(client.rateLimitSession(names.ChimeSDKMeetings, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMeetings])})))
+	client.ChimeSDKMessagingConn = chimesdkmessaging.New(client.rateLimitSession(names.ChimeSDKMessaging, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMessaging])})))
+	client.Cloud9Conn = cloud9.New(client.rateLimitSession(names.Cloud9, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Cloud9])})))
+	client.CloudDirectoryConn = clouddirectory.New(client.rateLimitSession(names.CloudDirectory, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudDirectory])})))
+	client.CloudFormationConn = cloudformation.New(client.rateLimitSession(names.CloudFormation, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFormation])})))
+	client.CloudFrontConn = cloudfront.New(client.rateLimitSession(names.CloudFront, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFront])})))
+	client.CloudHSMV2Conn = cloudhsmv2.New(client.rateLimitSession(names.CloudHSMV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudHSMV2])})))
+	client.CloudSearchConn = cloudsearch.New(client.rateLimitSession(names.CloudSearch, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearch])})))
+	client.CloudSearchDomainConn = cloudsearchdomain.New(client.rateLimitSession(names.CloudSearchDomain, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearchDomain])})))
+	client.CloudTrailConn = cloudtrail.New(client.rateLimitSession(names.CloudTrail, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudTrail])})))
+	client.CloudWatchConn = cloudwatch.New(client.rateLimitSession(names.CloudWatch, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudWatch])})))
+	client.CodeArtifactConn = codeartifact.New(client.rateLimitSession(names.CodeArtifact, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeArtifact])})))
+	client.CodeBuildConn = codebuild.New(client.rateLimitSession(names.CodeBuild, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeBuild])})))
+	client.CodeCommitConn = codecommit.New(client.rateLimitSession(names.CodeCommit, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeCommit])})))
+	client.CodeGuruProfilerConn = codeguruprofiler.New(client.rateLimitSession(names.CodeGuruProfiler, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruProfiler])})))
+	client.CodeGuruReviewerConn = codegurureviewer.New(client.rateLimitSession(names.CodeGuruReviewer, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruReviewer])})))
+	client.CodePipelineConn = codepipeline.New(client.rateLimitSession(names.CodePipeline, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodePipeline])})))
+	client.CodeStarConn = codestar.New(client.rateLimitSession(names.CodeStar, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStar])})))
+	client.CodeStarConnectionsConn = codestarconnections.New(client.rateLimitSession(names.CodeStarConnections, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarConnections])})))
+	client.CodeStarNotificationsConn = codestarnotifications.New(client.rateLimitSession(names.CodeStarNotifications, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarNotifications])})))
+	client.CognitoIDPConn = cognitoidentityprovider.New(client.rateLimitSession(names.CognitoIDP, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIDP])})))
+	client.CognitoIdentityConn = cognitoidentity.New(client.rateLimitSession(names.CognitoIdentity, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIdentity])})))
+	client.CognitoSyncConn = cognitosync.New(client.rateLimitSession(names.CognitoSync, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoSync])})))
+	client.ComprehendMedicalConn = comprehendmedical.New(client.rateLimitSession(names.ComprehendMedical, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComprehendMedical])})))
+	client.ConfigServiceConn = configservice.New(client.rateLimitSession(names.ConfigService, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConfigService])})))
+	client.ConnectConn = connect.New(client.rateLimitSession(names.Connect, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Connect])})))
+	client.ConnectContactLensConn = connectcontactlens.New(client.rateLimitSession(names.ConnectContactLens, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectContactLens])})))
+	client.ConnectParticipantConn = connectparticipant.New(client.rateLimitSession(names.ConnectParticipant, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectParticipant])})))
+	client.ControlTowerConn = controltower.New(client.rateLimitSession(names.ControlTower, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ControlTower])})))
+	client.CustomerProfilesConn = customerprofiles.New(client.rateLimitSession(names.CustomerProfiles, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CustomerProfiles])})))
+	client.DAXConn = dax.New(client.rateLimitSession(names.DAX, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DAX])})))
+	client.DLMConn = dlm.New(client.rateLimitSession(names.DLM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DLM])})))
+	client.DMSConn = databasemigrationservice.New(client.rateLimitSession(names.DMS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DMS])})))
+	client.DRSConn = drs.New(client.rateLimitSession(names.DRS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DRS])})))
+	client.DSConn = directoryservice.New(client.rateLimitSession(names.DS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DS])})))
+	client.DataBrewConn = gluedatabrew.New(client.rateLimitSession(names.DataBrew, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataBrew])})))
+	client.DataExchangeConn = dataexchange.New(client.rateLimitSession(names.DataExchange, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataExchange])})))
+	client.DataPipelineConn = datapipeline.New(client.rateLimitSession(names.DataPipeline, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataPipeline])})))
+	client.DataSyncConn = datasync.New(client.rateLimitSession(names.DataSync, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataSync])})))
+	client.DeployConn = codedeploy.New(client.rateLimitSession(names.Deploy, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Deploy])})))
+	client.DetectiveConn = detective.New(client.rateLimitSession(names.Detective, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Detective])})))
+	client.DevOpsGuruConn = devopsguru.New(client.rateLimitSession(names.DevOpsGuru, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DevOpsGuru])})))
+	client.DeviceFarmConn = devicefarm.New(client.rateLimitSession(names.DeviceFarm, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DeviceFarm])})))
+	client.DirectConnectConn = directconnect.New(client.rateLimitSession(names.DirectConnect, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DirectConnect])})))
+	client.DiscoveryConn = applicationdiscoveryservice.New(client.rateLimitSession(names.Discovery, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Discovery])})))
+	client.DocDBConn = docdb.New(client.rateLimitSession(names.DocDB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DocDB])})))
+	client.DynamoDBConn = dynamodb.New(client.rateLimitSession(names.DynamoDB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDB])})))
+	client.DynamoDBStreamsConn = dynamodbstreams.New(client.rateLimitSession(names.DynamoDBStreams, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDBStreams])})))
+	client.EBSConn = ebs.New(client.rateLimitSession(names.EBS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EBS])})))
+	client.EC2Conn = ec2.New(client.rateLimitSession(names.EC2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2])})))
+	client.EC2InstanceConnectConn = ec2instanceconnect.New(client.rateLimitSession(names.EC2InstanceConnect, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2InstanceConnect])})))
+	client.ECRConn = ecr.New(client.rateLimitSession(names.ECR, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECR])})))
+	client.ECRPublicConn = ecrpublic.New(client.rateLimitSession(names.ECRPublic, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECRPublic])})))
+	client.ECSConn = ecs.New(client.rateLimitSession(names.ECS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECS])})))
+	client.EFSConn = efs.New(client.rateLimitSession(names.EFS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EFS])})))
+	client.EKSConn = eks.New(client.rateLimitSession(names.EKS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EKS])})))
+	client.ELBConn = elb.New(client.rateLimitSession(names.ELB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELB])})))
+	client.ELBV2Conn = elbv2.New(client.rateLimitSession(names.ELBV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELBV2])})))
+	client.EMRConn = emr.New(client.rateLimitSession(names.EMR, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMR])})))
+	client.EMRContainersConn = emrcontainers.New(client.rateLimitSession(names.EMRContainers, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRContainers])})))
+	client.EMRServerlessConn = emrserverless.New(client.rateLimitSession(names.EMRServerless, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRServerless])})))
+	client.ElastiCacheConn = elasticache.New(client.rateLimitSession(names.ElastiCache, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElastiCache])})))
+	client.ElasticBeanstalkConn = elasticbeanstalk.New(client.rateLimitSession(names.ElasticBeanstalk, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticBeanstalk])})))
+	client.ElasticInferenceConn = elasticinference.New(client.rateLimitSession(names.ElasticInference, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticInference])})))
+	client.ElasticTranscoderConn = elastictranscoder.New(client.rateLimitSession(names.ElasticTranscoder, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticTranscoder])})))
+	client.ElasticsearchConn = elasticsearchservice.New(client.rateLimitSession(names.Elasticsearch, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Elasticsearch])})))
+	client.EventsConn = eventbridge.New(client.rateLimitSession(names.Events, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Events])})))
+	client.EvidentlyConn = cloudwatchevidently.New(client.rateLimitSession(names.Evidently, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Evidently])})))
+	client.FMSConn = fms.New(client.rateLimitSession(names.FMS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FMS])})))
+	client.FSxConn = fsx.New(client.rateLimitSession(names.FSx, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FSx])})))
+	client.FinSpaceConn = finspace.New(client.rateLimitSession(names.FinSpace, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpace])})))
+	client.FinSpaceDataConn = finspacedata.New(client.rateLimitSession(names.FinSpaceData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpaceData])})))
+	client.FirehoseConn = firehose.New(client.rateLimitSession(names.Firehose, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Firehose])})))
+	client.ForecastConn = forecastservice.New(client.rateLimitSession(names.Forecast, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Forecast])})))
+	client.ForecastQueryConn = forecastqueryservice.New(client.rateLimitSession(names.ForecastQuery, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ForecastQuery])})))
+	client.FraudDetectorConn = frauddetector.New(client.rateLimitSession(names.FraudDetector, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FraudDetector])})))
+	client.GameLiftConn = gamelift.New(client.rateLimitSession(names.GameLift, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GameLift])})))
+	client.GlacierConn = glacier.New(client.rateLimitSession(names.Glacier, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glacier])})))
+	client.GlueConn = glue.New(client.rateLimitSession(names.Glue, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glue])})))
+	client.GrafanaConn = managedgrafana.New(client.rateLimitSession(names.Grafana, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Grafana])})))
+	client.GreengrassConn = greengrass.New(client.rateLimitSession(names.Greengrass, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Greengrass])})))
+	client.GreengrassV2Conn = greengrassv2.New(client.rateLimitSession(names.GreengrassV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GreengrassV2])})))
+	client.GroundStationConn = groundstation.New(client.rateLimitSession(names.GroundStation, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GroundStation])})))
+	client.GuardDutyConn = guardduty.New(client.rateLimitSession(names.GuardDuty, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GuardDuty])})))
+	client.HealthConn = health.New(client.rateLimitSession(names.Health, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Health])})))
+	client.HealthLakeConn = healthlake.New(client.rateLimitSession(names.HealthLake, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.HealthLake])})))
+	client.HoneycodeConn = honeycode.New(client.rateLimitSession(names.Honeycode, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Honeycode])})))
+	client.IAMConn = iam.New(client.rateLimitSession(names.IAM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IAM])})))
+	client.IVSConn = ivs.New(client.rateLimitSession(names.IVS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IVS])})))
+	client.ImageBuilderConn = imagebuilder.New(client.rateLimitSession(names.ImageBuilder, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ImageBuilder])})))
+	client.InspectorConn = inspector.New(client.rateLimitSession(names.Inspector, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector])})))
+	client.IoTConn = iot.New(client.rateLimitSession(names.IoT, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT])})))
+	client.IoT1ClickDevicesConn = iot1clickdevicesservice.New(client.rateLimitSession(names.IoT1ClickDevices, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickDevices])})))
+	client.IoT1ClickProjectsConn = iot1clickprojects.New(client.rateLimitSession(names.IoT1ClickProjects, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickProjects])})))
+	client.IoTAnalyticsConn = iotanalytics.New(client.rateLimitSession(names.IoTAnalytics, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTAnalytics])})))
+	client.IoTDataConn = iotdataplane.New(client.rateLimitSession(names.IoTData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTData])})))
+	client.IoTDeviceAdvisorConn = iotdeviceadvisor.New(client.rateLimitSession(names.IoTDeviceAdvisor, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTDeviceAdvisor])})))
+	client.IoTEventsConn = iotevents.New(client.rateLimitSession(names.IoTEvents, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEvents])})))
+	client.IoTEventsDataConn = ioteventsdata.New(client.rateLimitSession(names.IoTEventsData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEventsData])})))
+	client.IoTFleetHubConn = iotfleethub.New(client.rateLimitSession(names.IoTFleetHub, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTFleetHub])})))
+	client.IoTJobsDataConn = iotjobsdataplane.New(client.rateLimitSession(names.IoTJobsData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTJobsData])})))
+	client.IoTSecureTunnelingConn = iotsecuretunneling.New(client.rateLimitSession(names.IoTSecureTunneling, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSecureTunneling])})))
+	client.IoTSiteWiseConn = iotsitewise.New(client.rateLimitSession(names.IoTSiteWise, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSiteWise])})))
+	client.IoTThingsGraphConn = iotthingsgraph.New(client.rateLimitSession(names.IoTThingsGraph, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTThingsGraph])})))
+	client.IoTTwinMakerConn = iottwinmaker.New(client.rateLimitSession(names.IoTTwinMaker, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTTwinMaker])})))
+	client.IoTWirelessConn = iotwireless.New(client.rateLimitSession(names.IoTWireless, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTWireless])})))
+	client.KMSConn = kms.New(client.rateLimitSession(names.KMS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KMS])})))
+	client.KafkaConn = kafka.New(client.rateLimitSession(names.Kafka, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kafka])})))
+	client.KafkaConnectConn = kafkaconnect.New(client.rateLimitSession(names.KafkaConnect, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KafkaConnect])})))
+	client.KeyspacesConn = keyspaces.New(client.rateLimitSession(names.Keyspaces, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Keyspaces])})))
+	client.KinesisConn = kinesis.New(client.rateLimitSession(names.Kinesis, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kinesis])})))
+	client.KinesisAnalyticsConn = kinesisanalytics.New(client.rateLimitSession(names.KinesisAnalytics, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalytics])})))
+	client.KinesisAnalyticsV2Conn = kinesisanalyticsv2.New(client.rateLimitSession(names.KinesisAnalyticsV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalyticsV2])})))
+	client.KinesisVideoConn = kinesisvideo.New(client.rateLimitSession(names.KinesisVideo, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideo])})))
+	client.KinesisVideoArchivedMediaConn = kinesisvideoarchivedmedia.New(client.rateLimitSession(names.KinesisVideoArchivedMedia, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoArchivedMedia])})))
+	client.KinesisVideoMediaConn = kinesisvideomedia.New(client.rateLimitSession(names.KinesisVideoMedia, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoMedia])})))
+	client.KinesisVideoSignalingConn = kinesisvideosignalingchannels.New(client.rateLimitSession(names.KinesisVideoSignaling, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoSignaling])})))
+	client.LakeFormationConn = lakeformation.New(client.rateLimitSession(names.LakeFormation, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LakeFormation])})))
+	client.LambdaConn = lambda.New(client.rateLimitSession(names.Lambda, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lambda])})))
+	client.LexModelsConn = lexmodelbuildingservice.New(client.rateLimitSession(names.LexModels, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModels])})))
+	client.LexModelsV2Conn = lexmodelsv2.New(client.rateLimitSession(names.LexModelsV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModelsV2])})))
+	client.LexRuntimeConn = lexruntimeservice.New(client.rateLimitSession(names.LexRuntime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntime])})))
+	client.LexRuntimeV2Conn = lexruntimev2.New(client.rateLimitSession(names.LexRuntimeV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntimeV2])})))
+	client.LicenseManagerConn = licensemanager.New(client.rateLimitSession(names.LicenseManager, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LicenseManager])})))
+	client.LightsailConn = lightsail.New(client.rateLimitSession(names.Lightsail, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lightsail])})))
+	client.LocationConn = locationservice.New(client.rateLimitSession(names.Location, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Location])})))
+	client.LogsConn = cloudwatchlogs.New(client.rateLimitSession(names.Logs, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Logs])})))
+	client.LookoutEquipmentConn = lookoutequipment.New(client.rateLimitSession(names.LookoutEquipment, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutEquipment])})))
+	client.LookoutMetricsConn = lookoutmetrics.New(client.rateLimitSession(names.LookoutMetrics, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutMetrics])})))
+	client.LookoutVisionConn = lookoutforvision.New(client.rateLimitSession(names.LookoutVision, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutVision])})))
+	client.M2Conn = m2.New(client.rateLimitSession(names.M2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.M2])})))
+	client.MQConn = mq.New(client.rateLimitSession(names.MQ, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MQ])})))
+	client.MTurkConn = mturk.New(client.rateLimitSession(names.MTurk, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MTurk])})))
+	client.MWAAConn = mwaa.New(client.rateLimitSession(names.MWAA, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MWAA])})))
+	client.MachineLearningConn = machinelearning.New(client.rateLimitSession(names.MachineLearning, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MachineLearning])})))
+	client.MacieConn = macie.New(client.rateLimitSession(names.Macie, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie])})))
+	client.Macie2Conn = macie2.New(client.rateLimitSession(names.Macie2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie2])})))
+	client.ManagedBlockchainConn = managedblockchain.New(client.rateLimitSession(names.ManagedBlockchain, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ManagedBlockchain])})))
+	client.MarketplaceCatalogConn = marketplacecatalog.New(client.rateLimitSession(names.MarketplaceCatalog, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCatalog])})))
+	client.MarketplaceCommerceAnalyticsConn = marketplacecommerceanalytics.New(client.rateLimitSession(names.MarketplaceCommerceAnalytics, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCommerceAnalytics])})))
+	client.MarketplaceEntitlementConn = marketplaceentitlementservice.New(client.rateLimitSession(names.MarketplaceEntitlement, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceEntitlement])})))
+	client.MarketplaceMeteringConn = marketplacemetering.New(client.rateLimitSession(names.MarketplaceMetering, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceMetering])})))
+	client.MediaConnectConn = mediaconnect.New(client.rateLimitSession(names.MediaConnect, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConnect])})))
+	client.MediaConvertConn = mediaconvert.New(client.rateLimitSession(names.MediaConvert, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConvert])})))
+	client.MediaPackageConn = mediapackage.New(client.rateLimitSession(names.MediaPackage, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackage])})))
+	client.MediaPackageVODConn = mediapackagevod.New(client.rateLimitSession(names.MediaPackageVOD, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackageVOD])})))
+	client.MediaStoreConn = mediastore.New(client.rateLimitSession(names.MediaStore, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStore])})))
+	client.MediaStoreDataConn = mediastoredata.New(client.rateLimitSession(names.MediaStoreData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStoreData])})))
+	client.MediaTailorConn = mediatailor.New(client.rateLimitSession(names.MediaTailor, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaTailor])})))
+	client.MemoryDBConn = memorydb.New(client.rateLimitSession(names.MemoryDB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MemoryDB])})))
+	client.MgHConn = migrationhub.New(client.rateLimitSession(names.MgH, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MgH])})))
+	client.MgnConn = mgn.New(client.rateLimitSession(names.Mgn, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mgn])})))
+	client.MigrationHubConfigConn = migrationhubconfig.New(client.rateLimitSession(names.MigrationHubConfig, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubConfig])})))
+	client.MigrationHubRefactorSpacesConn = migrationhubrefactorspaces.New(client.rateLimitSession(names.MigrationHubRefactorSpaces, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubRefactorSpaces])})))
+	client.MigrationHubStrategyConn = migrationhubstrategyrecommendations.New(client.rateLimitSession(names.MigrationHubStrategy, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubStrategy])})))
+	client.MobileConn = mobile.New(client.rateLimitSession(names.Mobile, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mobile])})))
+	client.NeptuneConn = neptune.New(client.rateLimitSession(names.Neptune, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Neptune])})))
+	client.NetworkFirewallConn = networkfirewall.New(client.rateLimitSession(names.NetworkFirewall, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkFirewall])})))
+	client.NetworkManagerConn = networkmanager.New(client.rateLimitSession(names.NetworkManager, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkManager])})))
+	client.NimbleConn = nimblestudio.New(client.rateLimitSession(names.Nimble, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Nimble])})))
+	client.ObservabilityAccessManagerConn = oam.New(client.rateLimitSession(names.ObservabilityAccessManager, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ObservabilityAccessManager])})))
+	client.OpenSearchConn = opensearchservice.New(client.rateLimitSession(names.OpenSearch, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearch])})))
+	client.OpsWorksConn = opsworks.New(client.rateLimitSession(names.OpsWorks, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorks])})))
+	client.OpsWorksCMConn = opsworkscm.New(client.rateLimitSession(names.OpsWorksCM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorksCM])})))
+	client.OrganizationsConn = organizations.New(client.rateLimitSession(names.Organizations, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Organizations])})))
+	client.OutpostsConn = outposts.New(client.rateLimitSession(names.Outposts, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Outposts])})))
+	client.PIConn = pi.New(client.rateLimitSession(names.PI, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PI])})))
+	client.PanoramaConn = panorama.New(client.rateLimitSession(names.Panorama, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Panorama])})))
+	client.PersonalizeConn = personalize.New(client.rateLimitSession(names.Personalize, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Personalize])})))
+	client.PersonalizeEventsConn = personalizeevents.New(client.rateLimitSession(names.PersonalizeEvents, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeEvents])})))
+	client.PersonalizeRuntimeConn = personalizeruntime.New(client.rateLimitSession(names.PersonalizeRuntime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeRuntime])})))
+	client.PinpointConn = pinpoint.New(client.rateLimitSession(names.Pinpoint, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pinpoint])})))
+	client.PinpointEmailConn = pinpointemail.New(client.rateLimitSession(names.PinpointEmail, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointEmail])})))
+	client.PinpointSMSVoiceConn = pinpointsmsvoice.New(client.rateLimitSession(names.PinpointSMSVoice, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointSMSVoice])})))
+	client.PinpointSMSVoiceV2Conn = pinpointsmsvoicev2.New(client.rateLimitSession(names.PinpointSMSVoiceV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointSMSVoiceV2])})))
+	client.PollyConn = polly.New(client.rateLimitSession(names.Polly, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Polly])})))
+	client.PricingConn = pricing.New(client.rateLimitSession(names.Pricing, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pricing])})))
+	client.ProtonConn = proton.New(client.rateLimitSession(names.Proton, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Proton])})))
+	client.QLDBConn = qldb.New(client.rateLimitSession(names.QLDB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDB])})))
+	client.QLDBSessionConn = qldbsession.New(client.rateLimitSession(names.QLDBSession, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDBSession])})))
+	client.QuickSightConn = quicksight.New(client.rateLimitSession(names.QuickSight, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QuickSight])})))
+	client.RAMConn = ram.New(client.rateLimitSession(names.RAM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RAM])})))
+	client.RBinConn = recyclebin.New(client.rateLimitSession(names.RBin, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RBin])})))
+	client.RDSConn = rds.New(client.rateLimitSession(names.RDS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDS])})))
+	client.RDSDataConn = rdsdataservice.New(client.rateLimitSession(names.RDSData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDSData])})))
+	client.RUMConn = cloudwatchrum.New(client.rateLimitSession(names.RUM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RUM])})))
+	client.RedshiftConn = redshift.New(client.rateLimitSession(names.Redshift, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Redshift])})))
+	client.RedshiftDataConn = redshiftdataapiservice.New(client.rateLimitSession(names.RedshiftData, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftData])})))
+	client.RedshiftServerlessConn = redshiftserverless.New(client.rateLimitSession(names.RedshiftServerless, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftServerless])})))
+	client.RekognitionConn = rekognition.New(client.rateLimitSession(names.Rekognition, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Rekognition])})))
+	client.ResilienceHubConn = resiliencehub.New(client.rateLimitSession(names.ResilienceHub, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResilienceHub])})))
+	client.ResourceGroupsConn = resourcegroups.New(client.rateLimitSession(names.ResourceGroups, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroups])})))
+	client.ResourceGroupsTaggingAPIConn = resourcegroupstaggingapi.New(client.rateLimitSession(names.ResourceGroupsTaggingAPI, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroupsTaggingAPI])})))
+	client.RoboMakerConn = robomaker.New(client.rateLimitSession(names.RoboMaker, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RoboMaker])})))
+	client.Route53RecoveryClusterConn = route53recoverycluster.New(client.rateLimitSession(names.Route53RecoveryCluster, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53RecoveryCluster])})))
+	client.Route53ResolverConn = route53resolver.New(client.rateLimitSession(names.Route53Resolver, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53Resolver])})))
+	client.S3ControlConn = s3control.New(client.rateLimitSession(names.S3Control, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Control])})))
+	client.S3OutpostsConn = s3outposts.New(client.rateLimitSession(names.S3Outposts, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Outposts])})))
+	client.SESConn = ses.New(client.rateLimitSession(names.SES, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SES])})))
+	client.SFNConn = sfn.New(client.rateLimitSession(names.SFN, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SFN])})))
+	client.SMSConn = sms.New(client.rateLimitSession(names.SMS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SMS])})))
+	client.SNSConn = sns.New(client.rateLimitSession(names.SNS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SNS])})))
+	client.SQSConn = sqs.New(client.rateLimitSession(names.SQS, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SQS])})))
+	client.SSMConn = ssm.New(client.rateLimitSession(names.SSM, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSM])})))
+	client.SSMContactsConn = ssmcontacts.New(client.rateLimitSession(names.SSMContacts, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSMContacts])})))
+	client.SSOConn = sso.New(client.rateLimitSession(names.SSO, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSO])})))
+	client.SSOAdminConn = ssoadmin.New(client.rateLimitSession(names.SSOAdmin, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOAdmin])})))
+	client.SSOOIDCConn = ssooidc.New(client.rateLimitSession(names.SSOOIDC, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOOIDC])})))
+	client.SWFConn = swf.New(client.rateLimitSession(names.SWF, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SWF])})))
+	client.SageMakerConn = sagemaker.New(client.rateLimitSession(names.SageMaker, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMaker])})))
+	client.SageMakerA2IRuntimeConn = augmentedairuntime.New(client.rateLimitSession(names.SageMakerA2IRuntime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerA2IRuntime])})))
+	client.SageMakerEdgeConn = sagemakeredgemanager.New(client.rateLimitSession(names.SageMakerEdge, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerEdge])})))
+	client.SageMakerFeatureStoreRuntimeConn = sagemakerfeaturestoreruntime.New(client.rateLimitSession(names.SageMakerFeatureStoreRuntime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerFeatureStoreRuntime])})))
+	client.SageMakerRuntimeConn = sagemakerruntime.New(client.rateLimitSession(names.SageMakerRuntime, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerRuntime])})))
+	client.SavingsPlansConn = savingsplans.New(client.rateLimitSession(names.SavingsPlans, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SavingsPlans])})))
+	client.SchemasConn = schemas.New(client.rateLimitSession(names.Schemas, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Schemas])})))
+	client.SecretsManagerConn = secretsmanager.New(client.rateLimitSession(names.SecretsManager, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecretsManager])})))
+	client.SecurityHubConn = securityhub.New(client.rateLimitSession(names.SecurityHub, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecurityHub])})))
+	client.SecurityLakeConn = securitylake.New(client.rateLimitSession(names.SecurityLake, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecurityLake])})))
+	client.ServerlessRepoConn = serverlessapplicationrepository.New(client.rateLimitSession(names.ServerlessRepo, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServerlessRepo])})))
+	client.ServiceCatalogConn = servicecatalog.New(client.rateLimitSession(names.ServiceCatalog, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalog])})))
+	client.ServiceCatalogAppRegistryConn = appregistry.New(client.rateLimitSession(names.ServiceCatalogAppRegistry, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalogAppRegistry])})))
+	client.ServiceDiscoveryConn = servicediscovery.New(client.rateLimitSession(names.ServiceDiscovery, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceDiscovery])})))
+	client.ServiceQuotasConn = servicequotas.New(client.rateLimitSession(names.ServiceQuotas, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceQuotas])})))
+	client.SignerConn = signer.New(client.rateLimitSession(names.Signer, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Signer])})))
+	client.SimpleDBConn = simpledb.New(client.rateLimitSession(names.SimpleDB, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SimpleDB])})))
+	client.SnowDeviceManagementConn = snowdevicemanagement.New(client.rateLimitSession(names.SnowDeviceManagement, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SnowDeviceManagement])})))
+	client.SnowballConn = snowball.New(client.rateLimitSession(names.Snowball, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Snowball])})))
+	client.StorageGatewayConn = storagegateway.New(client.rateLimitSession(names.StorageGateway, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.StorageGateway])})))
+	client.SupportConn = support.New(client.rateLimitSession(names.Support, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Support])})))
+	client.SupportAppConn = supportapp.New(client.rateLimitSession(names.SupportApp, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SupportApp])})))
+	client.SyntheticsConn = synthetics.New(client.rateLimitSession(names.Synthetics, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Synthetics])})))
+	client.TextractConn = textract.New(client.rateLimitSession(names.Textract, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Textract])})))
+	client.TimestreamQueryConn = timestreamquery.New(client.rateLimitSession(names.TimestreamQuery, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamQuery])})))
+	client.TimestreamWriteConn = timestreamwrite.New(client.rateLimitSession(names.TimestreamWrite, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamWrite])})))
+	client.TranscribeStreamingConn = transcribestreamingservice.New(client.rateLimitSession(names.TranscribeStreaming, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TranscribeStreaming])})))
+	client.TransferConn = transfer.New(client.rateLimitSession(names.Transfer, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transfer])})))
+	client.TranslateConn = translate.New(client.rateLimitSession(names.Translate, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Translate])})))
+	client.VoiceIDConn = voiceid.New(client.rateLimitSession(names.VoiceID, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.VoiceID])})))
+	client.WAFConn = waf.New(client.rateLimitSession(names.WAF, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAF])})))
+	client.WAFRegionalConn = wafregional.New(client.rateLimitSession(names.WAFRegional, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFRegional])})))
+	client.WAFV2Conn = wafv2.New(client.rateLimitSession(names.WAFV2, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFV2])})))
+	client.WellArchitectedConn = wellarchitected.New(client.rateLimitSession(names.WellArchitected, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WellArchitected])})))
+	client.WisdomConn = connectwisdomservice.New(client.rateLimitSession(names.Wisdom, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Wisdom])})))
+	client.WorkDocsConn = workdocs.New(client.rateLimitSession(names.WorkDocs, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkDocs])})))
+	client.WorkLinkConn = worklink.New(client.rateLimitSession(names.WorkLink, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkLink])})))
+	client.WorkMailConn = workmail.New(client.rateLimitSession(names.WorkMail, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMail])})))
+	client.WorkMailMessageFlowConn = workmailmessageflow.New(client.rateLimitSession(names.WorkMailMessageFlow, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMailMessageFlow])})))
+	client.WorkSpacesConn = workspaces.New(client.rateLimitSession(names.WorkSpaces, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpaces])})))
+	client.WorkSpacesWebConn = workspacesweb.New(client.rateLimitSession(names.WorkSpacesWeb, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpacesWeb])})))
+	client.XRayConn = xray.New(client.rateLimitSession(names.XRay, sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.XRay])})))
 }
 
 // sdkv2Conns initializes AWS SDK for Go v2 clients.
@@ -605,91 +615,109 @@ func (c *Config) sdkv2Conns(client *AWSClient, cfg aws_sdkv2.Config) {
 		if endpoint := c.Endpoints[names.AuditManager]; endpoint != "" {
 			o.EndpointResolver = auditmanager.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.AuditManager)...)
 	})
 	client.CloudControlClient = cloudcontrol.NewFromConfig(cfg, func(o *cloudcontrol.Options) {
 		if endpoint := c.Endpoints[names.CloudControl]; endpoint != "" {
 			o.EndpointResolver = cloudcontrol.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.CloudControl)...)
 	})
 	client.ComprehendClient = comprehend.NewFromConfig(cfg, func(o *comprehend.Options) {
 		if endpoint := c.Endpoints[names.Comprehend]; endpoint != "" {
 			o.EndpointResolver = comprehend.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.Comprehend)...)
 	})
 	client.ComputeOptimizerClient = computeoptimizer.NewFromConfig(cfg, func(o *computeoptimizer.Options) {
 		if endpoint := c.Endpoints[names.ComputeOptimizer]; endpoint != "" {
 			o.EndpointResolver = computeoptimizer.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.ComputeOptimizer)...)
 	})
 	client.FISClient = fis.NewFromConfig(cfg, func(o *fis.Options) {
 		if endpoint := c.Endpoints[names.FIS]; endpoint != "" {
 			o.EndpointResolver = fis.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.FIS)...)
 	})
 	client.IVSChatClient = ivschat.NewFromConfig(cfg, func(o *ivschat.Options) {
 		if endpoint := c.Endpoints[names.IVSChat]; endpoint != "" {
 			o.EndpointResolver = ivschat.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.IVSChat)...)
 	})
 	client.IdentityStoreClient = identitystore.NewFromConfig(cfg, func(o *identitystore.Options) {
 		if endpoint := c.Endpoints[names.IdentityStore]; endpoint != "" {
 			o.EndpointResolver = identitystore.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.IdentityStore)...)
 	})
 	client.Inspector2Client = inspector2.NewFromConfig(cfg, func(o *inspector2.Options) {
 		if endpoint := c.Endpoints[names.Inspector2]; endpoint != "" {
 			o.EndpointResolver = inspector2.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.Inspector2)...)
 	})
 	client.KendraClient = kendra.NewFromConfig(cfg, func(o *kendra.Options) {
 		if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
 			o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.Kendra)...)
 	})
 	client.MediaLiveClient = medialive.NewFromConfig(cfg, func(o *medialive.Options) {
 		if endpoint := c.Endpoints[names.MediaLive]; endpoint != "" {
 			o.EndpointResolver = medialive.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.MediaLive)...)
 	})
 	client.OpenSearchServerlessClient = opensearchserverless.NewFromConfig(cfg, func(o *opensearchserverless.Options) {
 		if endpoint := c.Endpoints[names.OpenSearchServerless]; endpoint != "" {
 			o.EndpointResolver = opensearchserverless.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.OpenSearchServerless)...)
 	})
 	client.PipesClient = pipes.NewFromConfig(cfg, func(o *pipes.Options) {
 		if endpoint := c.Endpoints[names.Pipes]; endpoint != "" {
 			o.EndpointResolver = pipes.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.Pipes)...)
 	})
 	client.ResourceExplorer2Client = resourceexplorer2.NewFromConfig(cfg, func(o *resourceexplorer2.Options) {
 		if endpoint := c.Endpoints[names.ResourceExplorer2]; endpoint != "" {
 			o.EndpointResolver = resourceexplorer2.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.ResourceExplorer2)...)
 	})
 	client.RolesAnywhereClient = rolesanywhere.NewFromConfig(cfg, func(o *rolesanywhere.Options) {
 		if endpoint := c.Endpoints[names.RolesAnywhere]; endpoint != "" {
 			o.EndpointResolver = rolesanywhere.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.RolesAnywhere)...)
 	})
 	client.SESV2Client = sesv2.NewFromConfig(cfg, func(o *sesv2.Options) {
 		if endpoint := c.Endpoints[names.SESV2]; endpoint != "" {
 			o.EndpointResolver = sesv2.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.SESV2)...)
 	})
 	client.SSMIncidentsClient = ssmincidents.NewFromConfig(cfg, func(o *ssmincidents.Options) {
 		if endpoint := c.Endpoints[names.SSMIncidents]; endpoint != "" {
 			o.EndpointResolver = ssmincidents.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.SSMIncidents)...)
 	})
 	client.SchedulerClient = scheduler.NewFromConfig(cfg, func(o *scheduler.Options) {
 		if endpoint := c.Endpoints[names.Scheduler]; endpoint != "" {
 			o.EndpointResolver = scheduler.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.Scheduler)...)
 	})
 	client.TranscribeClient = transcribe.NewFromConfig(cfg, func(o *transcribe.Options) {
 		if endpoint := c.Endpoints[names.Transcribe]; endpoint != "" {
 			o.EndpointResolver = transcribe.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.Transcribe)...)
 	})
 }
 
@@ -700,6 +728,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.EC2]; endpoint != "" {
 				o.EndpointResolver = ec2_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.EC2)...)
 		})
 	})
 	client.logsClient.init(&cfg, func() *cloudwatchlogs_sdkv2.Client {
@@ -707,6 +736,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.Logs]; endpoint != "" {
 				o.EndpointResolver = cloudwatchlogs_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.Logs)...)
 		})
 	})
 	client.rdsClient.init(&cfg, func() *rds_sdkv2.Client {
@@ -714,6 +744,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.RDS]; endpoint != "" {
 				o.EndpointResolver = rds_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.RDS)...)
 		})
 	})
 	client.s3controlClient.init(&cfg, func() *s3control_sdkv2.Client {
@@ -721,6 +752,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.S3Control]; endpoint != "" {
 				o.EndpointResolver = s3control_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.S3Control)...)
 		})
 	})
 	client.ssmClient.init(&cfg, func() *ssm_sdkv2.Client {
@@ -728,6 +760,7 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.SSM]; endpoint != "" {
 				o.EndpointResolver = ssm_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.SSM)...)
 		})
 	})
 }
@@ -0,0 +1,190 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// RateLimit is the client-side rate limit for a service's API calls.
+type RateLimit struct {
+	Burst             int
+	RequestsPerSecond float64
+}
+
+// rateLimiter is a token bucket rate limiter that is safe for concurrent use.
+type rateLimiter struct {
+	attached bool
+	burst    float64
+	last     time.Time
+	lock     sync.Mutex
+	rate     float64
+	tokens   float64
+}
+
+func newRateLimiter(rl RateLimit) *rateLimiter {
+	burst := rl.Burst
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rl.RequestsPerSecond)))
+	}
+
+	return &rateLimiter{
+		burst:  float64(burst),
+		rate:   rl.RequestsPerSecond,
+		tokens: float64(burst),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before the token is available.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a previously reserved token to the bucket.
+func (l *rateLimiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// attach records that the rate limiter has been added to an API client.
+func (l *rateLimiter) attach() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.attached = true
+}
+
+// isAttached returns whether the rate limiter has been added to any API client.
+func (l *rateLimiter) isAttached() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.attached
+}
+
+// wait blocks until a token is available or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// handler returns an AWS SDK for Go v1 request handler that waits for the rate limiter before each request attempt is signed.
+func (l *rateLimiter) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			if err := l.wait(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting for rate limiter", err)
+			}
+		},
+	}
+}
+
+// apiOption is an AWS SDK for Go v2 API option that waits for the rate limiter before each request attempt is signed.
+func (l *rateLimiter) apiOption(stack *middleware.Stack) error {
+	m := middleware.FinalizeMiddlewareFunc("terraform-provider-aws.RateLimitMiddleware", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if err := l.wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("request context canceled while waiting for rate limiter: %w", err)
+		}
+
+		return next.HandleFinalize(ctx, in)
+	})
+
+	if _, ok := stack.Finalize.Get("Signing"); ok {
+		return stack.Finalize.Insert(m, "Signing", middleware.Before)
+	}
+
+	return stack.Finalize.Add(m, middleware.After)
+}
+
+// rateLimiters returns a rate limiter for each configured client-side rate limit, keyed by service.
+func (c *Config) rateLimiters() map[string]*rateLimiter {
+	if len(c.RateLimits) == 0 {
+		return nil
+	}
+
+	limiters := make(map[string]*rateLimiter, len(c.RateLimits))
+
+	for service, rl := range c.RateLimits {
+		limiters[service] = newRateLimiter(rl)
+	}
+
+	return limiters
+}
+
+// rateLimitAPIOptions returns the AWS SDK for Go v2 API options that apply any client-side rate limit configured for the specified service.
+func (client *AWSClient) rateLimitAPIOptions(service string) []func(*middleware.Stack) error {
+	if limiter, ok := client.rateLimiters[service]; ok {
+		limiter.attach()
+		return []func(*middleware.Stack) error{limiter.apiOption}
+	}
+
+	return nil
+}
+
+// rateLimitSession adds any client-side rate limit configured for the specified service to the AWS SDK for Go v1 session's handlers.
+// The session should be a copy made for the service's API client.
+func (client *AWSClient) rateLimitSession(service string, sess *session.Session) *session.Session {
+	if limiter, ok := client.rateLimiters[service]; ok {
+		limiter.attach()
+		sess.Handlers.Sign.PushFrontNamed(limiter.handler())
+	}
+
+	return sess
+}
+
+// checkRateLimits returns an error if a configured client-side rate limit was not added to any of the service's API clients.
+func (client *AWSClient) checkRateLimits() error {
+	services := make([]string, 0, len(client.rateLimiters))
+
+	for service := range client.rateLimiters {
+		services = append(services, service)
+	}
+
+	sort.Strings(services)
+
+	for _, service := range services {
+		if !client.rateLimiters[service].isAttached() {
+			return fmt.Errorf("client-side rate limiting is not supported for service (%s)", service)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := newRateLimiter(RateLimit{Burst: 2, RequestsPerSecond: 4})
+	now := time.Now()
+
+	for i, expected := range []time.Duration{0, 0, 250 * time.Millisecond, 500 * time.Millisecond} {
+		if got := l.reserve(now); got != expected {
+			t.Errorf("reservation %d: got %s, expected %s", i, got, expected)
+		}
+	}
+
+	// After one second the bucket has refilled by 4 tokens, paying off the 2 outstanding reservations.
+	if got, expected := l.reserve(now.Add(time.Second)), time.Duration(0); got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestRateLimiterDefaultBurst(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		RateLimit RateLimit
+		Expected  float64
+	}{
+		{
+			Name:      "fractional rate",
+			RateLimit: RateLimit{RequestsPerSecond: 0.5},
+			Expected:  1,
+		},
+		{
+			Name:      "whole rate",
+			RateLimit: RateLimit{RequestsPerSecond: 5},
+			Expected:  5,
+		},
+		{
+			Name:      "explicit burst",
+			RateLimit: RateLimit{Burst: 10, RequestsPerSecond: 5},
+			Expected:  10,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := newRateLimiter(testCase.RateLimit).burst; got != testCase.Expected {
+				t.Errorf("got %f, expected %f", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRateLimiterAPIOption(t *testing.T) {
+	l := newRateLimiter(RateLimit{Burst: 1, RequestsPerSecond: 0.01})
+	stack := middleware.NewStack("GetGroup", smithyhttp.NewStackRequest)
+
+	if err := stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("Retry", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		return next.HandleFinalize(ctx, in)
+	}), middleware.After); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("Signing", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		return next.HandleFinalize(ctx, in)
+	}), middleware.After); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := l.apiOption(stack); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := stack.Finalize.List(), []string{"Retry", "terraform-provider-aws.RateLimitMiddleware", "Signing"}; len(got) != len(expected) || got[0] != expected[0] || got[1] != expected[1] || got[2] != expected[2] {
+		t.Errorf("got finalize middleware %v, expected %v", got, expected)
+	}
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+		return &smithyhttp.Response{}, middleware.Metadata{}, nil
+	}), stack)
+
+	// The first call uses the burst token.
+	if _, _, err := handler.Handle(context.Background(), struct{}{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The second call waits for the rate limiter until the context is canceled.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, _, err := handler.Handle(ctx, struct{}{}); err == nil {
+		t.Error("expected error for canceled context")
+	}
+}
+
+func TestAWSClientRateLimitSession(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(endpoints.UsWest2RegionID)})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c := &Config{
+		RateLimits: map[string]RateLimit{
+			names.IAM: {RequestsPerSecond: 1},
+		},
+	}
+	client := &AWSClient{
+		rateLimiters: c.rateLimiters(),
+	}
+	n := iam.New(sess.Copy()).Handlers.Sign.Len()
+
+	client.IAMConn = iam.New(client.rateLimitSession(names.IAM, sess.Copy()))
+
+	if got, expected := client.IAMConn.Handlers.Sign.Len(), n+1; got != expected {
+		t.Errorf("got %d sign handlers, expected %d", got, expected)
+	}
+
+	// The rate limit is only added to the service's own session.
+	if got, expected := client.rateLimitSession(names.STS, sess.Copy()).Handlers.Sign.Len(), sess.Handlers.Sign.Len(); got != expected {
+		t.Errorf("got %d sign handlers, expected %d", got, expected)
+	}
+
+	if err := client.checkRateLimits(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c = &Config{
+		RateLimits: map[string]RateLimit{
+			names.EC2: {RequestsPerSecond: 1},
+		},
+	}
+	client = &AWSClient{
+		rateLimiters: c.rateLimiters(),
+	}
+
+	if err := client.checkRateLimits(); err == nil {
+		t.Error("expected error for service without API client")
+	}
+
+	// Services with only an AWS SDK for Go v2 API client are rate limited by API options.
+	c = &Config{
+		RateLimits: map[string]RateLimit{
+			names.IdentityStore: {RequestsPerSecond: 1},
+		},
+	}
+	client = &AWSClient{
+		rateLimiters: c.rateLimiters(),
+	}
+	var apiOptions int
+	client.IdentityStoreClient = identitystore.NewFromConfig(aws_sdkv2.Config{}, func(o *identitystore.Options) {
+		o.APIOptions = append(o.APIOptions, client.rateLimitAPIOptions(names.IdentityStore)...)
+		apiOptions = len(o.APIOptions)
+	})
+
+	if got, expected := apiOptions, 1; got != expected {
+		t.Errorf("got %d API options, expected %d", got, expected)
+	}
+
+	if err := client.checkRateLimits(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -46,6 +46,11 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				Type:     types.SetType{ElemType: types.StringType},
 				Optional: true,
 			},
+			"audit_log_path": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "File to which mutating AWS API calls are appended as JSON Lines audit log entries. {pid} and {timestamp} are replaced with the provider's process ID and start time.",
+			},
 			"custom_ca_bundle": {
 				Type:        types.StringType,
 				Optional:    true,
@@ -278,6 +283,51 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				MaxItems:    1,
 				Description: "Configuration block with settings to default resource tags across all resources.",
 			},
+			"default_timeouts": {
+				Attributes: map[string]tfsdk.Attribute{
+					"multiplier": {
+						Type:        types.Float64Type,
+						Optional:    true,
+						Description: "Factor by which to multiply the default timeouts of all resources.",
+					},
+				},
+				Blocks: map[string]tfsdk.Block{
+					"resource": {
+						Attributes: map[string]tfsdk.Attribute{
+							"create": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "Default create timeout.",
+							},
+							"delete": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "Default delete timeout.",
+							},
+							"read": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "Default read timeout.",
+							},
+							"type": {
+								Type:        types.StringType,
+								Required:    true,
+								Description: "Resource type, e.g. `aws_networkmanager_vpc_attachment`.",
+							},
+							"update": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "Default update timeout.",
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeList,
+						Description: "Default timeouts for a resource type. These take precedence over `multiplier`.",
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeList,
+				MaxItems:    1,
+				Description: "Configuration block with settings to override default resource timeouts across all resources.",
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": {
 				Attributes: map[string]tfsdk.Attribute{
@@ -296,6 +346,27 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				MaxItems:    1,
 				Description: "Configuration block with settings to ignore resource tags across all resources.",
 			},
+			"rate_limit": {
+				Attributes: map[string]tfsdk.Attribute{
+					"burst": {
+						Type:        types.Int64Type,
+						Optional:    true,
+						Description: "Maximum number of API calls that can be made at once.",
+					},
+					"requests_per_second": {
+						Type:        types.Float64Type,
+						Required:    true,
+						Description: "Sustained rate of API calls per second.",
+					},
+					"service": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "Service to rate limit, using the same name as in the `endpoints` block, e.g. `route53`.",
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeSet,
+				Description: "Configuration block with settings to limit the rate of API calls to a service.",
+			},
 		},
 	}
 
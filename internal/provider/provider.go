@@ -70,6 +70,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/devopsguru"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
@@ -103,6 +104,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/health"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
@@ -126,8 +129,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/m2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
@@ -138,11 +143,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/oam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -151,6 +158,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
@@ -168,6 +176,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/serverlessrepo"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
@@ -177,11 +186,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/support"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/supportapp"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
@@ -192,6 +204,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -220,6 +233,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File to which mutating AWS API calls are appended as JSON Lines audit log entries. {pid} and {timestamp} are replaced with the provider's process ID and start time.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -243,6 +261,60 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to override default resource timeouts across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"multiplier": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(1),
+							Description:  "Factor by which to multiply the default timeouts of all resources.",
+						},
+						"resource": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Default timeouts for a resource type. These take precedence over `multiplier`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"create": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidDuration,
+										Description:  "Default create timeout.",
+									},
+									"delete": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidDuration,
+										Description:  "Default delete timeout.",
+									},
+									"read": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidDuration,
+										Description:  "Default read timeout.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource type, e.g. `aws_networkmanager_vpc_attachment`.",
+									},
+									"update": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidDuration,
+										Description:  "Default update timeout.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -312,6 +384,32 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration block with settings to limit the rate of API calls to a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of API calls that can be made at once.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0.01),
+							Description:  "Sustained rate of API calls per second.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Service to rate limit, using the same name as in the `endpoints` block, e.g. `route53`.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -420,8 +518,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		DataSourcesMap: map[string]*schema.Resource{
 			"aws_acm_certificate": acm.DataSourceCertificate(),
 
-			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
-			"aws_acmpca_certificate":           acmpca.DataSourceCertificate(),
+			"aws_acmpca_certificate_authority":   acmpca.DataSourceCertificateAuthority(),
+			"aws_acmpca_certificate_authorities": acmpca.DataSourceCertificateAuthorities(),
+			"aws_acmpca_certificate":             acmpca.DataSourceCertificate(),
 
 			"aws_api_gateway_api_key":     apigateway.DataSourceAPIKey(),
 			"aws_api_gateway_domain_name": apigateway.DataSourceDomainName(),
@@ -484,6 +583,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_cloudwatch_event_connection": events.DataSourceConnection(),
 			"aws_cloudwatch_event_source":     events.DataSourceSource(),
 
+			"aws_cloudwatch_metric_streams": cloudwatch.DataSourceMetricStreams(),
+
 			"aws_codeartifact_authorization_token": codeartifact.DataSourceAuthorizationToken(),
 			"aws_codeartifact_repository_endpoint": codeartifact.DataSourceRepositoryEndpoint(),
 
@@ -603,6 +704,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_vpc_endpoint":                               ec2.DataSourceVPCEndpoint(),
 			"aws_vpc_ipam_pool":                              ec2.DataSourceIPAMPool(),
 			"aws_vpc_ipam_pools":                             ec2.DataSourceIPAMPools(),
+			"aws_vpc_ipam_pool_cidr_availability":            ec2.DataSourceIPAMPoolCIDRAvailability(),
 			"aws_vpc_ipam_pool_cidrs":                        ec2.DataSourceIPAMPoolCIDRs(),
 			"aws_vpc_ipam_preview_next_cidr":                 ec2.DataSourceIPAMPreviewNextCIDR(),
 			"aws_vpc_peering_connection":                     ec2.DataSourceVPCPeeringConnection(),
@@ -617,10 +719,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_ecrpublic_authorization_token": ecrpublic.DataSourceAuthorizationToken(),
 
-			"aws_ecs_cluster":              ecs.DataSourceCluster(),
-			"aws_ecs_container_definition": ecs.DataSourceContainerDefinition(),
-			"aws_ecs_service":              ecs.DataSourceService(),
-			"aws_ecs_task_definition":      ecs.DataSourceTaskDefinition(),
+			"aws_ecs_cluster":                  ecs.DataSourceCluster(),
+			"aws_ecs_container_definition":     ecs.DataSourceContainerDefinition(),
+			"aws_ecs_service":                  ecs.DataSourceService(),
+			"aws_ecs_service_connect_services": ecs.DataSourceServiceConnectServices(),
+			"aws_ecs_task_definition":          ecs.DataSourceTaskDefinition(),
 
 			"aws_efs_access_point":  efs.DataSourceAccessPoint(),
 			"aws_efs_access_points": efs.DataSourceAccessPoints(),
@@ -676,6 +779,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_guardduty_detector": guardduty.DataSourceDetector(),
 
+			"aws_health_events": health.DataSourceEvents(),
+
 			"aws_iam_account_alias":           iam.DataSourceAccountAlias(),
 			"aws_iam_group":                   iam.DataSourceGroup(),
 			"aws_iam_instance_profile":        iam.DataSourceInstanceProfile(),
@@ -711,7 +816,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_inspector_rules_packages": inspector.DataSourceRulesPackages(),
 
-			"aws_iot_endpoint": iot.DataSourceEndpoint(),
+			"aws_iot_domain_configuration": iot.DataSourceDomainConfiguration(),
+			"aws_iot_endpoint":             iot.DataSourceEndpoint(),
 
 			"aws_ivs_stream_key": ivs.DataSourceStreamKey(),
 
@@ -794,6 +900,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_networkmanager_site":                         networkmanager.DataSourceSite(),
 			"aws_networkmanager_sites":                        networkmanager.DataSourceSites(),
 
+			"aws_oam_linked_accounts": oam.DataSourceLinkedAccounts(),
+
 			"aws_opensearch_domain": opensearch.DataSourceDomain(),
 
 			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
@@ -804,6 +912,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_outposts_asset":                  outposts.DataSourceOutpostAsset(),
 			"aws_outposts_assets":                 outposts.DataSourceOutpostAssets(),
+			"aws_outposts_order":                  outposts.DataSourceOrder(),
+			"aws_outposts_orders":                 outposts.DataSourceOrders(),
 			"aws_outposts_outpost":                outposts.DataSourceOutpost(),
 			"aws_outposts_outpost_instance_type":  outposts.DataSourceOutpostInstanceType(),
 			"aws_outposts_outpost_instance_types": outposts.DataSourceOutpostInstanceTypes(),
@@ -817,7 +927,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_qldb_ledger": qldb.DataSourceLedger(),
 
-			"aws_ram_resource_share": ram.DataSourceResourceShare(),
+			"aws_ram_resource_share":              ram.DataSourceResourceShare(),
+			"aws_ram_resource_share_associations": ram.DataSourceResourceShareAssociations(),
 
 			"aws_ses_active_receipt_rule_set": ses.DataSourceActiveReceiptRuleSet(),
 			"aws_ses_domain_identity":         ses.DataSourceDomainIdentity(),
@@ -919,6 +1030,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_storagegateway_local_disk": storagegateway.DataSourceLocalDisk(),
 
+			"aws_support_trusted_advisor_checks": support.DataSourceTrustedAdvisorChecks(),
+
 			"aws_transfer_server": transfer.DataSourceServer(),
 
 			"aws_waf_ipset":                 waf.DataSourceIPSet(),
@@ -1043,13 +1156,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_apprunner_custom_domain_association":          apprunner.ResourceCustomDomainAssociation(),
 			"aws_apprunner_service":                            apprunner.ResourceService(),
 
-			"aws_appstream_directory_config":        appstream.ResourceDirectoryConfig(),
-			"aws_appstream_fleet":                   appstream.ResourceFleet(),
-			"aws_appstream_fleet_stack_association": appstream.ResourceFleetStackAssociation(),
-			"aws_appstream_image_builder":           appstream.ResourceImageBuilder(),
-			"aws_appstream_stack":                   appstream.ResourceStack(),
-			"aws_appstream_user":                    appstream.ResourceUser(),
-			"aws_appstream_user_stack_association":  appstream.ResourceUserStackAssociation(),
+			"aws_appstream_app_block":                     appstream.ResourceAppBlock(),
+			"aws_appstream_application":                   appstream.ResourceApplication(),
+			"aws_appstream_application_fleet_association": appstream.ResourceApplicationFleetAssociation(),
+			"aws_appstream_directory_config":              appstream.ResourceDirectoryConfig(),
+			"aws_appstream_entitlement":                   appstream.ResourceEntitlement(),
+			"aws_appstream_fleet":                         appstream.ResourceFleet(),
+			"aws_appstream_fleet_stack_association":       appstream.ResourceFleetStackAssociation(),
+			"aws_appstream_image_builder":                 appstream.ResourceImageBuilder(),
+			"aws_appstream_stack":                         appstream.ResourceStack(),
+			"aws_appstream_user":                          appstream.ResourceUser(),
+			"aws_appstream_user_stack_association":        appstream.ResourceUserStackAssociation(),
 
 			"aws_appsync_api_cache":                   appsync.ResourceAPICache(),
 			"aws_appsync_api_key":                     appsync.ResourceAPIKey(),
@@ -1141,6 +1258,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_cloudtrail":                  cloudtrail.ResourceCloudTrail(),
 			"aws_cloudtrail_event_data_store": cloudtrail.ResourceEventDataStore(),
+			"aws_cloudtrail_query":            cloudtrail.ResourceQuery(),
 
 			"aws_cloudwatch_composite_alarm": cloudwatch.ResourceCompositeAlarm(),
 			"aws_cloudwatch_dashboard":       cloudwatch.ResourceDashboard(),
@@ -1176,6 +1294,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_codecommit_trigger":                            codecommit.ResourceTrigger(),
 
 			"aws_codedeploy_app":               deploy.ResourceApp(),
+			"aws_codedeploy_deployment":        deploy.ResourceDeployment(),
 			"aws_codedeploy_deployment_config": deploy.ResourceDeploymentConfig(),
 			"aws_codedeploy_deployment_group":  deploy.ResourceDeploymentGroup(),
 
@@ -1271,6 +1390,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_detective_invitation_accepter": detective.ResourceInvitationAccepter(),
 			"aws_detective_member":              detective.ResourceMember(),
 
+			"aws_devopsguru_event_sources_config": devopsguru.ResourceEventSourcesConfig(),
+			"aws_devopsguru_notification_channel": devopsguru.ResourceNotificationChannel(),
+			"aws_devopsguru_resource_collection":  devopsguru.ResourceResourceCollection(),
+			"aws_devopsguru_service_integration":  devopsguru.ResourceServiceIntegration(),
+
 			"aws_dx_bgp_peer":                                  directconnect.ResourceBGPPeer(),
 			"aws_dx_connection":                                directconnect.ResourceConnection(),
 			"aws_dx_connection_association":                    directconnect.ResourceConnectionAssociation(),
@@ -1325,122 +1449,132 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_dynamodb_table_replica":                 dynamodb.ResourceTableReplica(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
-			"aws_ami":                                              ec2.ResourceAMI(),
-			"aws_ami_copy":                                         ec2.ResourceAMICopy(),
-			"aws_ami_from_instance":                                ec2.ResourceAMIFromInstance(),
-			"aws_ami_launch_permission":                            ec2.ResourceAMILaunchPermission(),
-			"aws_customer_gateway":                                 ec2.ResourceCustomerGateway(),
-			"aws_default_network_acl":                              ec2.ResourceDefaultNetworkACL(),
-			"aws_default_route_table":                              ec2.ResourceDefaultRouteTable(),
-			"aws_default_security_group":                           ec2.ResourceDefaultSecurityGroup(),
-			"aws_default_subnet":                                   ec2.ResourceDefaultSubnet(),
-			"aws_default_vpc":                                      ec2.ResourceDefaultVPC(),
-			"aws_default_vpc_dhcp_options":                         ec2.ResourceDefaultVPCDHCPOptions(),
-			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),
-			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
-			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
-			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
-			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(),
-			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
-			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
-			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(),
-			"aws_ec2_client_vpn_endpoint":                          ec2.ResourceClientVPNEndpoint(),
-			"aws_ec2_client_vpn_network_association":               ec2.ResourceClientVPNNetworkAssociation(),
-			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
-			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
-			"aws_ec2_host":                                         ec2.ResourceHost(),
-			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
-			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
-			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
-			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),
-			"aws_ec2_network_insights_analysis":                    ec2.ResourceNetworkInsightsAnalysis(),
-			"aws_ec2_network_insights_path":                        ec2.ResourceNetworkInsightsPath(),
-			"aws_ec2_serial_console_access":                        ec2.ResourceSerialConsoleAccess(),
-			"aws_ec2_subnet_cidr_reservation":                      ec2.ResourceSubnetCIDRReservation(),
-			"aws_ec2_tag":                                          ec2.ResourceTag(),
-			"aws_ec2_traffic_mirror_filter":                        ec2.ResourceTrafficMirrorFilter(),
-			"aws_ec2_traffic_mirror_filter_rule":                   ec2.ResourceTrafficMirrorFilterRule(),
-			"aws_ec2_traffic_mirror_session":                       ec2.ResourceTrafficMirrorSession(),
-			"aws_ec2_traffic_mirror_target":                        ec2.ResourceTrafficMirrorTarget(),
-			"aws_ec2_transit_gateway":                              ec2.ResourceTransitGateway(),
-			"aws_ec2_transit_gateway_connect":                      ec2.ResourceTransitGatewayConnect(),
-			"aws_ec2_transit_gateway_connect_peer":                 ec2.ResourceTransitGatewayConnectPeer(),
-			"aws_ec2_transit_gateway_multicast_domain":             ec2.ResourceTransitGatewayMulticastDomain(),
-			"aws_ec2_transit_gateway_multicast_domain_association": ec2.ResourceTransitGatewayMulticastDomainAssociation(),
-			"aws_ec2_transit_gateway_multicast_group_member":       ec2.ResourceTransitGatewayMulticastGroupMember(),
-			"aws_ec2_transit_gateway_multicast_group_source":       ec2.ResourceTransitGatewayMulticastGroupSource(),
-			"aws_ec2_transit_gateway_peering_attachment":           ec2.ResourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_peering_attachment_accepter":  ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
-			"aws_ec2_transit_gateway_policy_table":                 ec2.ResourceTransitGatewayPolicyTable(),
-			"aws_ec2_transit_gateway_policy_table_association":     ec2.ResourceTransitGatewayPolicyTableAssociation(),
-			"aws_ec2_transit_gateway_prefix_list_reference":        ec2.ResourceTransitGatewayPrefixListReference(),
-			"aws_ec2_transit_gateway_route":                        ec2.ResourceTransitGatewayRoute(),
-			"aws_ec2_transit_gateway_route_table":                  ec2.ResourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_table_association":      ec2.ResourceTransitGatewayRouteTableAssociation(),
-			"aws_ec2_transit_gateway_route_table_propagation":      ec2.ResourceTransitGatewayRouteTablePropagation(),
-			"aws_ec2_transit_gateway_vpc_attachment":               ec2.ResourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachment_accepter":      ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
-			"aws_egress_only_internet_gateway":                     ec2.ResourceEgressOnlyInternetGateway(),
-			"aws_eip":                                              ec2.ResourceEIP(),
-			"aws_eip_association":                                  ec2.ResourceEIPAssociation(),
-			"aws_flow_log":                                         ec2.ResourceFlowLog(),
-			"aws_instance":                                         ec2.ResourceInstance(),
-			"aws_internet_gateway":                                 ec2.ResourceInternetGateway(),
-			"aws_internet_gateway_attachment":                      ec2.ResourceInternetGatewayAttachment(),
-			"aws_key_pair":                                         ec2.ResourceKeyPair(),
-			"aws_launch_template":                                  ec2.ResourceLaunchTemplate(),
-			"aws_main_route_table_association":                     ec2.ResourceMainRouteTableAssociation(),
-			"aws_nat_gateway":                                      ec2.ResourceNATGateway(),
-			"aws_network_acl":                                      ec2.ResourceNetworkACL(),
-			"aws_network_acl_association":                          ec2.ResourceNetworkACLAssociation(),
-			"aws_network_acl_rule":                                 ec2.ResourceNetworkACLRule(),
-			"aws_network_interface":                                ec2.ResourceNetworkInterface(),
-			"aws_network_interface_attachment":                     ec2.ResourceNetworkInterfaceAttachment(),
-			"aws_network_interface_sg_attachment":                  ec2.ResourceNetworkInterfaceSGAttachment(),
-			"aws_placement_group":                                  ec2.ResourcePlacementGroup(),
-			"aws_route":                                            ec2.ResourceRoute(),
-			"aws_route_table":                                      ec2.ResourceRouteTable(),
-			"aws_route_table_association":                          ec2.ResourceRouteTableAssociation(),
-			"aws_security_group":                                   ec2.ResourceSecurityGroup(),
-			"aws_security_group_rule":                              ec2.ResourceSecurityGroupRule(),
-			"aws_snapshot_create_volume_permission":                ec2.ResourceSnapshotCreateVolumePermission(),
-			"aws_spot_datafeed_subscription":                       ec2.ResourceSpotDataFeedSubscription(),
-			"aws_spot_fleet_request":                               ec2.ResourceSpotFleetRequest(),
-			"aws_spot_instance_request":                            ec2.ResourceSpotInstanceRequest(),
-			"aws_subnet":                                           ec2.ResourceSubnet(),
-			"aws_volume_attachment":                                ec2.ResourceVolumeAttachment(),
-			"aws_vpc":                                              ec2.ResourceVPC(),
-			"aws_vpc_dhcp_options":                                 ec2.ResourceVPCDHCPOptions(),
-			"aws_vpc_dhcp_options_association":                     ec2.ResourceVPCDHCPOptionsAssociation(),
-			"aws_vpc_endpoint":                                     ec2.ResourceVPCEndpoint(),
-			"aws_vpc_endpoint_connection_accepter":                 ec2.ResourceVPCEndpointConnectionAccepter(),
-			"aws_vpc_endpoint_connection_notification":             ec2.ResourceVPCEndpointConnectionNotification(),
-			"aws_vpc_endpoint_policy":                              ec2.ResourceVPCEndpointPolicy(),
-			"aws_vpc_endpoint_route_table_association":             ec2.ResourceVPCEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_security_group_association":          ec2.ResourceVPCEndpointSecurityGroupAssociation(),
-			"aws_vpc_endpoint_service":                             ec2.ResourceVPCEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":           ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
-			"aws_vpc_endpoint_subnet_association":                  ec2.ResourceVPCEndpointSubnetAssociation(),
-			"aws_vpc_ipam":                                         ec2.ResourceIPAM(),
-			"aws_vpc_ipam_organization_admin_account":              ec2.ResourceIPAMOrganizationAdminAccount(),
-			"aws_vpc_ipam_pool":                                    ec2.ResourceIPAMPool(),
-			"aws_vpc_ipam_pool_cidr_allocation":                    ec2.ResourceIPAMPoolCIDRAllocation(),
-			"aws_vpc_ipam_pool_cidr":                               ec2.ResourceIPAMPoolCIDR(),
-			"aws_vpc_ipam_preview_next_cidr":                       ec2.ResourceIPAMPreviewNextCIDR(),
-			"aws_vpc_ipam_scope":                                   ec2.ResourceIPAMScope(),
-			"aws_vpc_ipv4_cidr_block_association":                  ec2.ResourceVPCIPv4CIDRBlockAssociation(),
-			"aws_vpc_ipv6_cidr_block_association":                  ec2.ResourceVPCIPv6CIDRBlockAssociation(),
-			"aws_vpc_network_performance_metric_subscription":      ec2.ResourceNetworkPerformanceMetricSubscription(),
-			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
-			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
-			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
-			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
-			"aws_vpn_gateway_attachment":                           ec2.ResourceVPNGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":                    ec2.ResourceVPNGatewayRoutePropagation(),
+			"aws_ami":                                               ec2.ResourceAMI(),
+			"aws_ami_copy":                                          ec2.ResourceAMICopy(),
+			"aws_ami_from_instance":                                 ec2.ResourceAMIFromInstance(),
+			"aws_ami_launch_permission":                             ec2.ResourceAMILaunchPermission(),
+			"aws_customer_gateway":                                  ec2.ResourceCustomerGateway(),
+			"aws_default_network_acl":                               ec2.ResourceDefaultNetworkACL(),
+			"aws_default_route_table":                               ec2.ResourceDefaultRouteTable(),
+			"aws_default_security_group":                            ec2.ResourceDefaultSecurityGroup(),
+			"aws_default_subnet":                                    ec2.ResourceDefaultSubnet(),
+			"aws_default_vpc":                                       ec2.ResourceDefaultVPC(),
+			"aws_default_vpc_dhcp_options":                          ec2.ResourceDefaultVPCDHCPOptions(),
+			"aws_ebs_default_kms_key":                               ec2.ResourceEBSDefaultKMSKey(),
+			"aws_ebs_encryption_by_default":                         ec2.ResourceEBSEncryptionByDefault(),
+			"aws_ebs_snapshot":                                      ec2.ResourceEBSSnapshot(),
+			"aws_ebs_snapshot_copy":                                 ec2.ResourceEBSSnapshotCopy(),
+			"aws_ebs_snapshot_import":                               ec2.ResourceEBSSnapshotImport(),
+			"aws_ebs_volume":                                        ec2.ResourceEBSVolume(),
+			"aws_ec2_availability_zone_group":                       ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_capacity_reservation":                          ec2.ResourceCapacityReservation(),
+			"aws_ec2_carrier_gateway":                               ec2.ResourceCarrierGateway(),
+			"aws_ec2_client_vpn_authorization_rule":                 ec2.ResourceClientVPNAuthorizationRule(),
+			"aws_ec2_client_vpn_endpoint":                           ec2.ResourceClientVPNEndpoint(),
+			"aws_ec2_client_vpn_network_association":                ec2.ResourceClientVPNNetworkAssociation(),
+			"aws_ec2_client_vpn_route":                              ec2.ResourceClientVPNRoute(),
+			"aws_ec2_fleet":                                         ec2.ResourceFleet(),
+			"aws_ec2_host":                                          ec2.ResourceHost(),
+			"aws_ec2_local_gateway_route":                           ec2.ResourceLocalGatewayRoute(),
+			"aws_ec2_local_gateway_route_table_vpc_association":     ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
+			"aws_ec2_managed_prefix_list":                           ec2.ResourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_list_entry":                     ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_analysis":                     ec2.ResourceNetworkInsightsAnalysis(),
+			"aws_ec2_network_insights_path":                         ec2.ResourceNetworkInsightsPath(),
+			"aws_ec2_serial_console_access":                         ec2.ResourceSerialConsoleAccess(),
+			"aws_ec2_subnet_cidr_reservation":                       ec2.ResourceSubnetCIDRReservation(),
+			"aws_ec2_tag":                                           ec2.ResourceTag(),
+			"aws_ec2_traffic_mirror_filter":                         ec2.ResourceTrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":                    ec2.ResourceTrafficMirrorFilterRule(),
+			"aws_ec2_traffic_mirror_session":                        ec2.ResourceTrafficMirrorSession(),
+			"aws_ec2_traffic_mirror_target":                         ec2.ResourceTrafficMirrorTarget(),
+			"aws_ec2_transit_gateway":                               ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                       ec2.ResourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                  ec2.ResourceTransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_multicast_domain":              ec2.ResourceTransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_multicast_domain_association":  ec2.ResourceTransitGatewayMulticastDomainAssociation(),
+			"aws_ec2_transit_gateway_multicast_group_member":        ec2.ResourceTransitGatewayMulticastGroupMember(),
+			"aws_ec2_transit_gateway_multicast_group_source":        ec2.ResourceTransitGatewayMulticastGroupSource(),
+			"aws_ec2_transit_gateway_peering_attachment":            ec2.ResourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_peering_attachment_accepter":   ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_policy_table":                  ec2.ResourceTransitGatewayPolicyTable(),
+			"aws_ec2_transit_gateway_policy_table_association":      ec2.ResourceTransitGatewayPolicyTableAssociation(),
+			"aws_ec2_transit_gateway_prefix_list_reference":         ec2.ResourceTransitGatewayPrefixListReference(),
+			"aws_ec2_transit_gateway_prefix_list_references":        ec2.ResourceTransitGatewayPrefixListReferences(),
+			"aws_ec2_transit_gateway_route":                         ec2.ResourceTransitGatewayRoute(),
+			"aws_ec2_transit_gateway_route_table":                   ec2.ResourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_association":       ec2.ResourceTransitGatewayRouteTableAssociation(),
+			"aws_ec2_transit_gateway_route_table_associations":      ec2.ResourceTransitGatewayRouteTableAssociations(),
+			"aws_ec2_transit_gateway_route_table_propagation":       ec2.ResourceTransitGatewayRouteTablePropagation(),
+			"aws_ec2_transit_gateway_route_table_propagations":      ec2.ResourceTransitGatewayRouteTablePropagations(),
+			"aws_ec2_transit_gateway_vpc_attachment":                ec2.ResourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachment_accepter":       ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
+			"aws_egress_only_internet_gateway":                      ec2.ResourceEgressOnlyInternetGateway(),
+			"aws_eip":                                               ec2.ResourceEIP(),
+			"aws_eip_association":                                   ec2.ResourceEIPAssociation(),
+			"aws_flow_log":                                          ec2.ResourceFlowLog(),
+			"aws_instance":                                          ec2.ResourceInstance(),
+			"aws_internet_gateway":                                  ec2.ResourceInternetGateway(),
+			"aws_internet_gateway_attachment":                       ec2.ResourceInternetGatewayAttachment(),
+			"aws_key_pair":                                          ec2.ResourceKeyPair(),
+			"aws_launch_template":                                   ec2.ResourceLaunchTemplate(),
+			"aws_main_route_table_association":                      ec2.ResourceMainRouteTableAssociation(),
+			"aws_nat_gateway":                                       ec2.ResourceNATGateway(),
+			"aws_network_acl":                                       ec2.ResourceNetworkACL(),
+			"aws_network_acl_association":                           ec2.ResourceNetworkACLAssociation(),
+			"aws_network_acl_rule":                                  ec2.ResourceNetworkACLRule(),
+			"aws_network_interface":                                 ec2.ResourceNetworkInterface(),
+			"aws_network_interface_attachment":                      ec2.ResourceNetworkInterfaceAttachment(),
+			"aws_network_interface_sg_attachment":                   ec2.ResourceNetworkInterfaceSGAttachment(),
+			"aws_placement_group":                                   ec2.ResourcePlacementGroup(),
+			"aws_route":                                             ec2.ResourceRoute(),
+			"aws_route_table":                                       ec2.ResourceRouteTable(),
+			"aws_route_table_association":                           ec2.ResourceRouteTableAssociation(),
+			"aws_route_table_routes":                                ec2.ResourceRouteTableRoutes(),
+			"aws_security_group":                                    ec2.ResourceSecurityGroup(),
+			"aws_security_group_rule":                               ec2.ResourceSecurityGroupRule(),
+			"aws_snapshot_create_volume_permission":                 ec2.ResourceSnapshotCreateVolumePermission(),
+			"aws_spot_datafeed_subscription":                        ec2.ResourceSpotDataFeedSubscription(),
+			"aws_spot_fleet_request":                                ec2.ResourceSpotFleetRequest(),
+			"aws_spot_instance_request":                             ec2.ResourceSpotInstanceRequest(),
+			"aws_subnet":                                            ec2.ResourceSubnet(),
+			"aws_volume_attachment":                                 ec2.ResourceVolumeAttachment(),
+			"aws_verifiedaccess_endpoint":                           ec2.ResourceVerifiedAccessEndpoint(),
+			"aws_verifiedaccess_group":                              ec2.ResourceVerifiedAccessGroup(),
+			"aws_verifiedaccess_instance":                           ec2.ResourceVerifiedAccessInstance(),
+			"aws_verifiedaccess_instance_trust_provider_attachment": ec2.ResourceVerifiedAccessInstanceTrustProviderAttachment(),
+			"aws_verifiedaccess_trust_provider":                     ec2.ResourceVerifiedAccessTrustProvider(),
+			"aws_vpc":                                               ec2.ResourceVPC(),
+			"aws_vpc_dhcp_options":                                  ec2.ResourceVPCDHCPOptions(),
+			"aws_vpc_dhcp_options_association":                      ec2.ResourceVPCDHCPOptionsAssociation(),
+			"aws_vpc_endpoint":                                      ec2.ResourceVPCEndpoint(),
+			"aws_vpc_endpoint_connection_accepter":                  ec2.ResourceVPCEndpointConnectionAccepter(),
+			"aws_vpc_endpoint_connection_notification":              ec2.ResourceVPCEndpointConnectionNotification(),
+			"aws_vpc_endpoint_policy":                               ec2.ResourceVPCEndpointPolicy(),
+			"aws_vpc_endpoint_route_table_association":              ec2.ResourceVPCEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_security_group_association":           ec2.ResourceVPCEndpointSecurityGroupAssociation(),
+			"aws_vpc_endpoint_service":                              ec2.ResourceVPCEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":            ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_subnet_association":                   ec2.ResourceVPCEndpointSubnetAssociation(),
+			"aws_vpc_ipam":                                          ec2.ResourceIPAM(),
+			"aws_vpc_ipam_organization_admin_account":               ec2.ResourceIPAMOrganizationAdminAccount(),
+			"aws_vpc_ipam_pool":                                     ec2.ResourceIPAMPool(),
+			"aws_vpc_ipam_pool_cidr_allocation":                     ec2.ResourceIPAMPoolCIDRAllocation(),
+			"aws_vpc_ipam_pool_cidr":                                ec2.ResourceIPAMPoolCIDR(),
+			"aws_vpc_ipam_preview_next_cidr":                        ec2.ResourceIPAMPreviewNextCIDR(),
+			"aws_vpc_ipam_scope":                                    ec2.ResourceIPAMScope(),
+			"aws_vpc_ipv4_cidr_block_association":                   ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpc_ipv6_cidr_block_association":                   ec2.ResourceVPCIPv6CIDRBlockAssociation(),
+			"aws_vpc_network_performance_metric_subscription":       ec2.ResourceNetworkPerformanceMetricSubscription(),
+			"aws_vpc_peering_connection":                            ec2.ResourceVPCPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                   ec2.ResourceVPCPeeringConnectionAccepter(),
+			"aws_vpc_peering_connection_options":                    ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_rules":                          ec2.ResourceSecurityGroupRules(),
+			"aws_vpn_connection":                                    ec2.ResourceVPNConnection(),
+			"aws_vpn_connection_route":                              ec2.ResourceVPNConnectionRoute(),
+			"aws_vpn_gateway":                                       ec2.ResourceVPNGateway(),
+			"aws_vpn_gateway_attachment":                            ec2.ResourceVPNGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                     ec2.ResourceVPNGatewayRoutePropagation(),
 
 			"aws_ecr_lifecycle_policy":                ecr.ResourceLifecyclePolicy(),
 			"aws_ecr_pull_through_cache_rule":         ecr.ResourcePullThroughCacheRule(),
@@ -1528,9 +1662,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_emr_studio":                 emr.ResourceStudio(),
 			"aws_emr_studio_session_mapping": emr.ResourceStudioSessionMapping(),
 
-			"aws_emrcontainers_virtual_cluster": emrcontainers.ResourceVirtualCluster(),
+			"aws_emrcontainers_managed_endpoint": emrcontainers.ResourceManagedEndpoint(),
+			"aws_emrcontainers_virtual_cluster":  emrcontainers.ResourceVirtualCluster(),
 
 			"aws_emrserverless_application": emrserverless.ResourceApplication(),
+			"aws_emrserverless_job_run":     emrserverless.ResourceJobRun(),
 
 			"aws_evidently_feature": evidently.ResourceFeature(),
 			"aws_evidently_project": evidently.ResourceProject(),
@@ -1557,9 +1693,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_gamelift_alias":              gamelift.ResourceAlias(),
 			"aws_gamelift_build":              gamelift.ResourceBuild(),
+			"aws_gamelift_compute":            gamelift.ResourceCompute(),
 			"aws_gamelift_fleet":              gamelift.ResourceFleet(),
 			"aws_gamelift_game_server_group":  gamelift.ResourceGameServerGroup(),
 			"aws_gamelift_game_session_queue": gamelift.ResourceGameSessionQueue(),
+			"aws_gamelift_location":           gamelift.ResourceLocation(),
 			"aws_gamelift_script":             gamelift.ResourceScript(),
 
 			"aws_glacier_vault":      glacier.ResourceVault(),
@@ -1604,6 +1742,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
 
+			"aws_healthlake_fhir_datastore":  healthlake.ResourceFHIRDatastore(),
+			"aws_healthlake_fhir_import_job": healthlake.ResourceFHIRImportJob(),
+
 			"aws_iam_access_key":                  iam.ResourceAccessKey(),
 			"aws_iam_account_alias":               iam.ResourceAccountAlias(),
 			"aws_iam_account_password_policy":     iam.ResourceAccountPasswordPolicy(),
@@ -1631,9 +1772,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
 			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
 
-			"aws_identitystore_group":            identitystore.ResourceGroup(),
-			"aws_identitystore_user":             identitystore.ResourceUser(),
-			"aws_identitystore_group_membership": identitystore.ResourceGroupMembership(),
+			"aws_identitystore_group":             identitystore.ResourceGroup(),
+			"aws_identitystore_user":              identitystore.ResourceUser(),
+			"aws_identitystore_group_membership":  identitystore.ResourceGroupMembership(),
+			"aws_identitystore_group_memberships": identitystore.ResourceGroupMemberships(),
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_container_recipe":             imagebuilder.ResourceContainerRecipe(),
@@ -1649,10 +1791,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_inspector2_delegated_admin_account":    inspector2.ResourceDelegatedAdminAccount(),
 			"aws_inspector2_enabler":                    inspector2.ResourceEnabler(),
+			"aws_inspector2_filter":                     inspector2.ResourceFilter(),
 			"aws_inspector2_organization_configuration": inspector2.ResourceOrganizationConfiguration(),
 
 			"aws_iot_authorizer":                 iot.ResourceAuthorizer(),
 			"aws_iot_certificate":                iot.ResourceCertificate(),
+			"aws_iot_domain_configuration":       iot.ResourceDomainConfiguration(),
+			"aws_iot_fleet_metric":               iot.ResourceFleetMetric(),
 			"aws_iot_indexing_configuration":     iot.ResourceIndexingConfiguration(),
 			"aws_iot_logging_options":            iot.ResourceLoggingOptions(),
 			"aws_iot_policy":                     iot.ResourcePolicy(),
@@ -1737,6 +1882,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_licensemanager_association":           licensemanager.ResourceAssociation(),
 			"aws_licensemanager_license_configuration": licensemanager.ResourceLicenseConfiguration(),
 
+			"aws_lightsail_bucket_access_key":                    lightsail.ResourceBucketAccessKey(),
 			"aws_lightsail_certificate":                          lightsail.ResourceCertificate(),
 			"aws_lightsail_container_service":                    lightsail.ResourceContainerService(),
 			"aws_lightsail_container_service_deployment_version": lightsail.ResourceContainerServiceDeploymentVersion(),
@@ -1764,18 +1910,29 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_location_tracker":             location.ResourceTracker(),
 			"aws_location_tracker_association": location.ResourceTrackerAssociation(),
 
+			"aws_m2_application": m2.ResourceApplication(),
+			"aws_m2_deployment":  m2.ResourceDeployment(),
+			"aws_m2_environment": m2.ResourceEnvironment(),
+
 			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
 
 			"aws_macie2_account":                             macie2.ResourceAccount(),
+			"aws_macie2_automated_discovery_configuration":   macie2.ResourceAutomatedDiscoveryConfiguration(),
 			"aws_macie2_classification_job":                  macie2.ResourceClassificationJob(),
+			"aws_macie2_classification_scope":                macie2.ResourceClassificationScope(),
 			"aws_macie2_custom_data_identifier":              macie2.ResourceCustomDataIdentifier(),
 			"aws_macie2_findings_filter":                     macie2.ResourceFindingsFilter(),
 			"aws_macie2_invitation_accepter":                 macie2.ResourceInvitationAccepter(),
 			"aws_macie2_member":                              macie2.ResourceMember(),
 			"aws_macie2_organization_admin_account":          macie2.ResourceOrganizationAdminAccount(),
+			"aws_macie2_sensitivity_inspection_template":     macie2.ResourceSensitivityInspectionTemplate(),
 			"aws_macie2_classification_export_configuration": macie2.ResourceClassificationExportConfiguration(),
 
+			"aws_managedblockchain_accessor":      managedblockchain.ResourceAccessor(),
+			"aws_managedblockchain_proposal":      managedblockchain.ResourceProposal(),
+			"aws_managedblockchain_proposal_vote": managedblockchain.ResourceProposalVote(),
+
 			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
 
 			"aws_media_package_channel": mediapackage.ResourceChannel(),
@@ -1876,18 +2033,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
-			"aws_qldb_ledger": qldb.ResourceLedger(),
-			"aws_qldb_stream": qldb.ResourceStream(),
-
-			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
-			"aws_quicksight_group":            quicksight.ResourceGroup(),
-			"aws_quicksight_group_membership": quicksight.ResourceGroupMembership(),
-			"aws_quicksight_user":             quicksight.ResourceUser(),
-
-			"aws_ram_principal_association":   ram.ResourcePrincipalAssociation(),
-			"aws_ram_resource_association":    ram.ResourceResourceAssociation(),
-			"aws_ram_resource_share":          ram.ResourceResourceShare(),
-			"aws_ram_resource_share_accepter": ram.ResourceResourceShareAccepter(),
+			"aws_pinpointsmsvoicev2_configuration_set": pinpointsmsvoicev2.ResourceConfigurationSet(),
+			"aws_pinpointsmsvoicev2_event_destination": pinpointsmsvoicev2.ResourceEventDestination(),
+			"aws_pinpointsmsvoicev2_opt_out_list":      pinpointsmsvoicev2.ResourceOptOutList(),
+			"aws_pinpointsmsvoicev2_phone_number":      pinpointsmsvoicev2.ResourcePhoneNumber(),
+			"aws_pinpointsmsvoicev2_pool":              pinpointsmsvoicev2.ResourcePool(),
+
+			"aws_qldb_journal_s3_export": qldb.ResourceJournalS3Export(),
+			"aws_qldb_ledger":            qldb.ResourceLedger(),
+			"aws_qldb_stream":            qldb.ResourceStream(),
+
+			"aws_quicksight_data_source":       quicksight.ResourceDataSource(),
+			"aws_quicksight_folder":            quicksight.ResourceFolder(),
+			"aws_quicksight_folder_membership": quicksight.ResourceFolderMembership(),
+			"aws_quicksight_group":             quicksight.ResourceGroup(),
+			"aws_quicksight_group_membership":  quicksight.ResourceGroupMembership(),
+			"aws_quicksight_user":              quicksight.ResourceUser(),
+
+			"aws_ram_principal_association":                 ram.ResourcePrincipalAssociation(),
+			"aws_ram_resource_association":                  ram.ResourceResourceAssociation(),
+			"aws_ram_resource_share":                        ram.ResourceResourceShare(),
+			"aws_ram_resource_share_accepter":               ram.ResourceResourceShareAccepter(),
+			"aws_ram_resource_share_permission_association": ram.ResourceResourceSharePermissionAssociation(),
 
 			"aws_db_cluster_snapshot":                       rds.ResourceClusterSnapshot(),
 			"aws_db_event_subscription":                     rds.ResourceEventSubscription(),
@@ -1940,6 +2107,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_redshiftserverless_usage_limit":     redshiftserverless.ResourceUsageLimit(),
 			"aws_redshiftserverless_workgroup":       redshiftserverless.ResourceWorkgroup(),
 
+			"aws_resiliencehub_app":               resiliencehub.ResourceApp(),
+			"aws_resiliencehub_app_assessment":    resiliencehub.ResourceAppAssessment(),
+			"aws_resiliencehub_app_version":       resiliencehub.ResourceAppVersion(),
+			"aws_resiliencehub_resiliency_policy": resiliencehub.ResourceResiliencyPolicy(),
+
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
 			"aws_rolesanywhere_profile":      rolesanywhere.ResourceProfile(),
@@ -2071,6 +2243,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_securityhub_standards_subscription":     securityhub.ResourceStandardsSubscription(),
 			"aws_securityhub_finding_aggregator":         securityhub.ResourceFindingAggregator(),
 
+			"aws_securitylake_aws_log_source":          securitylake.ResourceAWSLogSource(),
+			"aws_securitylake_custom_log_source":       securitylake.ResourceCustomLogSource(),
+			"aws_securitylake_data_lake":               securitylake.ResourceDataLake(),
+			"aws_securitylake_subscriber":              securitylake.ResourceSubscriber(),
+			"aws_securitylake_subscriber_notification": securitylake.ResourceSubscriberNotification(),
+
 			"aws_serverlessapplicationrepository_cloudformation_stack": serverlessrepo.ResourceCloudFormationStack(),
 
 			"aws_servicecatalog_budget_resource_association":     servicecatalog.ResourceBudgetResourceAssociation(),
@@ -2089,11 +2267,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_service_discovery_http_namespace":        servicediscovery.ResourceHTTPNamespace(),
 			"aws_service_discovery_instance":              servicediscovery.ResourceInstance(),
+			"aws_service_discovery_instances":             servicediscovery.ResourceInstances(),
 			"aws_service_discovery_private_dns_namespace": servicediscovery.ResourcePrivateDNSNamespace(),
 			"aws_service_discovery_public_dns_namespace":  servicediscovery.ResourcePublicDNSNamespace(),
 			"aws_service_discovery_service":               servicediscovery.ResourceService(),
 
-			"aws_servicequotas_service_quota": servicequotas.ResourceServiceQuota(),
+			"aws_servicequotas_service_quota":        servicequotas.ResourceServiceQuota(),
+			"aws_servicequotas_template":             servicequotas.ResourceTemplate(),
+			"aws_servicequotas_template_association": servicequotas.ResourceTemplateAssociation(),
 
 			"aws_ses_active_receipt_rule_set":      ses.ResourceActiveReceiptRuleSet(),
 			"aws_ses_configuration_set":            ses.ResourceConfigurationSet(),
@@ -2120,14 +2301,19 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_sfn_activity":      sfn.ResourceActivity(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
-			"aws_shield_protection":                          shield.ResourceProtection(),
-			"aws_shield_protection_group":                    shield.ResourceProtectionGroup(),
-			"aws_shield_protection_health_check_association": shield.ResourceProtectionHealthCheckAssociation(),
+			"aws_shield_proactive_engagement":                 shield.ResourceProactiveEngagement(),
+			"aws_shield_protection":                           shield.ResourceProtection(),
+			"aws_shield_protection_group":                     shield.ResourceProtectionGroup(),
+			"aws_shield_protection_health_check_association":  shield.ResourceProtectionHealthCheckAssociation(),
+			"aws_shield_protection_health_check_associations": shield.ResourceProtectionHealthCheckAssociations(),
 
 			"aws_signer_signing_job":                signer.ResourceSigningJob(),
 			"aws_signer_signing_profile":            signer.ResourceSigningProfile(),
 			"aws_signer_signing_profile_permission": signer.ResourceSigningProfilePermission(),
 
+			"aws_snowball_address": snowball.ResourceAddress(),
+			"aws_snowball_job":     snowball.ResourceJob(),
+
 			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
 			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
 			"aws_sns_topic":                sns.ResourceTopic(),
@@ -2170,6 +2356,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_storagegateway_upload_buffer":           storagegateway.ResourceUploadBuffer(),
 			"aws_storagegateway_working_storage":         storagegateway.ResourceWorkingStorage(),
 
+			"aws_supportapp_slack_channel_configuration": supportapp.ResourceSlackChannelConfiguration(),
+
 			"aws_swf_domain": swf.ResourceDomain(),
 
 			"aws_synthetics_canary": synthetics.ResourceCanary(),
@@ -2230,14 +2418,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_workspaces_ip_group":  workspaces.ResourceIPGroup(),
 			"aws_workspaces_workspace": workspaces.ResourceWorkspace(),
 
+			"aws_workspacesweb_browser_settings": workspacesweb.ResourceBrowserSettings(),
+			"aws_workspacesweb_network_settings": workspacesweb.ResourceNetworkSettings(),
+			"aws_workspacesweb_portal":           workspacesweb.ResourcePortal(),
+			"aws_workspacesweb_trust_store":      workspacesweb.ResourceTrustStore(),
+			"aws_workspacesweb_user_settings":    workspacesweb.ResourceUserSettings(),
+
 			"aws_xray_encryption_config": xray.ResourceEncryptionConfig(),
 			"aws_xray_group":             xray.ResourceGroup(),
+			"aws_xray_resource_policy":   xray.ResourceResourcePolicy(),
 			"aws_xray_sampling_rule":     xray.ResourceSamplingRule(),
 		},
 	}
 
+	// The resources as declared, before any provider-level default timeouts are applied.
+	var resources map[string]*schema.Resource
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+		return configure(ctx, provider, resources, d)
 	}
 
 	var errs *multierror.Error
@@ -2305,6 +2503,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		return nil, err
 	}
 
+	resources = provider.ResourcesMap
+
 	// Set the provider Meta (instance data) here.
 	// It will be overwritten by the result of the call to ConfigureContextFunc,
 	// but can be used pre-configuration by other (non-primary) provider servers.
@@ -2316,7 +2516,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 }
 
 // configure ensures that the provider is fully configured.
-func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData) (*conns.AWSClient, diag.Diagnostics) {
+func configure(ctx context.Context, provider *schema.Provider, resources map[string]*schema.Resource, d *schema.ResourceData) (*conns.AWSClient, diag.Diagnostics) {
 	terraformVersion := provider.TerraformVersion
 	if terraformVersion == "" {
 		// Terraform 0.12 introduced this field to the protocol
@@ -2326,6 +2526,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogPath:                   d.Get("audit_log_path").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -2366,6 +2567,18 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	// Resources are copied rather than modified, so that reconfiguration starts from the declared default timeouts.
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		var err error
+		resources, err = resourcesWithDefaultTimeouts(resources, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	provider.ResourcesMap = resources
+
 	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
 		endpoints, err := expandEndpoints(v.(*schema.Set).List())
 
@@ -2388,6 +2601,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && v.(*schema.Set).Len() > 0 {
+		rateLimits, err := expandRateLimits(v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_file"); ok {
 		config.SharedCredentialsFiles = []string{v.(string)}
 	} else if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
@@ -2688,6 +2911,105 @@ func expandDefaultTags(tfMap map[string]interface{}) *tftags.DefaultConfig {
 	return defaultConfig
 }
 
+// resourcesWithDefaultTimeouts returns the specified resources with their default timeouts overridden.
+// Any resource whose timeouts are overridden is replaced by a copy; the specified resources are not modified.
+// Timeouts configured in a resource's `timeouts` block still take precedence.
+// Only Plugin SDK resources are supported.
+func resourcesWithDefaultTimeouts(resources map[string]*schema.Resource, tfMap map[string]interface{}) (map[string]*schema.Resource, error) {
+	if tfMap == nil {
+		return resources, nil
+	}
+
+	timeouts := make(map[string]*schema.ResourceTimeout)
+
+	if v, ok := tfMap["multiplier"].(float64); ok && v > 1 {
+		for typeName, r := range resources {
+			if r.Timeouts == nil {
+				continue
+			}
+
+			t := *r.Timeouts
+
+			for _, d := range []**time.Duration{&t.Create, &t.Read, &t.Update, &t.Delete, &t.Default} {
+				if *d != nil {
+					duration := time.Duration(float64(**d) * v)
+					*d = &duration
+				}
+			}
+
+			timeouts[typeName] = &t
+		}
+	}
+
+	for _, tfMapRaw := range tfMap["resource"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		typeName := tfMap["type"].(string)
+		r, ok := resources[typeName]
+
+		if !ok {
+			return nil, fmt.Errorf("default_timeouts: unknown resource type (%s), or resource type is implemented with the Terraform Plugin Framework", typeName)
+		}
+
+		if r.Timeouts == nil {
+			return nil, fmt.Errorf("default_timeouts: resource type (%s) does not support timeouts", typeName)
+		}
+
+		t, ok := timeouts[typeName]
+
+		if !ok {
+			v := *r.Timeouts
+			t = &v
+		}
+
+		for key, d := range map[string]**time.Duration{
+			schema.TimeoutCreate: &t.Create,
+			schema.TimeoutRead:   &t.Read,
+			schema.TimeoutUpdate: &t.Update,
+			schema.TimeoutDelete: &t.Delete,
+		} {
+			v, ok := tfMap[key].(string)
+
+			if !ok || v == "" {
+				continue
+			}
+
+			// Only timeouts that the resource itself supports can be overridden.
+			if *d == nil && t.Default == nil {
+				return nil, fmt.Errorf("default_timeouts: resource type (%s) does not support a %s timeout", typeName, key)
+			}
+
+			duration, err := time.ParseDuration(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("default_timeouts: resource type (%s) %s timeout: %w", typeName, key, err)
+			}
+
+			*d = &duration
+		}
+
+		timeouts[typeName] = t
+	}
+
+	output := make(map[string]*schema.Resource, len(resources))
+
+	for typeName, r := range resources {
+		if t, ok := timeouts[typeName]; ok {
+			v := *r
+			v.Timeouts = t
+			r = &v
+		}
+
+		output[typeName] = r
+	}
+
+	return output, nil
+}
+
 func expandIgnoreTags(tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -2706,6 +3028,40 @@ func expandIgnoreTags(tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	return ignoreConfig
 }
 
+func expandRateLimits(tfList []interface{}) (map[string]conns.RateLimit, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	rateLimits := make(map[string]conns.RateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		alias := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("rate_limit: %w", err)
+		}
+
+		if _, ok := rateLimits[pkg]; ok {
+			return nil, fmt.Errorf("rate_limit: duplicate service (%s)", alias)
+		}
+
+		rateLimits[pkg] = conns.RateLimit{
+			Burst:             tfMap["burst"].(int),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+		}
+	}
+
+	return rateLimits, nil
+}
+
 func expandEndpoints(tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -4,7 +4,9 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -164,6 +166,130 @@ func TestEndpointEnvVarPrecedence(t *testing.T) {
 	}
 }
 
+func TestExpandRateLimits(t *testing.T) {
+	results, err := expandRateLimits([]interface{}{
+		map[string]interface{}{
+			"burst":               0,
+			"requests_per_second": 2.5,
+			"service":             "route53",
+		},
+		map[string]interface{}{
+			"burst":               10,
+			"requests_per_second": 5.0,
+			"service":             "iam",
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(results), 2; got != expected {
+		t.Fatalf("got %d rate limits, expected %d", got, expected)
+	}
+
+	if got := results[names.IAM]; got.Burst != 10 || got.RequestsPerSecond != 5 {
+		t.Errorf("unexpected IAM rate limit: %#v", got)
+	}
+
+	for _, tfList := range [][]interface{}{
+		{
+			map[string]interface{}{"burst": 0, "requests_per_second": 1.0, "service": "unknown"},
+		},
+		{
+			map[string]interface{}{"burst": 0, "requests_per_second": 1.0, "service": "iam"},
+			map[string]interface{}{"burst": 1, "requests_per_second": 1.0, "service": "iam"},
+		},
+	} {
+		if _, err := expandRateLimits(tfList); err == nil {
+			t.Errorf("expected error for %v", tfList)
+		}
+	}
+}
+
+func TestResourcesWithDefaultTimeouts(t *testing.T) {
+	tenMinutes, twentyMinutes := 10*time.Minute, 20*time.Minute
+	resources := map[string]*schema.Resource{
+		"aws_test_one": {
+			Timeouts: &schema.ResourceTimeout{
+				Create: &tenMinutes,
+				Delete: &tenMinutes,
+			},
+		},
+		"aws_test_two": {
+			Timeouts: &schema.ResourceTimeout{
+				Default: &twentyMinutes,
+			},
+		},
+		"aws_test_three": {},
+	}
+
+	got, err := resourcesWithDefaultTimeouts(resources, map[string]interface{}{
+		"multiplier": 1.5,
+		"resource": []interface{}{
+			map[string]interface{}{
+				"type":   "aws_test_one",
+				"create": "1h",
+			},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v, expected := *got["aws_test_one"].Timeouts.Create, time.Hour; v != expected {
+		t.Errorf("aws_test_one create timeout: got %s, expected %s", v, expected)
+	}
+
+	if v, expected := *got["aws_test_one"].Timeouts.Delete, 15*time.Minute; v != expected {
+		t.Errorf("aws_test_one delete timeout: got %s, expected %s", v, expected)
+	}
+
+	if v := got["aws_test_one"].Timeouts.Update; v != nil {
+		t.Errorf("aws_test_one update timeout: got %s, expected nil", v)
+	}
+
+	if v, expected := *got["aws_test_two"].Timeouts.Default, 30*time.Minute; v != expected {
+		t.Errorf("aws_test_two default timeout: got %s, expected %s", v, expected)
+	}
+
+	if v := got["aws_test_three"].Timeouts; v != nil {
+		t.Errorf("aws_test_three timeouts: got %v, expected nil", v)
+	}
+
+	// Reconfiguration must start from the declared timeouts.
+	if v, expected := *resources["aws_test_one"].Timeouts.Delete, 10*time.Minute; v != expected {
+		t.Errorf("declared aws_test_one delete timeout: got %s, expected %s", v, expected)
+	}
+
+	if v, expected := *resources["aws_test_two"].Timeouts.Default, 20*time.Minute; v != expected {
+		t.Errorf("declared aws_test_two default timeout: got %s, expected %s", v, expected)
+	}
+
+	if got["aws_test_three"] != resources["aws_test_three"] {
+		t.Errorf("aws_test_three: expected declared resource")
+	}
+
+	if tenMinutes != 10*time.Minute {
+		t.Errorf("original timeout modified: got %s", tenMinutes)
+	}
+
+	for _, tfMap := range []map[string]interface{}{
+		{"type": "aws_test_unknown", "create": "1h"},
+		{"type": "aws_test_one", "update": "1h"},
+		{"type": "aws_test_three", "create": "1h"},
+	} {
+		_, err := resourcesWithDefaultTimeouts(resources, map[string]interface{}{
+			"resource": []interface{}{tfMap},
+		})
+
+		if err == nil {
+			t.Errorf("expected error for %v", tfMap)
+		}
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
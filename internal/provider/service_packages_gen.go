@@ -60,6 +60,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/devopsguru"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
@@ -94,6 +95,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/health"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
@@ -120,8 +123,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/m2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
@@ -134,12 +139,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/oam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -148,6 +155,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
@@ -166,6 +174,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/serverlessrepo"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
@@ -176,12 +185,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/support"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/supportapp"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
@@ -192,6 +204,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 	"golang.org/x/exp/slices"
 )
@@ -252,6 +265,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		deploy.ServicePackage,
 		detective.ServicePackage,
 		devicefarm.ServicePackage,
+		devopsguru.ServicePackage,
 		directconnect.ServicePackage,
 		dlm.ServicePackage,
 		dms.ServicePackage,
@@ -286,6 +300,8 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		grafana.ServicePackage,
 		greengrass.ServicePackage,
 		guardduty.ServicePackage,
+		health.ServicePackage,
+		healthlake.ServicePackage,
 		iam.ServicePackage,
 		identitystore.ServicePackage,
 		imagebuilder.ServicePackage,
@@ -312,8 +328,10 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		lightsail.ServicePackage,
 		location.ServicePackage,
 		logs.ServicePackage,
+		m2.ServicePackage,
 		macie.ServicePackage,
 		macie2.ServicePackage,
+		managedblockchain.ServicePackage,
 		mediaconnect.ServicePackage,
 		mediaconvert.ServicePackage,
 		medialive.ServicePackage,
@@ -326,12 +344,14 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		neptune.ServicePackage,
 		networkfirewall.ServicePackage,
 		networkmanager.ServicePackage,
+		oam.ServicePackage,
 		opensearch.ServicePackage,
 		opensearchserverless.ServicePackage,
 		opsworks.ServicePackage,
 		organizations.ServicePackage,
 		outposts.ServicePackage,
 		pinpoint.ServicePackage,
+		pinpointsmsvoicev2.ServicePackage,
 		pricing.ServicePackage,
 		qldb.ServicePackage,
 		quicksight.ServicePackage,
@@ -340,6 +360,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		redshift.ServicePackage,
 		redshiftdata.ServicePackage,
 		redshiftserverless.ServicePackage,
+		resiliencehub.ServicePackage,
 		resourceexplorer2.ServicePackage,
 		resourcegroups.ServicePackage,
 		resourcegroupstaggingapi.ServicePackage,
@@ -358,6 +379,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		schemas.ServicePackage,
 		secretsmanager.ServicePackage,
 		securityhub.ServicePackage,
+		securitylake.ServicePackage,
 		serverlessrepo.ServicePackage,
 		servicecatalog.ServicePackage,
 		servicediscovery.ServicePackage,
@@ -368,12 +390,15 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		shield.ServicePackage,
 		signer.ServicePackage,
 		simpledb.ServicePackage,
+		snowball.ServicePackage,
 		sns.ServicePackage,
 		sqs.ServicePackage,
 		ssm.ServicePackage,
 		ssoadmin.ServicePackage,
 		storagegateway.ServicePackage,
 		sts.ServicePackage,
+		support.ServicePackage,
+		supportapp.ServicePackage,
 		swf.ServicePackage,
 		synthetics.ServicePackage,
 		timestreamwrite.ServicePackage,
@@ -384,6 +409,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		wafv2.ServicePackage,
 		worklink.ServicePackage,
 		workspaces.ServicePackage,
+		workspacesweb.ServicePackage,
 		xray.ServicePackage,
 	}
 
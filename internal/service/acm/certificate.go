@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/duration"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 const (
@@ -170,6 +172,13 @@ func ResourceCertificate() *schema.Resource {
 				Sensitive:    true,
 				ExactlyOneOf: []string{"domain_name", "private_key"},
 			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
 			"renewal_eligibility": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -179,6 +188,38 @@ func ResourceCertificate() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"domain_validation_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"domain_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_record_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_record_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_record_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"validation_method": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"validation_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"renewal_status": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -327,7 +368,7 @@ func ResourceCertificate() *schema.Resource {
 }
 
 func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := regionalConn(meta, d.Get("region").(string))
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -414,7 +455,7 @@ func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := regionalConn(meta, regionFromARN(d.Id()))
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -468,6 +509,7 @@ func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta i
 	} else {
 		d.Set("options", nil)
 	}
+	d.Set("region", conn.Config.Region)
 	d.Set("renewal_eligibility", certificate.RenewalEligibility)
 	if certificate.RenewalSummary != nil {
 		if err := d.Set("renewal_summary", []interface{}{flattenRenewalSummary(certificate.RenewalSummary)}); err != nil {
@@ -505,7 +547,7 @@ func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := regionalConn(meta, regionFromARN(d.Id()))
 
 	if d.HasChanges("private_key", "certificate_body", "certificate_chain") {
 		oCBRaw, nCBRaw := d.GetChange("certificate_body")
@@ -557,7 +599,7 @@ func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := regionalConn(meta, regionFromARN(d.Id()))
 
 	log.Printf("[INFO] Deleting ACM Certificate: %s", d.Id())
 	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, certificateCrossServicePropagationTimeout,
@@ -578,6 +620,29 @@ func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// regionalConn returns an ACM API client for the specified Region.
+// The provider's API client is returned if no Region is specified.
+func regionalConn(meta interface{}, region string) *acm.ACM {
+	client := meta.(*conns.AWSClient)
+
+	if region == "" || region == client.Region {
+		return client.ACMConn
+	}
+
+	return acm.New(client.RegionalSession(names.ACM, region))
+}
+
+// regionFromARN returns the Region of the specified ACM Certificate ARN, or "" if it cannot be parsed.
+func regionFromARN(s string) string {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return ""
+	}
+
+	return v.Region
+}
+
 func certificateValidationMethod(certificate *acm.CertificateDetail) string {
 	if aws.StringValue(certificate.Type) == acm.CertificateTypeAmazonIssued {
 		for _, v := range certificate.DomainValidationOptions {
@@ -767,6 +832,10 @@ func flattenRenewalSummary(apiObject *acm.RenewalSummary) map[string]interface{}
 
 	tfMap := map[string]interface{}{}
 
+	if v := apiObject.DomainValidationOptions; v != nil {
+		tfMap["domain_validation_options"] = flattenRenewalDomainValidations(v)
+	}
+
 	if v := apiObject.RenewalStatus; v != nil {
 		tfMap["renewal_status"] = aws.StringValue(v)
 	}
@@ -782,6 +851,40 @@ func flattenRenewalSummary(apiObject *acm.RenewalSummary) map[string]interface{}
 	return tfMap
 }
 
+// flattenRenewalDomainValidations flattens the per-domain validation state
+// reported for a managed renewal. Unlike flattenDomainValidations, domains
+// without a DNS resource record are retained so that email-validated domains
+// stuck in PENDING_VALIDATION are still visible.
+func flattenRenewalDomainValidations(apiObjects []*acm.DomainValidation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"domain_name":       aws.StringValue(apiObject.DomainName),
+			"validation_method": aws.StringValue(apiObject.ValidationMethod),
+			"validation_status": aws.StringValue(apiObject.ValidationStatus),
+		}
+
+		if v := apiObject.ResourceRecord; v != nil {
+			tfMap["resource_record_name"] = aws.StringValue(v.Name)
+			tfMap["resource_record_type"] = aws.StringValue(v.Type)
+			tfMap["resource_record_value"] = aws.StringValue(v.Value)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func isChangeNormalizeCertRemoval(oldRaw, newRaw interface{}) bool {
 	old, ok := oldRaw.(string)
 
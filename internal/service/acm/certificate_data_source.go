@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func DataSourceCertificate() *schema.Resource {
@@ -49,6 +50,12 @@ func DataSourceCertificate() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -69,7 +76,7 @@ func DataSourceCertificate() *schema.Resource {
 }
 
 func dataSourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := regionalConn(meta, d.Get("region").(string))
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	params := &acm.ListCertificatesInput{}
@@ -200,6 +207,7 @@ func dataSourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.StringValue(matchedCertificate.CertificateArn))
 	d.Set("arn", matchedCertificate.CertificateArn)
+	d.Set("region", conn.Config.Region)
 	d.Set("status", matchedCertificate.Status)
 
 	tags, err := ListTagsWithContext(ctx, conn, aws.StringValue(matchedCertificate.CertificateArn))
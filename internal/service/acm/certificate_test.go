@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -63,6 +64,39 @@ func TestAccACMCertificate_emailValidation(t *testing.T) {
 	})
 }
 
+func TestAccACMCertificate_region(t *testing.T) {
+	resourceName := "aws_acm_certificate.test"
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	var v acm.CertificateDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, acm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_region(domain, acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:acm:%s:\d{12}:certificate/.+$`, acctest.AlternateRegion()))),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domain),
+					resource.TestCheckResourceAttr(resourceName, "region", acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccACMCertificate_dnsValidation(t *testing.T) {
 	resourceName := "aws_acm_certificate.test"
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
@@ -1567,9 +1601,7 @@ func testAccCheckCertificateExists(n string, v *acm.CertificateDetail) resource.
 			return fmt.Errorf("no ACM Certificate ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn
-
-		output, err := tfacm.FindCertificateByARN(context.Background(), conn, rs.Primary.ID)
+		output, err := tfacm.FindCertificateByARN(context.Background(), testAccCertificateConn(rs.Primary.ID), rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -1582,14 +1614,12 @@ func testAccCheckCertificateExists(n string, v *acm.CertificateDetail) resource.
 }
 
 func testAccCheckCertificateDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn
-
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acm_certificate" {
 			continue
 		}
 
-		_, err := tfacm.FindCertificateByARN(context.Background(), conn, rs.Primary.ID)
+		_, err := tfacm.FindCertificateByARN(context.Background(), testAccCertificateConn(rs.Primary.ID), rs.Primary.ID)
 
 		if tfresource.NotFound(err) {
 			continue
@@ -1605,6 +1635,17 @@ func testAccCheckCertificateDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCertificateConn returns an ACM API client for the Region of the specified ACM Certificate.
+func testAccCertificateConn(certificateARN string) *acm.ACM {
+	client := acctest.Provider.Meta().(*conns.AWSClient)
+
+	if v, err := arn.Parse(certificateARN); err == nil && v.Region != client.Region {
+		return acm.New(client.Session, aws.NewConfig().WithRegion(v.Region))
+	}
+
+	return client.ACMConn
+}
+
 func testAccCheckCertficateNotRecreated(v1, v2 *acm.CertificateDetail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if aws.StringValue(v1.CertificateArn) != aws.StringValue(v2.CertificateArn) {
@@ -1643,6 +1684,16 @@ resource "aws_acm_certificate" "test" {
 `, domainName, validationMethod)
 }
 
+func testAccCertificateConfig_region(domainName, region string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[1]q
+  validation_method = "DNS"
+  region            = %[2]q
+}
+`, domainName, region)
+}
+
 func testAccCertificateConfig_validationOptions(rootDomainName, domainName string) string {
 	return fmt.Sprintf(`
 resource "aws_acm_certificate" "test" {
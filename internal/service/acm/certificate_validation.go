@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -45,9 +44,8 @@ func ResourceCertificateValidation() *schema.Resource {
 }
 
 func resourceCertificateValidationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ACMConn
-
 	arn := d.Get("certificate_arn").(string)
+	conn := regionalConn(meta, regionFromARN(arn))
 	certificate, err := FindCertificateByARN(ctx, conn, arn)
 
 	if err != nil {
@@ -98,9 +96,8 @@ func resourceCertificateValidationCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCertificateValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ACMConn
-
 	arn := d.Get("certificate_arn").(string)
+	conn := regionalConn(meta, regionFromARN(arn))
 	certificate, err := FindCertificateValidationByARN(ctx, conn, arn)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -1,6 +1,7 @@
 package acmpca
 
 import (
+	"context"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
@@ -61,7 +62,7 @@ func ResourceCertificate() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: ValidCertificateAuthorityARN,
 			},
 			"certificate_signing_request": {
 				Type:     schema.TypeString,
@@ -104,9 +105,40 @@ func ResourceCertificate() *schema.Resource {
 				ValidateFunc: ValidTemplateARN,
 			},
 		},
+
+		CustomizeDiff: resourceCertificateCustomizeDiff,
 	}
 }
 
+// resourceCertificateCustomizeDiff ensures that the template is in the same
+// partition as the issuing certificate authority, which may be owned by another
+// account and shared via AWS RAM.
+func resourceCertificateCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	caARN, templateARN := diff.Get("certificate_authority_arn").(string), diff.Get("template_arn").(string)
+
+	if caARN == "" || templateARN == "" {
+		return nil
+	}
+
+	caParsedARN, err := arn.Parse(caARN)
+
+	if err != nil {
+		return nil
+	}
+
+	templateParsedARN, err := arn.Parse(templateARN)
+
+	if err != nil {
+		return nil
+	}
+
+	if caParsedARN.Partition != templateParsedARN.Partition {
+		return fmt.Errorf("template_arn partition (%s) must match certificate_authority_arn partition (%s)", templateParsedARN.Partition, caParsedARN.Partition)
+	}
+
+	return nil
+}
+
 func resourceCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ACMPCAConn
 
@@ -228,6 +260,35 @@ func resourceCertificateRevoke(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func ValidCertificateAuthorityARN(v interface{}, k string) (ws []string, errors []error) {
+	wsARN, errorsARN := verify.ValidARN(v, k)
+	ws = append(ws, wsARN...)
+	errors = append(errors, errorsARN...)
+
+	if len(errors) == 0 {
+		value := v.(string)
+		parsedARN, _ := arn.Parse(value)
+
+		if parsedARN.Service != acmpca.ServiceName {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid ACM PCA certificate authority ARN: service must be \""+acmpca.ServiceName+"\", was %q)", k, value, parsedARN.Service))
+		}
+
+		if parsedARN.Region == "" {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid ACM PCA certificate authority ARN: region must not be empty)", k, value))
+		}
+
+		if parsedARN.AccountID == "" {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid ACM PCA certificate authority ARN: account ID must not be empty)", k, value))
+		}
+
+		if parts := strings.Split(parsedARN.Resource, "/"); len(parts) != 2 || parts[0] != "certificate-authority" || parts[1] == "" {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid ACM PCA certificate authority ARN: expected resource of the form \"certificate-authority/<id>\", was %q)", k, value, parsedARN.Resource))
+		}
+	}
+
+	return ws, errors
+}
+
 func ValidTemplateARN(v interface{}, k string) (ws []string, errors []error) {
 	wsARN, errorsARN := verify.ValidARN(v, k)
 	ws = append(ws, wsARN...)
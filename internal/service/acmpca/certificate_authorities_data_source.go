@@ -0,0 +1,76 @@
+package acmpca
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceCertificateAuthorities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCertificateAuthoritiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      acmpca.ResourceOwnerSelf,
+				ValidateFunc: validation.StringInSlice(acmpca.ResourceOwner_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(acmpca.CertificateAuthorityStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceCertificateAuthoritiesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ACMPCAConn
+
+	resourceOwner := d.Get("resource_owner").(string)
+	input := &acmpca.ListCertificateAuthoritiesInput{
+		ResourceOwner: aws.String(resourceOwner),
+	}
+	status := d.Get("status").(string)
+	var arns []string
+
+	err := conn.ListCertificateAuthoritiesPages(input, func(page *acmpca.ListCertificateAuthoritiesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CertificateAuthorities {
+			if v == nil {
+				continue
+			}
+
+			if status != "" && aws.StringValue(v.Status) != status {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(v.Arn))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("listing ACM PCA Certificate Authorities: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", meta.(*conns.AWSClient).Region, resourceOwner))
+	d.Set("arns", arns)
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package acmpca_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccACMPCACertificateAuthoritiesDataSource_basic(t *testing.T) {
+	resourceName := "aws_acmpca_certificate_authority.test"
+	dataSourceName := "data.aws_acmpca_certificate_authorities.test"
+
+	commonName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, acmpca.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateAuthoritiesDataSourceConfig_basic(commonName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_owner", acmpca.ResourceOwnerSelf),
+				),
+			},
+		},
+	})
+}
+
+func TestAccACMPCACertificateAuthoritiesDataSource_otherAccounts(t *testing.T) {
+	resourceName := "aws_acmpca_certificate_authority.test"
+	dataSourceName := "data.aws_acmpca_certificate_authorities.test"
+
+	commonName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, acmpca.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateAuthoritiesDataSourceConfig_otherAccounts(commonName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_owner", acmpca.ResourceOwnerOtherAccounts),
+				),
+			},
+		},
+	})
+}
+
+func testAccCertificateAuthoritiesDataSourceConfig_basic(commonName string) string {
+	return fmt.Sprintf(`
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = %[1]q
+    }
+  }
+}
+
+data "aws_acmpca_certificate_authorities" "test" {
+  depends_on = [aws_acmpca_certificate_authority.test]
+}
+`, commonName)
+}
+
+func testAccCertificateAuthoritiesDataSourceConfig_otherAccounts(commonName string) string {
+	return acctest.ConfigCompose(testAccCertificateConfig_sharedCABase(commonName), `
+data "aws_acmpca_certificate_authorities" "test" {
+  resource_owner = "OTHER_ACCOUNTS"
+
+  depends_on = [aws_ram_resource_share_accepter.test]
+}
+`)
+}
@@ -230,6 +230,38 @@ func TestAccACMPCACertificate_Validity_absolute(t *testing.T) {
 	})
 }
 
+func TestAccACMPCACertificate_crossAccount(t *testing.T) {
+	resourceName := "aws_acmpca_certificate.test"
+	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
+
+	csrDomain := acctest.RandomDomainName()
+	csr, _ := acctest.TLSRSAX509CertificateRequestPEM(t, 4096, csrDomain)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, acmpca.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_crossAccount(domain, acctest.TLSPEMEscapeNewlines(csr)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_chain"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", certificateAuthorityResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_signing_request", csr),
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "template_arn", "acm-pca", "template/EndEntityCertificate/V1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCertificateDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMPCAConn
 
@@ -413,6 +445,108 @@ resource "aws_acmpca_certificate" "test" {
 `, csr, expiry))
 }
 
+func testAccCertificateConfig_crossAccount(domain, csr string) string {
+	return acctest.ConfigCompose(
+		testAccCertificateConfig_sharedCABase(domain),
+		fmt.Sprintf(`
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = "%[1]s"
+  signing_algorithm           = "SHA256WITHRSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/EndEntityCertificate/V1"
+
+  validity {
+    type  = "DAYS"
+    value = 1
+  }
+
+  depends_on = [aws_ram_resource_share_accepter.test]
+}
+`, csr))
+}
+
+// testAccCertificateConfig_sharedCABase creates an active root CA in the
+// alternate account and shares it with the default account through RAM.
+func testAccCertificateConfig_sharedCABase(domain string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		fmt.Sprintf(`
+resource "aws_acmpca_certificate_authority" "test" {
+  provider = "awsalternate"
+
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = %[1]q
+    }
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  provider = "awsalternate"
+
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+
+  certificate       = aws_acmpca_certificate.root.certificate
+  certificate_chain = aws_acmpca_certificate.root.certificate_chain
+}
+
+resource "aws_acmpca_certificate" "root" {
+  provider = "awsalternate"
+
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.test.certificate_signing_request
+  signing_algorithm           = "SHA512WITHRSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 2
+  }
+}
+
+resource "aws_ram_resource_share" "test" {
+  provider = "awsalternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_resource_association" "test" {
+  provider = "awsalternate"
+
+  resource_arn       = aws_acmpca_certificate_authority.test.arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+
+  depends_on = [aws_acmpca_certificate_authority_certificate.test]
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "awsalternate"
+
+  principal          = data.aws_caller_identity.current.account_id
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+resource "aws_ram_resource_share_accepter" "test" {
+  share_arn = aws_ram_principal_association.test.resource_share_arn
+
+  depends_on = [aws_ram_resource_association.test]
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+`, domain))
+}
+
 func testAccCertificateBaseRootCAConfig(domain string) string {
 	return fmt.Sprintf(`
 resource "aws_acmpca_certificate_authority" "root" {
@@ -453,6 +587,35 @@ data "aws_partition" "current" {}
   `, domain)
 }
 
+func TestValidateCertificateAuthorityARN(t *testing.T) {
+	validNames := []string{
+		"arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/08322ede-92f9-4200-8f21-c7d12b2b6edb",            // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:acm-pca:us-gov-west-1:123456789012:certificate-authority/08322ede-92f9-4200-8f21-c7d12b2b6edb", // lintignore:AWSAT003,AWSAT005
+		"arn:aws-cn:acm-pca:cn-north-1:123456789012:certificate-authority/08322ede-92f9-4200-8f21-c7d12b2b6edb",        // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validNames {
+		_, errors := tfacmpca.ValidCertificateAuthorityARN(v, "certificate_authority_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid ACM PCA certificate authority ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"arn",
+		"arn:aws:s3:::my_corporate_bucket/exampleobject.png",                                                                                       // lintignore:AWSAT005
+		"arn:aws:acm-pca::123456789012:certificate-authority/08322ede-92f9-4200-8f21-c7d12b2b6edb",                                                 // lintignore:AWSAT005
+		"arn:aws:acm-pca:us-west-2::certificate-authority/08322ede-92f9-4200-8f21-c7d12b2b6edb",                                                    // lintignore:AWSAT003,AWSAT005
+		"arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/08322ede-92f9-4200-8f21-c7d12b2b6edb/certificate/a4e9c2aa2ccfab625b1b913646", // lintignore:AWSAT003,AWSAT005
+		"arn:aws:acm-pca:::template/EndEntityCertificate/V1",                                                                                       // lintignore:AWSAT005
+	}
+	for _, v := range invalidNames {
+		_, errors := tfacmpca.ValidCertificateAuthorityARN(v, "certificate_authority_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ACM PCA certificate authority ARN", v)
+		}
+	}
+}
+
 func TestValidateTemplateARN(t *testing.T) {
 	validNames := []string{
 		"arn:aws:acm-pca:::template/EndEntityCertificate/V1",                     // lintignore:AWSAT005
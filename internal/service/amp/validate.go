@@ -0,0 +1,99 @@
+package amp
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ruleGroupsNamespaceData is the subset of the Prometheus rules file format checked at plan time.
+type ruleGroupsNamespaceData struct {
+	Groups []struct {
+		Name  string `yaml:"name"`
+		Rules []struct {
+			Alert  string `yaml:"alert"`
+			Expr   string `yaml:"expr"`
+			Record string `yaml:"record"`
+		} `yaml:"rules"`
+	} `yaml:"groups"`
+}
+
+// alertManagerDefinition is the Amazon Managed Service for Prometheus alert manager definition file format.
+type alertManagerDefinition struct {
+	AlertManagerConfig string            `yaml:"alertmanager_config"`
+	TemplateFiles      map[string]string `yaml:"template_files"`
+}
+
+func validRuleGroupNamespaceData(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var data ruleGroupsNamespaceData
+
+	if err := yaml.Unmarshal([]byte(value), &data); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid YAML: %s", k, err))
+		return
+	}
+
+	if len(data.Groups) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one rule group under \"groups\"", k))
+		return
+	}
+
+	names := make(map[string]struct{})
+
+	for i, group := range data.Groups {
+		if group.Name == "" {
+			errors = append(errors, fmt.Errorf("%q: rule group %d must have a name", k, i))
+			continue
+		}
+
+		if _, ok := names[group.Name]; ok {
+			errors = append(errors, fmt.Errorf("%q: rule group name %q is repeated", k, group.Name))
+		}
+		names[group.Name] = struct{}{}
+
+		for j, rule := range group.Rules {
+			switch {
+			case rule.Alert == "" && rule.Record == "":
+				errors = append(errors, fmt.Errorf("%q: rule %d in group %q must set one of \"alert\" or \"record\"", k, j, group.Name))
+			case rule.Alert != "" && rule.Record != "":
+				errors = append(errors, fmt.Errorf("%q: rule %d in group %q must not set both \"alert\" and \"record\"", k, j, group.Name))
+			}
+
+			if rule.Expr == "" {
+				errors = append(errors, fmt.Errorf("%q: rule %d in group %q must set \"expr\"", k, j, group.Name))
+			}
+		}
+	}
+
+	return
+}
+
+func validAlertManagerDefinition(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var definition alertManagerDefinition
+
+	if err := yaml.UnmarshalStrict([]byte(value), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid alert manager definition: %s", k, err))
+		return
+	}
+
+	if definition.AlertManagerConfig == "" {
+		errors = append(errors, fmt.Errorf("%q must set \"alertmanager_config\"", k))
+		return
+	}
+
+	var config map[string]interface{}
+
+	if err := yaml.Unmarshal([]byte(definition.AlertManagerConfig), &config); err != nil {
+		errors = append(errors, fmt.Errorf("%q: \"alertmanager_config\" contains invalid YAML: %s", k, err))
+		return
+	}
+
+	if _, ok := config["route"]; !ok {
+		errors = append(errors, fmt.Errorf("%q: \"alertmanager_config\" must set \"route\"", k))
+	}
+
+	return
+}
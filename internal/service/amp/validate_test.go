@@ -0,0 +1,151 @@
+package amp
+
+import (
+	"testing"
+)
+
+func TestValidRuleGroupNamespaceData(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		`
+groups:
+  - name: test
+    rules:
+      - record: metric:recording_rule
+        expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+`,
+		`
+groups:
+  - name: test
+    interval: 1m
+    rules:
+      - alert: HighRequestLatency
+        expr: job:request_latency_seconds:mean5m{job="myjob"} > 0.5
+        for: 10m
+        labels:
+          severity: page
+  - name: other
+    rules: []
+`,
+	}
+
+	for _, v := range validValues {
+		_, errors := validRuleGroupNamespaceData(v, "data")
+		if len(errors) != 0 {
+			t.Errorf("%q should be valid rule group namespace data: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		`groups: [`,
+		``,
+		`groups: []`,
+		`
+groups:
+  - rules:
+      - record: metric:recording_rule
+        expr: up
+`,
+		`
+groups:
+  - name: test
+    rules: []
+  - name: test
+    rules: []
+`,
+		`
+groups:
+  - name: test
+    rules:
+      - expr: up
+`,
+		`
+groups:
+  - name: test
+    rules:
+      - record: metric:recording_rule
+        alert: Up
+        expr: up
+`,
+		`
+groups:
+  - name: test
+    rules:
+      - record: metric:recording_rule
+`,
+	}
+
+	for _, v := range invalidValues {
+		_, errors := validRuleGroupNamespaceData(v, "data")
+		if len(errors) == 0 {
+			t.Errorf("%q should be invalid rule group namespace data", v)
+		}
+	}
+}
+
+func TestValidAlertManagerDefinition(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		`
+alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+		`
+template_files:
+  default_template: |
+    {{ define "sns.default.message" }}{{ .Status }}{{ end }}
+alertmanager_config: |
+  templates:
+    - 'default_template'
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+	}
+
+	for _, v := range validValues {
+		_, errors := validAlertManagerDefinition(v, "definition")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid alert manager definition: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		`alertmanager_config: [`,
+		``,
+		`
+route:
+  receiver: 'default'
+receivers:
+  - name: 'default'
+`,
+		`
+alertmanager_config: |
+  route: [
+`,
+		`
+alertmanager_config: |
+  receivers:
+    - name: 'default'
+`,
+		`
+alertmanager_config: |
+  route:
+    receiver: 'default'
+unknown: true
+`,
+	}
+
+	for _, v := range invalidValues {
+		_, errors := validAlertManagerDefinition(v, "definition")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid alert manager definition", v)
+		}
+	}
+}
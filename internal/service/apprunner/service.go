@@ -408,6 +408,13 @@ func ResourceService() *schema.Resource {
 			"tags": tftags.TagsSchema(),
 
 			"tags_all": tftags.TagsSchemaComputed(),
+
+			// modeled after aws_ecs_service, e.g. to redeploy when the digest of a mutable image tag changes
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -626,6 +633,29 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	// Updating the service starts a deployment, so one is only started explicitly for trigger-only changes.
+	if d.HasChange("triggers") && !d.HasChanges(
+		"auto_scaling_configuration_arn",
+		"instance_configuration",
+		"network_configuration",
+		"observability_configuration",
+		"source_configuration",
+	) {
+		input := &apprunner.StartDeploymentInput{
+			ServiceArn: aws.String(d.Id()),
+		}
+
+		_, err := conn.StartDeploymentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error starting App Runner Service (%s) deployment: %w", d.Id(), err))
+		}
+
+		if err := WaitServiceUpdated(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for App Runner Service (%s) deployment: %w", d.Id(), err))
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
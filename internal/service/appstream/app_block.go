@@ -0,0 +1,297 @@
+package appstream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAppBlock() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppBlockCreate,
+		ReadWithoutTimeout:   resourceAppBlockRead,
+		UpdateWithoutTimeout: resourceAppBlockUpdate,
+		DeleteWithoutTimeout: resourceAppBlockDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"setup_script_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"executable_parameters": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"executable_path": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"script_s3_location": s3LocationSchema(),
+						"timeout_in_seconds": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"source_s3_location": s3LocationSchema(),
+			"tags":               tftags.TagsSchema(),
+			"tags_all":           tftags.TagsSchemaComputed(),
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func s3LocationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"s3_bucket": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"s3_key": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAppBlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appstream.CreateAppBlockInput{
+		Name:               aws.String(name),
+		SetupScriptDetails: expandScriptDetails(d.Get("setup_script_details").([]interface{})),
+		SourceS3Location:   expandS3Location(d.Get("source_s3_location").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateAppBlockWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating AppStream App Block (%s): %w", name, err))
+	}
+
+	d.SetId(aws.StringValue(output.AppBlock.Arn))
+
+	return resourceAppBlockRead(ctx, d, meta)
+}
+
+func resourceAppBlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	appBlock, err := FindAppBlockByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream App Block (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading AppStream App Block (%s): %w", d.Id(), err))
+	}
+
+	arn := aws.StringValue(appBlock.Arn)
+
+	d.Set("arn", arn)
+	if appBlock.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(appBlock.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("description", appBlock.Description)
+	d.Set("display_name", appBlock.DisplayName)
+	d.Set("name", appBlock.Name)
+
+	if err = d.Set("setup_script_details", flattenScriptDetails(appBlock.SetupScriptDetails)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream App Block (%s): %w", "setup_script_details", d.Id(), err))
+	}
+
+	if err = d.Set("source_s3_location", flattenS3Location(appBlock.SourceS3Location)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream App Block (%s): %w", "source_s3_location", d.Id(), err))
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tags for AppStream App Block (%s): %w", arn, err))
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceAppBlockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("tags_all") {
+		conn := meta.(*conns.AWSClient).AppStreamConn
+
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags for AppStream App Block (%s): %w", d.Id(), err))
+		}
+	}
+
+	return resourceAppBlockRead(ctx, d, meta)
+}
+
+func resourceAppBlockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+
+	log.Printf("[DEBUG] Deleting AppStream App Block: (%s)", d.Id())
+	_, err := conn.DeleteAppBlockWithContext(ctx, &appstream.DeleteAppBlockInput{
+		Name: aws.String(d.Get("name").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting AppStream App Block (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandS3Location(tfList []interface{}) *appstream.S3Location {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &appstream.S3Location{
+		S3Bucket: aws.String(tfMap["s3_bucket"].(string)),
+		S3Key:    aws.String(tfMap["s3_key"].(string)),
+	}
+}
+
+func flattenS3Location(apiObject *appstream.S3Location) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_bucket": aws.StringValue(apiObject.S3Bucket),
+		"s3_key":    aws.StringValue(apiObject.S3Key),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandScriptDetails(tfList []interface{}) *appstream.ScriptDetails {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appstream.ScriptDetails{
+		ExecutablePath:   aws.String(tfMap["executable_path"].(string)),
+		ScriptS3Location: expandS3Location(tfMap["script_s3_location"].([]interface{})),
+		TimeoutInSeconds: aws.Int64(int64(tfMap["timeout_in_seconds"].(int))),
+	}
+
+	if v, ok := tfMap["executable_parameters"].(string); ok && v != "" {
+		apiObject.ExecutableParameters = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenScriptDetails(apiObject *appstream.ScriptDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"executable_parameters": aws.StringValue(apiObject.ExecutableParameters),
+		"executable_path":       aws.StringValue(apiObject.ExecutablePath),
+		"script_s3_location":    flattenS3Location(apiObject.ScriptS3Location),
+		"timeout_in_seconds":    aws.Int64Value(apiObject.TimeoutInSeconds),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,280 @@
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppStreamAppBlock_basic(t *testing.T) {
+	resourceName := "aws_appstream_app_block.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appstream", regexp.MustCompile(`app-block/.+`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.0.executable_path", "C:\\Windows\\System32\\WindowsPowerShell\\v1.0\\powershell.exe"),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.0.script_s3_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "setup_script_details.0.script_s3_location.0.s3_bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.0.timeout_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_s3_location.0.s3_key", "aws_s3_object.vhd", "key"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlock_disappears(t *testing.T) {
+	resourceName := "aws_appstream_app_block.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappstream.ResourceAppBlock(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlock_tags(t *testing.T) {
+	resourceName := "aws_appstream_app_block.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppBlockConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAppBlockConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAppBlockExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn
+
+		_, err := tfappstream.FindAppBlockByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAppBlockDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_app_block" {
+			continue
+		}
+
+		_, err := tfappstream.FindAppBlockByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream App Block %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAppBlockConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "appstream.${data.aws_partition.current.dns_suffix}"
+      }
+      Action   = ["s3:GetObject"]
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+
+resource "aws_s3_object" "vhd" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "app.vhdx"
+  content = "vhd"
+}
+
+resource "aws_s3_object" "script" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "setup.ps1"
+  content = "Write-Host 'setup'"
+}
+`, rName)
+}
+
+func testAccAppBlockConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAppBlockConfig_base(rName), fmt.Sprintf(`
+resource "aws_appstream_app_block" "test" {
+  name = %[1]q
+
+  source_s3_location {
+    s3_bucket = aws_s3_bucket.test.id
+    s3_key    = aws_s3_object.vhd.key
+  }
+
+  setup_script_details {
+    executable_path    = "C:\\Windows\\System32\\WindowsPowerShell\\v1.0\\powershell.exe"
+    timeout_in_seconds = 60
+
+    script_s3_location {
+      s3_bucket = aws_s3_bucket.test.id
+      s3_key    = aws_s3_object.script.key
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName))
+}
+
+func testAccAppBlockConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAppBlockConfig_base(rName), fmt.Sprintf(`
+resource "aws_appstream_app_block" "test" {
+  name = %[1]q
+
+  source_s3_location {
+    s3_bucket = aws_s3_bucket.test.id
+    s3_key    = aws_s3_object.vhd.key
+  }
+
+  setup_script_details {
+    executable_path    = "C:\\Windows\\System32\\WindowsPowerShell\\v1.0\\powershell.exe"
+    timeout_in_seconds = 60
+
+    script_s3_location {
+      s3_bucket = aws_s3_bucket.test.id
+      s3_key    = aws_s3_object.script.key
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAppBlockConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAppBlockConfig_base(rName), fmt.Sprintf(`
+resource "aws_appstream_app_block" "test" {
+  name = %[1]q
+
+  source_s3_location {
+    s3_bucket = aws_s3_bucket.test.id
+    s3_key    = aws_s3_object.vhd.key
+  }
+
+  setup_script_details {
+    executable_path    = "C:\\Windows\\System32\\WindowsPowerShell\\v1.0\\powershell.exe"
+    timeout_in_seconds = 60
+
+    script_s3_location {
+      s3_bucket = aws_s3_bucket.test.id
+      s3_key    = aws_s3_object.script.key
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
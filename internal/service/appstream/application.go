@@ -0,0 +1,313 @@
+package appstream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"app_block_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"icon_s3_location": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"s3_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"icon_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_families": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"launch_parameters": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"launch_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"platforms": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 4,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(appstream.PlatformType_Values(), false),
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"working_directory": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appstream.CreateApplicationInput{
+		AppBlockArn:      aws.String(d.Get("app_block_arn").(string)),
+		IconS3Location:   expandS3Location(d.Get("icon_s3_location").([]interface{})),
+		InstanceFamilies: flex.ExpandStringSet(d.Get("instance_families").(*schema.Set)),
+		LaunchPath:       aws.String(d.Get("launch_path").(string)),
+		Name:             aws.String(name),
+		Platforms:        flex.ExpandStringSet(d.Get("platforms").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("launch_parameters"); ok {
+		input.LaunchParameters = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("working_directory"); ok {
+		input.WorkingDirectory = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating AppStream Application (%s): %w", name, err))
+	}
+
+	d.SetId(aws.StringValue(output.Application.Arn))
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	application, err := FindApplicationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading AppStream Application (%s): %w", d.Id(), err))
+	}
+
+	arn := aws.StringValue(application.Arn)
+
+	d.Set("app_block_arn", application.AppBlockArn)
+	d.Set("arn", arn)
+	if application.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(application.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("description", application.Description)
+	d.Set("display_name", application.DisplayName)
+	d.Set("enabled", application.Enabled)
+
+	if err = d.Set("icon_s3_location", flattenS3Location(application.IconS3Location)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream Application (%s): %w", "icon_s3_location", d.Id(), err))
+	}
+
+	d.Set("icon_url", application.IconURL)
+	d.Set("instance_families", aws.StringValueSlice(application.InstanceFamilies))
+	d.Set("launch_parameters", application.LaunchParameters)
+	d.Set("launch_path", application.LaunchPath)
+	d.Set("metadata", aws.StringValueMap(application.Metadata))
+	d.Set("name", application.Name)
+	d.Set("platforms", aws.StringValueSlice(application.Platforms))
+	d.Set("working_directory", application.WorkingDirectory)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tags for AppStream Application (%s): %w", arn, err))
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &appstream.UpdateApplicationInput{
+			Name: aws.String(d.Get("name").(string)),
+		}
+
+		if d.HasChange("app_block_arn") {
+			input.AppBlockArn = aws.String(d.Get("app_block_arn").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("icon_s3_location") {
+			input.IconS3Location = expandS3Location(d.Get("icon_s3_location").([]interface{}))
+		}
+
+		if d.HasChange("launch_parameters") {
+			if v, ok := d.GetOk("launch_parameters"); ok {
+				input.LaunchParameters = aws.String(v.(string))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.ApplicationAttributeLaunchParameters))
+			}
+		}
+
+		if d.HasChange("launch_path") {
+			input.LaunchPath = aws.String(d.Get("launch_path").(string))
+		}
+
+		if d.HasChange("working_directory") {
+			if v, ok := d.GetOk("working_directory"); ok {
+				input.WorkingDirectory = aws.String(v.(string))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.ApplicationAttributeWorkingDirectory))
+			}
+		}
+
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating AppStream Application (%s): %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags for AppStream Application (%s): %w", d.Id(), err))
+		}
+	}
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+
+	log.Printf("[DEBUG] Deleting AppStream Application: (%s)", d.Id())
+	_, err := conn.DeleteApplicationWithContext(ctx, &appstream.DeleteApplicationInput{
+		Name: aws.String(d.Get("name").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting AppStream Application (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package appstream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplicationFleetAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationFleetAssociationCreate,
+		ReadWithoutTimeout:   resourceApplicationFleetAssociationRead,
+		DeleteWithoutTimeout: resourceApplicationFleetAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"fleet_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationFleetAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+
+	fleetName := d.Get("fleet_name").(string)
+	applicationARN := d.Get("application_arn").(string)
+	id := EncodeApplicationFleetAssociationID(fleetName, applicationARN)
+	input := &appstream.AssociateApplicationFleetInput{
+		ApplicationArn: aws.String(applicationARN),
+		FleetName:      aws.String(fleetName),
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, fleetOperationTimeout, func() (interface{}, error) {
+		return conn.AssociateApplicationFleetWithContext(ctx, input)
+	}, appstream.ErrCodeResourceNotFoundException)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating AppStream Application Fleet Association (%s): %w", id, err))
+	}
+
+	d.SetId(id)
+
+	return resourceApplicationFleetAssociationRead(ctx, d, meta)
+}
+
+func resourceApplicationFleetAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+
+	fleetName, applicationARN, err := DecodeApplicationFleetAssociationID(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding AppStream Application Fleet Association ID (%s): %w", d.Id(), err))
+	}
+
+	_, err = FindApplicationFleetAssociation(ctx, conn, fleetName, applicationARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Application Fleet Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading AppStream Application Fleet Association (%s): %w", d.Id(), err))
+	}
+
+	d.Set("application_arn", applicationARN)
+	d.Set("fleet_name", fleetName)
+
+	return nil
+}
+
+func resourceApplicationFleetAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+
+	fleetName, applicationARN, err := DecodeApplicationFleetAssociationID(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding AppStream Application Fleet Association ID (%s): %w", d.Id(), err))
+	}
+
+	_, err = conn.DisassociateApplicationFleetWithContext(ctx, &appstream.DisassociateApplicationFleetInput{
+		ApplicationArn: aws.String(applicationARN),
+		FleetName:      aws.String(fleetName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting AppStream Application Fleet Association (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func EncodeApplicationFleetAssociationID(fleetName, applicationARN string) string {
+	return fmt.Sprintf("%s/%s", fleetName, applicationARN)
+}
+
+func DecodeApplicationFleetAssociationID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in format FleetName/ApplicationARN, received: %s", id)
+	}
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,144 @@
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppStreamApplicationFleetAssociation_basic(t *testing.T) {
+	resourceName := "aws_appstream_application_fleet_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckHasIAMRole(t, "AmazonAppStreamServiceAccess")
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationFleetAssociationDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationFleetAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationFleetAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", "aws_appstream_application.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_name", "aws_appstream_fleet.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamApplicationFleetAssociation_disappears(t *testing.T) {
+	resourceName := "aws_appstream_application_fleet_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckHasIAMRole(t, "AmazonAppStreamServiceAccess")
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationFleetAssociationDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationFleetAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationFleetAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappstream.ResourceApplicationFleetAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationFleetAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn
+
+		fleetName, applicationARN, err := tfappstream.DecodeApplicationFleetAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfappstream.FindApplicationFleetAssociation(context.Background(), conn, fleetName, applicationARN)
+
+		return err
+	}
+}
+
+func testAccCheckApplicationFleetAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_application_fleet_association" {
+			continue
+		}
+
+		fleetName, applicationARN, err := tfappstream.DecodeApplicationFleetAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfappstream.FindApplicationFleetAssociation(context.Background(), conn, fleetName, applicationARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Application Fleet Association %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccApplicationFleetAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_basic(rName),
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name                    = %[1]q
+  fleet_type              = "ELASTIC"
+  instance_type           = "stream.standard.small"
+  max_concurrent_sessions = 1
+  platform                = "WINDOWS_SERVER_2019"
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+resource "aws_appstream_application_fleet_association" "test" {
+  application_arn = aws_appstream_application.test.arn
+  fleet_name      = aws_appstream_fleet.test.name
+}
+`, rName))
+}
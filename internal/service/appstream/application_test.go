@@ -0,0 +1,213 @@
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppStreamApplication_basic(t *testing.T) {
+	resourceName := "aws_appstream_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "app_block_arn", "aws_appstream_app_block.test", "arn"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appstream", regexp.MustCompile(`application/.+`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "icon_s3_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_families.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "instance_families.*", "GENERAL_PURPOSE"),
+					resource.TestCheckResourceAttr(resourceName, "launch_parameters", ""),
+					resource.TestCheckResourceAttr(resourceName, "launch_path", "C:\\Program Files\\Example\\example.exe"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "platforms.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "platforms.*", appstream.PlatformTypeWindowsServer2019),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamApplication_disappears(t *testing.T) {
+	resourceName := "aws_appstream_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappstream.ResourceApplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamApplication_update(t *testing.T) {
+	resourceName := "aws_appstream_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "launch_parameters", ""),
+					resource.TestCheckResourceAttr(resourceName, "working_directory", ""),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_complete(rName, "Example application", "--verbose"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Example application"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "launch_parameters", "--verbose"),
+					resource.TestCheckResourceAttr(resourceName, "working_directory", "C:\\Program Files\\Example"),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_complete(rName, "Example application updated", "--quiet"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Example application updated"),
+					resource.TestCheckResourceAttr(resourceName, "launch_parameters", "--quiet"),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "launch_parameters", ""),
+					resource.TestCheckResourceAttr(resourceName, "working_directory", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn
+
+		_, err := tfappstream.FindApplicationByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckApplicationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_application" {
+			continue
+		}
+
+		_, err := tfappstream.FindApplicationByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Application %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccApplicationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccAppBlockConfig_basic(rName), `
+resource "aws_s3_object" "icon" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "icon.png"
+  content = "icon"
+}
+`)
+}
+
+func testAccApplicationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_base(rName), fmt.Sprintf(`
+resource "aws_appstream_application" "test" {
+  name              = %[1]q
+  app_block_arn     = aws_appstream_app_block.test.arn
+  instance_families = ["GENERAL_PURPOSE"]
+  launch_path       = "C:\\Program Files\\Example\\example.exe"
+  platforms         = ["WINDOWS_SERVER_2019"]
+
+  icon_s3_location {
+    s3_bucket = aws_s3_bucket.test.id
+    s3_key    = aws_s3_object.icon.key
+  }
+}
+`, rName))
+}
+
+func testAccApplicationConfig_complete(rName, description, launchParameters string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_base(rName), fmt.Sprintf(`
+resource "aws_appstream_application" "test" {
+  name              = %[1]q
+  app_block_arn     = aws_appstream_app_block.test.arn
+  description       = %[2]q
+  display_name      = %[1]q
+  instance_families = ["GENERAL_PURPOSE"]
+  launch_parameters = %[3]q
+  launch_path       = "C:\\Program Files\\Example\\example.exe"
+  platforms         = ["WINDOWS_SERVER_2019"]
+  working_directory = "C:\\Program Files\\Example"
+
+  icon_s3_location {
+    s3_bucket = aws_s3_bucket.test.id
+    s3_key    = aws_s3_object.icon.key
+  }
+}
+`, rName, description, launchParameters))
+}
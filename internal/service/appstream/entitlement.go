@@ -0,0 +1,253 @@
+package appstream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceEntitlement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEntitlementCreate,
+		ReadWithoutTimeout:   resourceEntitlementRead,
+		UpdateWithoutTimeout: resourceEntitlementUpdate,
+		DeleteWithoutTimeout: resourceEntitlementDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"app_visibility": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(appstream.AppVisibility_Values(), false),
+			},
+			"attribute": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stack_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceEntitlementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+
+	stackName := d.Get("stack_name").(string)
+	name := d.Get("name").(string)
+	id := EncodeEntitlementID(stackName, name)
+	input := &appstream.CreateEntitlementInput{
+		AppVisibility: aws.String(d.Get("app_visibility").(string)),
+		Attributes:    expandEntitlementAttributes(d.Get("attribute").(*schema.Set).List()),
+		Name:          aws.String(name),
+		StackName:     aws.String(stackName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateEntitlementWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating AppStream Entitlement (%s): %w", id, err))
+	}
+
+	d.SetId(id)
+
+	return resourceEntitlementRead(ctx, d, meta)
+}
+
+func resourceEntitlementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+
+	stackName, name, err := DecodeEntitlementID(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding AppStream Entitlement ID (%s): %w", d.Id(), err))
+	}
+
+	entitlement, err := FindEntitlementByStackNameAndName(ctx, conn, stackName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Entitlement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading AppStream Entitlement (%s): %w", d.Id(), err))
+	}
+
+	d.Set("app_visibility", entitlement.AppVisibility)
+
+	if err = d.Set("attribute", flattenEntitlementAttributes(entitlement.Attributes)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream Entitlement (%s): %w", "attribute", d.Id(), err))
+	}
+
+	if entitlement.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(entitlement.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("description", entitlement.Description)
+	if entitlement.LastModifiedTime != nil {
+		d.Set("last_modified_time", aws.TimeValue(entitlement.LastModifiedTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_time", nil)
+	}
+	d.Set("name", entitlement.Name)
+	d.Set("stack_name", entitlement.StackName)
+
+	return nil
+}
+
+func resourceEntitlementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+
+	input := &appstream.UpdateEntitlementInput{
+		Name:      aws.String(d.Get("name").(string)),
+		StackName: aws.String(d.Get("stack_name").(string)),
+	}
+
+	if d.HasChange("app_visibility") {
+		input.AppVisibility = aws.String(d.Get("app_visibility").(string))
+	}
+
+	if d.HasChange("attribute") {
+		input.Attributes = expandEntitlementAttributes(d.Get("attribute").(*schema.Set).List())
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	_, err := conn.UpdateEntitlementWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating AppStream Entitlement (%s): %w", d.Id(), err))
+	}
+
+	return resourceEntitlementRead(ctx, d, meta)
+}
+
+func resourceEntitlementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn
+
+	log.Printf("[DEBUG] Deleting AppStream Entitlement: (%s)", d.Id())
+	_, err := conn.DeleteEntitlementWithContext(ctx, &appstream.DeleteEntitlementInput{
+		Name:      aws.String(d.Get("name").(string)),
+		StackName: aws.String(d.Get("stack_name").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeEntitlementNotFoundException, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting AppStream Entitlement (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandEntitlementAttributes(tfList []interface{}) []*appstream.EntitlementAttribute {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*appstream.EntitlementAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &appstream.EntitlementAttribute{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenEntitlementAttributes(apiObjects []*appstream.EntitlementAttribute) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.StringValue(apiObject.Name),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func EncodeEntitlementID(stackName, name string) string {
+	return fmt.Sprintf("%s/%s", stackName, name)
+}
+
+func DecodeEntitlementID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in format StackName/EntitlementName, received: %s", id)
+	}
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,152 @@
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppStreamEntitlement_basic(t *testing.T) {
+	resourceName := "aws_appstream_entitlement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntitlementDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntitlementConfig_basic(rName, appstream.AppVisibilityAll, "Admins"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntitlementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app_visibility", appstream.AppVisibilityAll),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"name":  "roles",
+						"value": "Admins",
+					}),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_name", "aws_appstream_stack.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEntitlementConfig_basic(rName, appstream.AppVisibilityAssociated, "Users"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntitlementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app_visibility", appstream.AppVisibilityAssociated),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"name":  "roles",
+						"value": "Users",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppStreamEntitlement_disappears(t *testing.T) {
+	resourceName := "aws_appstream_entitlement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntitlementDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntitlementConfig_basic(rName, appstream.AppVisibilityAll, "Admins"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntitlementExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappstream.ResourceEntitlement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEntitlementExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn
+
+		stackName, name, err := tfappstream.DecodeEntitlementID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfappstream.FindEntitlementByStackNameAndName(context.Background(), conn, stackName, name)
+
+		return err
+	}
+}
+
+func testAccCheckEntitlementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_entitlement" {
+			continue
+		}
+
+		stackName, name, err := tfappstream.DecodeEntitlementID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfappstream.FindEntitlementByStackNameAndName(context.Background(), conn, stackName, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Entitlement %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEntitlementConfig_basic(rName, appVisibility, role string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+
+resource "aws_appstream_entitlement" "test" {
+  name           = %[1]q
+  stack_name     = aws_appstream_stack.test.name
+  app_visibility = %[2]q
+
+  attribute {
+    name  = "roles"
+    value = %[3]q
+  }
+}
+`, rName, appVisibility, role)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/appstream"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindStackByName Retrieve a appstream stack by name
@@ -192,3 +193,125 @@ func FindFleetStackAssociation(ctx context.Context, conn *appstream.AppStream, f
 
 	return nil
 }
+
+// FindAppBlockByARN Retrieve a appstream AppBlock by ARN
+func FindAppBlockByARN(ctx context.Context, conn *appstream.AppStream, arn string) (*appstream.AppBlock, error) {
+	input := &appstream.DescribeAppBlocksInput{
+		Arns: []*string{aws.String(arn)},
+	}
+
+	output, err := conn.DescribeAppBlocksWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AppBlocks) == 0 || output.AppBlocks[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.AppBlocks); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.AppBlocks[0], nil
+}
+
+// FindApplicationByARN Retrieve a appstream Application by ARN
+func FindApplicationByARN(ctx context.Context, conn *appstream.AppStream, arn string) (*appstream.Application, error) {
+	input := &appstream.DescribeApplicationsInput{
+		Arns: []*string{aws.String(arn)},
+	}
+
+	output, err := conn.DescribeApplicationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Applications) == 0 || output.Applications[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Applications); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Applications[0], nil
+}
+
+// FindApplicationFleetAssociation Retrieve the association between an appstream Application and Fleet
+func FindApplicationFleetAssociation(ctx context.Context, conn *appstream.AppStream, fleetName, applicationARN string) (*appstream.ApplicationFleetAssociation, error) {
+	input := &appstream.DescribeApplicationFleetAssociationsInput{
+		ApplicationArn: aws.String(applicationARN),
+		FleetName:      aws.String(fleetName),
+	}
+
+	output, err := conn.DescribeApplicationFleetAssociationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ApplicationFleetAssociations) == 0 || output.ApplicationFleetAssociations[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ApplicationFleetAssociations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ApplicationFleetAssociations[0], nil
+}
+
+// FindEntitlementByStackNameAndName Retrieve a appstream Entitlement by stack name and entitlement name
+func FindEntitlementByStackNameAndName(ctx context.Context, conn *appstream.AppStream, stackName, name string) (*appstream.Entitlement, error) {
+	input := &appstream.DescribeEntitlementsInput{
+		Name:      aws.String(name),
+		StackName: aws.String(stackName),
+	}
+
+	output, err := conn.DescribeEntitlementsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeEntitlementNotFoundException, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Entitlements) == 0 || output.Entitlements[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Entitlements); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Entitlements[0], nil
+}
@@ -48,7 +48,7 @@ func ResourceFleet() *schema.Resource {
 			"compute_capacity": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
-				Required: true,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"available": {
@@ -150,6 +150,12 @@ func ResourceFleet() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"max_concurrent_sessions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"max_user_duration_in_seconds": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -161,6 +167,12 @@ func ResourceFleet() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"platform": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(appstream.PlatformType_Values(), false),
+			},
 			"stream_view": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -250,10 +262,18 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.IamRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("max_concurrent_sessions"); ok {
+		input.MaxConcurrentSessions = aws.Int64(int64(v.(int)))
+	}
+
 	if v, ok := d.GetOk("max_user_duration_in_seconds"); ok {
 		input.MaxUserDurationInSeconds = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("platform"); ok {
+		input.Platform = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("stream_view"); ok {
 		input.StreamView = aws.String(v.(string))
 	}
@@ -368,8 +388,10 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("image_name", fleet.ImageName)
 	d.Set("image_arn", fleet.ImageArn)
 	d.Set("instance_type", fleet.InstanceType)
+	d.Set("max_concurrent_sessions", fleet.MaxConcurrentSessions)
 	d.Set("max_user_duration_in_seconds", fleet.MaxUserDurationInSeconds)
 	d.Set("name", fleet.Name)
+	d.Set("platform", fleet.Platform)
 	d.Set("state", fleet.State)
 	d.Set("stream_view", fleet.StreamView)
 
@@ -414,7 +436,7 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	shouldStop := false
 
-	if d.HasChanges("description", "domain_join_info", "enable_default_internet_access", "iam_role_arn", "instance_type", "max_user_duration_in_seconds", "stream_view", "vpc_config") {
+	if d.HasChanges("description", "domain_join_info", "enable_default_internet_access", "iam_role_arn", "instance_type", "max_user_duration_in_seconds", "platform", "stream_view", "vpc_config") {
 		shouldStop = true
 	}
 
@@ -479,10 +501,18 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.InstanceType = aws.String(d.Get("instance_type").(string))
 	}
 
+	if d.HasChange("max_concurrent_sessions") {
+		input.MaxConcurrentSessions = aws.Int64(int64(d.Get("max_concurrent_sessions").(int)))
+	}
+
 	if d.HasChange("max_user_duration_in_seconds") {
 		input.MaxUserDurationInSeconds = aws.Int64(int64(d.Get("max_user_duration_in_seconds").(int)))
 	}
 
+	if d.HasChange("platform") {
+		input.Platform = aws.String(d.Get("platform").(string))
+	}
+
 	if d.HasChange("vpc_config") {
 		input.VpcConfig = expandVPCConfig(d.Get("vpc_config").([]interface{}))
 	}
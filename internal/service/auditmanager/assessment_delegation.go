@@ -0,0 +1,253 @@
+package auditmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2resource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerFrameworkResourceFactory(newResourceAssessmentDelegation)
+}
+
+func newResourceAssessmentDelegation(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAssessmentDelegation{}, nil
+}
+
+const (
+	ResNameAssessmentDelegation = "AssessmentDelegation"
+)
+
+type resourceAssessmentDelegation struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceAssessmentDelegation) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_auditmanager_assessment_delegation"
+}
+
+func (r *resourceAssessmentDelegation) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"assessment_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"control_set_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"role_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleTypeValues()...),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceAssessmentDelegation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().AuditManagerClient
+
+	var plan resourceAssessmentDelegationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	delegationIn := awstypes.CreateDelegationRequest{
+		ControlSetId: aws.String(plan.ControlSetID.ValueString()),
+		RoleArn:      aws.String(plan.RoleARN.ValueString()),
+		RoleType:     awstypes.RoleType(plan.RoleType.ValueString()),
+	}
+	if !plan.Comment.IsNull() {
+		delegationIn.Comment = aws.String(plan.Comment.ValueString())
+	}
+
+	in := auditmanager.BatchCreateDelegationByAssessmentInput{
+		AssessmentId:             aws.String(plan.AssessmentID.ValueString()),
+		CreateDelegationRequests: []awstypes.CreateDelegationRequest{delegationIn},
+	}
+
+	out, err := conn.BatchCreateDelegationByAssessment(ctx, &in)
+	if err == nil && out != nil && len(out.Errors) > 0 {
+		err = errors.New(aws.ToString(out.Errors[0].ErrorMessage))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameAssessmentDelegation, plan.RoleARN.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || len(out.Delegations) != 1 {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameAssessmentDelegation, plan.RoleARN.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = flex.StringToFramework(ctx, out.Delegations[0].Id)
+	state.Status = flex.StringValueToFramework(ctx, out.Delegations[0].Status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceAssessmentDelegation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().AuditManagerClient
+
+	var state resourceAssessmentDelegationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindAssessmentDelegationByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		diag.NewWarningDiagnostic(
+			"AWS Resource Not Found During Refresh",
+			fmt.Sprintf("Automatically removing from Terraform State instead of returning the error, which may trigger resource recreation. Original Error: %s", err.Error()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameAssessmentDelegation, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	// The delegation metadata does not include the comment, control set ID or role type,
+	// so those values are retained from state.
+	state.AssessmentID = flex.StringToFramework(ctx, out.AssessmentId)
+	state.RoleARN = flex.StringToFramework(ctx, out.RoleArn)
+	state.Status = flex.StringValueToFramework(ctx, out.Status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is a no-op. All configurable arguments require replacement.
+func (r *resourceAssessmentDelegation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan resourceAssessmentDelegationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceAssessmentDelegation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().AuditManagerClient
+
+	var state resourceAssessmentDelegationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.BatchDeleteDelegationByAssessment(ctx, &auditmanager.BatchDeleteDelegationByAssessmentInput{
+		AssessmentId:  aws.String(state.AssessmentID.ValueString()),
+		DelegationIds: []string{state.ID.ValueString()},
+	})
+	if err == nil && out != nil && len(out.Errors) > 0 {
+		err = errors.New(aws.ToString(out.Errors[0].ErrorMessage))
+	}
+	if err != nil {
+		var nfe *awstypes.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameAssessmentDelegation, state.ID.String(), nil),
+			err.Error(),
+		)
+	}
+}
+
+// FindAssessmentDelegationByID returns the metadata of the delegation with the specified ID.
+// There is no API to describe a single delegation, so all delegations are listed and filtered.
+func FindAssessmentDelegationByID(ctx context.Context, conn *auditmanager.Client, id string) (*awstypes.DelegationMetadata, error) {
+	in := &auditmanager.GetDelegationsInput{}
+	pages := auditmanager.NewGetDelegationsPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Delegations {
+			if aws.ToString(v.Id) == id {
+				v := v
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &sdkv2resource.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+func roleTypeValues() []string {
+	var values []string
+	for _, v := range awstypes.RoleType("").Values() {
+		values = append(values, string(v))
+	}
+	return values
+}
+
+type resourceAssessmentDelegationData struct {
+	AssessmentID types.String `tfsdk:"assessment_id"`
+	Comment      types.String `tfsdk:"comment"`
+	ControlSetID types.String `tfsdk:"control_set_id"`
+	ID           types.String `tfsdk:"id"`
+	RoleARN      types.String `tfsdk:"role_arn"`
+	RoleType     types.String `tfsdk:"role_type"`
+	Status       types.String `tfsdk:"status"`
+}
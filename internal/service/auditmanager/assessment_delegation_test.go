@@ -0,0 +1,132 @@
+package auditmanager_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAuditManagerAssessmentDelegation_basic(t *testing.T) {
+	assessmentID := testAccPreCheckAssessment(t)
+	controlSetID := os.Getenv("AUDITMANAGER_CONTROL_SET_ID")
+	if controlSetID == "" {
+		t.Skip("Environment variable AUDITMANAGER_CONTROL_SET_ID is not set")
+	}
+
+	var delegation types.DelegationMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_delegation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.AuditManagerEndpointID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentDelegationConfig_basic(rName, assessmentID, controlSetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentDelegationExists(resourceName, &delegation),
+					resource.TestCheckResourceAttr(resourceName, "assessment_id", assessmentID),
+					resource.TestCheckResourceAttr(resourceName, "comment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "control_set_id", controlSetID),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "role_type", string(types.RoleTypeResourceOwner)),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAssessmentDelegationDestroy(s *terraform.State) error {
+	ctx := context.Background()
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_assessment_delegation" {
+			continue
+		}
+
+		_, err := tfauditmanager.FindAssessmentDelegationByID(ctx, conn, rs.Primary.ID)
+		if tfresource.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.AuditManager, create.ErrActionCheckingDestroyed, tfauditmanager.ResNameAssessmentDelegation, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckAssessmentDelegationExists(name string, delegation *types.DelegationMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentDelegation, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentDelegation, name, errors.New("not set"))
+		}
+
+		ctx := context.Background()
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient
+		resp, err := tfauditmanager.FindAssessmentDelegationByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentDelegation, rs.Primary.ID, err)
+		}
+
+		*delegation = *resp
+
+		return nil
+	}
+}
+
+func testAccAssessmentDelegationConfig_basic(rName, assessmentID, controlSetID string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "auditmanager.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_auditmanager_assessment_delegation" "test" {
+  assessment_id  = %[2]q
+  comment        = "test"
+  control_set_id = %[3]q
+  role_arn       = aws_iam_role.test.arn
+  role_type      = "RESOURCE_OWNER"
+}
+`, rName, assessmentID, controlSetID)
+}
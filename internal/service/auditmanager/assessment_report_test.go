@@ -0,0 +1,151 @@
+package auditmanager_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testAccPreCheckAssessment skips tests that require an existing Audit Manager assessment,
+// identified by the AUDITMANAGER_ASSESSMENT_ID environment variable.
+func testAccPreCheckAssessment(t *testing.T) string {
+	assessmentID := os.Getenv("AUDITMANAGER_ASSESSMENT_ID")
+	if assessmentID == "" {
+		t.Skip("Environment variable AUDITMANAGER_ASSESSMENT_ID is not set")
+	}
+
+	return assessmentID
+}
+
+func TestAccAuditManagerAssessmentReport_basic(t *testing.T) {
+	assessmentID := testAccPreCheckAssessment(t)
+	var report types.AssessmentReportMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.AuditManagerEndpointID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentReportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentReportConfig_basic(rName, assessmentID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentReportExists(resourceName, &report),
+					resource.TestCheckResourceAttr(resourceName, "assessment_id", assessmentID),
+					resource.TestCheckResourceAttrSet(resourceName, "author"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status"},
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerAssessmentReport_disappears(t *testing.T) {
+	assessmentID := testAccPreCheckAssessment(t)
+	var report types.AssessmentReportMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.AuditManagerEndpointID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentReportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentReportConfig_basic(rName, assessmentID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentReportExists(resourceName, &report),
+					acctest.CheckFrameworkResourceDisappears(acctest.Provider, tfauditmanager.ResourceAssessmentReport, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssessmentReportDestroy(s *terraform.State) error {
+	ctx := context.Background()
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_assessment_report" {
+			continue
+		}
+
+		_, err := tfauditmanager.FindAssessmentReportByID(ctx, conn, rs.Primary.ID)
+		if tfresource.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.AuditManager, create.ErrActionCheckingDestroyed, tfauditmanager.ResNameAssessmentReport, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckAssessmentReportExists(name string, report *types.AssessmentReportMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentReport, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentReport, name, errors.New("not set"))
+		}
+
+		ctx := context.Background()
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient
+		resp, err := tfauditmanager.FindAssessmentReportByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentReport, rs.Primary.ID, err)
+		}
+
+		*report = *resp
+
+		return nil
+	}
+}
+
+func testAccAssessmentReportConfig_basic(rName, assessmentID string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_assessment_report" "test" {
+  assessment_id = %[2]q
+  name          = %[1]q
+  description   = "test"
+}
+`, rName, assessmentID)
+}
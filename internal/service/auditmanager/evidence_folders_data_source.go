@@ -0,0 +1,206 @@
+package auditmanager
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerFrameworkDataSourceFactory(newDataSourceEvidenceFolders)
+}
+
+func newDataSourceEvidenceFolders(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceEvidenceFolders{}, nil
+}
+
+const (
+	DSNameEvidenceFolders = "Evidence Folders Data Source"
+)
+
+type dataSourceEvidenceFolders struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceEvidenceFolders) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_auditmanager_evidence_folders"
+}
+
+func (d *dataSourceEvidenceFolders) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"assessment_id": schema.StringAttribute{
+				Required: true,
+			},
+			"control_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("control_set_id")),
+				},
+			},
+			"control_set_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("control_id")),
+				},
+			},
+			"evidence_folders": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: evidenceFolderAttrTypes},
+				Computed:    true,
+			},
+			"id": framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *dataSourceEvidenceFolders) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().AuditManagerClient
+
+	var data dataSourceEvidenceFoldersData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assessmentID := data.AssessmentID.ValueString()
+	id := assessmentID
+
+	var folders []awstypes.AssessmentEvidenceFolder
+	var err error
+	if !data.ControlSetID.IsNull() {
+		id = strings.Join([]string{assessmentID, data.ControlSetID.ValueString(), data.ControlID.ValueString()}, ",")
+		folders, err = findEvidenceFoldersByAssessmentControl(ctx, conn, assessmentID, data.ControlSetID.ValueString(), data.ControlID.ValueString())
+	} else {
+		folders, err = findEvidenceFoldersByAssessment(ctx, conn, assessmentID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, DSNameEvidenceFolders, id, nil),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.EvidenceFolders = flattenEvidenceFolders(ctx, folders)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findEvidenceFoldersByAssessment(ctx context.Context, conn *auditmanager.Client, assessmentID string) ([]awstypes.AssessmentEvidenceFolder, error) {
+	in := &auditmanager.GetEvidenceFoldersByAssessmentInput{
+		AssessmentId: aws.String(assessmentID),
+	}
+	pages := auditmanager.NewGetEvidenceFoldersByAssessmentPaginator(conn, in)
+
+	var folders []awstypes.AssessmentEvidenceFolder
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		folders = append(folders, page.EvidenceFolders...)
+	}
+
+	return folders, nil
+}
+
+func findEvidenceFoldersByAssessmentControl(ctx context.Context, conn *auditmanager.Client, assessmentID, controlSetID, controlID string) ([]awstypes.AssessmentEvidenceFolder, error) {
+	in := &auditmanager.GetEvidenceFoldersByAssessmentControlInput{
+		AssessmentId: aws.String(assessmentID),
+		ControlId:    aws.String(controlID),
+		ControlSetId: aws.String(controlSetID),
+	}
+	pages := auditmanager.NewGetEvidenceFoldersByAssessmentControlPaginator(conn, in)
+
+	var folders []awstypes.AssessmentEvidenceFolder
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		folders = append(folders, page.EvidenceFolders...)
+	}
+
+	return folders, nil
+}
+
+var evidenceFolderAttrTypes = map[string]attr.Type{
+	"assessment_report_selection_count": types.Int64Type,
+	"author":                            types.StringType,
+	"control_id":                        types.StringType,
+	"control_name":                      types.StringType,
+	"control_set_id":                    types.StringType,
+	"data_source":                       types.StringType,
+	"date":                              types.StringType,
+	"evidence_aws_service_source_count": types.Int64Type,
+	"evidence_by_type_compliance_check_count":        types.Int64Type,
+	"evidence_by_type_compliance_check_issues_count": types.Int64Type,
+	"evidence_by_type_configuration_data_count":      types.Int64Type,
+	"evidence_by_type_manual_count":                  types.Int64Type,
+	"evidence_by_type_user_activity_count":           types.Int64Type,
+	"evidence_resources_included_count":              types.Int64Type,
+	"id":                                             types.StringType,
+	"name":                                           types.StringType,
+	"total_evidence":                                 types.Int64Type,
+}
+
+func flattenEvidenceFolders(ctx context.Context, apiObjects []awstypes.AssessmentEvidenceFolder) types.List {
+	elemType := types.ObjectType{AttrTypes: evidenceFolderAttrTypes}
+
+	elems := []attr.Value{}
+	for _, item := range apiObjects {
+		date := types.StringNull()
+		if item.Date != nil {
+			date = types.StringValue(aws.ToTime(item.Date).Format(time.RFC3339))
+		}
+
+		obj := map[string]attr.Value{
+			"assessment_report_selection_count": types.Int64Value(int64(item.AssessmentReportSelectionCount)),
+			"author":                            flex.StringToFramework(ctx, item.Author),
+			"control_id":                        flex.StringToFramework(ctx, item.ControlId),
+			"control_name":                      flex.StringToFramework(ctx, item.ControlName),
+			"control_set_id":                    flex.StringToFramework(ctx, item.ControlSetId),
+			"data_source":                       flex.StringToFramework(ctx, item.DataSource),
+			"date":                              date,
+			"evidence_aws_service_source_count": types.Int64Value(int64(item.EvidenceAwsServiceSourceCount)),
+			"evidence_by_type_compliance_check_count":        types.Int64Value(int64(item.EvidenceByTypeComplianceCheckCount)),
+			"evidence_by_type_compliance_check_issues_count": types.Int64Value(int64(item.EvidenceByTypeComplianceCheckIssuesCount)),
+			"evidence_by_type_configuration_data_count":      types.Int64Value(int64(item.EvidenceByTypeConfigurationDataCount)),
+			"evidence_by_type_manual_count":                  types.Int64Value(int64(item.EvidenceByTypeManualCount)),
+			"evidence_by_type_user_activity_count":           types.Int64Value(int64(item.EvidenceByTypeUserActivityCount)),
+			"evidence_resources_included_count":              types.Int64Value(int64(item.EvidenceResourcesIncludedCount)),
+			"id":                                             flex.StringToFramework(ctx, item.Id),
+			"name":                                           flex.StringToFramework(ctx, item.Name),
+			"total_evidence":                                 types.Int64Value(int64(item.TotalEvidence)),
+		}
+
+		elems = append(elems, types.ObjectValueMust(evidenceFolderAttrTypes, obj))
+	}
+
+	return types.ListValueMust(elemType, elems)
+}
+
+type dataSourceEvidenceFoldersData struct {
+	AssessmentID    types.String `tfsdk:"assessment_id"`
+	ControlID       types.String `tfsdk:"control_id"`
+	ControlSetID    types.String `tfsdk:"control_set_id"`
+	EvidenceFolders types.List   `tfsdk:"evidence_folders"`
+	ID              types.String `tfsdk:"id"`
+}
@@ -0,0 +1,42 @@
+package auditmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAuditManagerEvidenceFoldersDataSource_basic(t *testing.T) {
+	assessmentID := testAccPreCheckAssessment(t)
+	dataSourceName := "data.aws_auditmanager_evidence_folders.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.AuditManagerEndpointID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvidenceFoldersDataSourceConfig_basic(assessmentID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "assessment_id", assessmentID),
+					resource.TestCheckResourceAttr(dataSourceName, "id", assessmentID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "evidence_folders.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvidenceFoldersDataSourceConfig_basic(assessmentID string) string {
+	return fmt.Sprintf(`
+data "aws_auditmanager_evidence_folders" "test" {
+  assessment_id = %[1]q
+}
+`, assessmentID)
+}
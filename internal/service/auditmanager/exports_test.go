@@ -2,7 +2,9 @@ package auditmanager
 
 // Exports for use in tests only.
 var (
-	ResourceControl             = newResourceControl
-	ResourceFramework           = newResourceFramework
-	ResourceAccountRegistration = newResourceAccountRegistration
+	ResourceAccountRegistration  = newResourceAccountRegistration
+	ResourceAssessmentDelegation = newResourceAssessmentDelegation
+	ResourceAssessmentReport     = newResourceAssessmentReport
+	ResourceControl              = newResourceControl
+	ResourceFramework            = newResourceFramework
 )
@@ -67,7 +67,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"allocation_strategy": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 							StateFunc: func(val interface{}) string {
 								return strings.ToUpper(val.(string))
 							},
@@ -76,7 +75,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"bid_percentage": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 						},
 						"desired_vcpus": {
 							Type:     schema.TypeInt,
@@ -87,7 +85,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -95,13 +92,11 @@ func ResourceComputeEnvironment() *schema.Resource {
 										Type:         schema.TypeString,
 										Optional:     true,
 										Computed:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.StringLenBetween(1, 256),
 									},
 									"image_type": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.StringLenBetween(1, 256),
 									},
 								},
@@ -110,48 +105,40 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"ec2_key_pair": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						"image_id": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						"instance_role": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: verify.ValidARN,
 						},
 						"instance_type": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"launch_template": {
 							Type:     schema.TypeList,
 							Optional: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"launch_template_id": {
 										Type:          schema.TypeString,
 										Optional:      true,
-										ForceNew:      true,
 										ConflictsWith: []string{"compute_resources.0.launch_template.0.launch_template_name"},
 									},
 									"launch_template_name": {
 										Type:          schema.TypeString,
 										Optional:      true,
-										ForceNew:      true,
 										ConflictsWith: []string{"compute_resources.0.launch_template.0.launch_template_id"},
 									},
 									"version": {
 										Type:     schema.TypeString,
 										Optional: true,
-										ForceNew: true,
 									},
 								},
 							},
@@ -180,7 +167,7 @@ func ResourceComputeEnvironment() *schema.Resource {
 							Required: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
-						"tags": tftags.TagsSchemaForceNew(),
+						"tags": tftags.TagsSchema(),
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -253,6 +240,27 @@ func ResourceComputeEnvironment() *schema.Resource {
 				},
 				ValidateFunc: validation.StringInSlice(batch.CEType_Values(), true),
 			},
+			"update_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_execution_timeout_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+						"terminate_jobs_on_update": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -348,6 +356,14 @@ func resourceComputeEnvironmentRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("eks_configuration", nil)
 	}
 
+	if computeEnvironment.UpdatePolicy != nil {
+		if err := d.Set("update_policy", []interface{}{flattenUpdatePolicy(computeEnvironment.UpdatePolicy)}); err != nil {
+			return fmt.Errorf("error setting update_policy: %w", err)
+		}
+	} else {
+		d.Set("update_policy", nil)
+	}
+
 	tags := KeyValueTags(computeEnvironment.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -400,9 +416,61 @@ func resourceComputeEnvironmentUpdate(d *schema.ResourceData, meta interface{})
 				computeResourceUpdate.Subnets = flex.ExpandStringSet(d.Get("compute_resources.0.subnets").(*schema.Set))
 			}
 
+			if d.HasChange("compute_resources.0.allocation_strategy") {
+				computeResourceUpdate.AllocationStrategy = aws.String(d.Get("compute_resources.0.allocation_strategy").(string))
+			}
+
+			if d.HasChange("compute_resources.0.bid_percentage") {
+				computeResourceUpdate.BidPercentage = aws.Int64(int64(d.Get("compute_resources.0.bid_percentage").(int)))
+			}
+
+			if d.HasChange("compute_resources.0.ec2_configuration") {
+				// An empty list removes the EC2 configuration.
+				computeResourceUpdate.Ec2Configuration = []*batch.Ec2Configuration{}
+
+				if v, ok := d.GetOk("compute_resources.0.ec2_configuration"); ok && len(v.([]interface{})) > 0 {
+					computeResourceUpdate.Ec2Configuration = expandEC2Configurations(v.([]interface{}))
+				}
+			}
+
+			if d.HasChange("compute_resources.0.ec2_key_pair") {
+				computeResourceUpdate.Ec2KeyPair = aws.String(d.Get("compute_resources.0.ec2_key_pair").(string))
+			}
+
+			if d.HasChange("compute_resources.0.image_id") {
+				computeResourceUpdate.ImageId = aws.String(d.Get("compute_resources.0.image_id").(string))
+			}
+
+			if d.HasChange("compute_resources.0.instance_role") {
+				computeResourceUpdate.InstanceRole = aws.String(d.Get("compute_resources.0.instance_role").(string))
+			}
+
+			if d.HasChange("compute_resources.0.instance_type") {
+				computeResourceUpdate.InstanceTypes = flex.ExpandStringSet(d.Get("compute_resources.0.instance_type").(*schema.Set))
+			}
+
+			if d.HasChange("compute_resources.0.launch_template") {
+				// An empty launch template ID removes the launch template.
+				computeResourceUpdate.LaunchTemplate = &batch.LaunchTemplateSpecification{
+					LaunchTemplateId: aws.String(""),
+				}
+
+				if v, ok := d.GetOk("compute_resources.0.launch_template"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					computeResourceUpdate.LaunchTemplate = expandLaunchTemplateSpecification(v.([]interface{})[0].(map[string]interface{}))
+				}
+			}
+
+			if d.HasChange("compute_resources.0.tags") {
+				computeResourceUpdate.Tags = Tags(tftags.New(d.Get("compute_resources.0.tags").(map[string]interface{})))
+			}
+
 			input.ComputeResources = computeResourceUpdate
 		}
 
+		if v, ok := d.GetOk("update_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.UpdatePolicy = expandUpdatePolicy(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		log.Printf("[DEBUG] Updating Batch Compute Environment: %s", input)
 		if _, err := conn.UpdateComputeEnvironment(input); err != nil {
 			return fmt.Errorf("error updating Batch Compute Environment (%s): %w", d.Id(), err)
@@ -478,22 +546,86 @@ func resourceComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.Res
 			fargateComputeResources = true
 		}
 
-		if diff.HasChange("compute_resources.0.security_group_ids") && !fargateComputeResources {
+		updatableComputeEnvironment := isUpdatableComputeEnvironmentDiff(diff)
+
+		if diff.HasChange("compute_resources.0.security_group_ids") && !fargateComputeResources && !updatableComputeEnvironment {
 			if err := diff.ForceNew("compute_resources.0.security_group_ids"); err != nil {
 				return err
 			}
 		}
 
-		if diff.HasChange("compute_resources.0.subnets") && !fargateComputeResources {
+		if diff.HasChange("compute_resources.0.subnets") && !fargateComputeResources && !updatableComputeEnvironment {
 			if err := diff.ForceNew("compute_resources.0.subnets"); err != nil {
 				return err
 			}
 		}
+
+		// Other compute resource changes can only be applied by an infrastructure update.
+		if !updatableComputeEnvironment {
+			for _, key := range []string{
+				"compute_resources.0.allocation_strategy",
+				"compute_resources.0.bid_percentage",
+				"compute_resources.0.ec2_configuration.0.image_id_override",
+				"compute_resources.0.ec2_configuration.0.image_type",
+				"compute_resources.0.ec2_key_pair",
+				"compute_resources.0.image_id",
+				"compute_resources.0.instance_role",
+				"compute_resources.0.instance_type",
+				"compute_resources.0.launch_template.0.launch_template_id",
+				"compute_resources.0.launch_template.0.launch_template_name",
+				"compute_resources.0.launch_template.0.version",
+				"compute_resources.0.tags",
+			} {
+				if diff.HasChange(key) {
+					if err := diff.ForceNew(key); err != nil {
+						return err
+					}
+				}
+			}
+		}
 	}
 
 	return nil
 }
 
+// isUpdatableComputeEnvironmentDiff returns whether the compute environment supports infrastructure updates
+// both before and after the change.
+// See https://docs.aws.amazon.com/batch/latest/userguide/updating-compute-environments.html.
+func isUpdatableComputeEnvironmentDiff(diff *schema.ResourceDiff) bool {
+	if computeEnvironmentType := strings.ToUpper(diff.Get("type").(string)); computeEnvironmentType != batch.CETypeManaged {
+		return false
+	}
+
+	if computeResourceType := strings.ToUpper(diff.Get("compute_resources.0.type").(string)); computeResourceType == batch.CRTypeFargate || computeResourceType == batch.CRTypeFargateSpot {
+		return false
+	}
+
+	if o, n := diff.GetChange("service_role"); !isServiceLinkedRoleARN(o.(string)) || !isServiceLinkedRoleARN(n.(string)) {
+		return false
+	}
+
+	if o, n := diff.GetChange("compute_resources.0.allocation_strategy"); !isUpdatableAllocationStrategy(o.(string)) || !isUpdatableAllocationStrategy(n.(string)) {
+		return false
+	}
+
+	return true
+}
+
+// isServiceLinkedRoleARN returns whether the service role is the Batch service-linked role.
+// An empty value means Batch uses the service-linked role.
+func isServiceLinkedRoleARN(v string) bool {
+	return v == "" || strings.Contains(v, ":role/aws-service-role/batch.amazonaws.com/")
+}
+
+func isUpdatableAllocationStrategy(v string) bool {
+	switch strings.ToUpper(v) {
+	case batch.CRAllocationStrategyBestFitProgressive, batch.CRAllocationStrategySpotCapacityOptimized:
+		return true
+	default:
+		return false
+	}
+}
+
 func expandComputeResource(tfMap map[string]interface{}) *batch.ComputeResource {
 	if tfMap == nil {
 		return nil
@@ -660,6 +792,24 @@ func expandLaunchTemplateSpecification(tfMap map[string]interface{}) *batch.Laun
 	return apiObject
 }
 
+func expandUpdatePolicy(tfMap map[string]interface{}) *batch.UpdatePolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.UpdatePolicy{}
+
+	if v, ok := tfMap["job_execution_timeout_minutes"].(int); ok && v != 0 {
+		apiObject.JobExecutionTimeoutMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["terminate_jobs_on_update"].(bool); ok {
+		apiObject.TerminateJobsOnUpdate = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
 func flattenComputeResource(apiObject *batch.ComputeResource) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -809,3 +959,21 @@ func flattenLaunchTemplateSpecification(apiObject *batch.LaunchTemplateSpecifica
 
 	return tfMap
 }
+
+func flattenUpdatePolicy(apiObject *batch.UpdatePolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.JobExecutionTimeoutMinutes; v != nil {
+		tfMap["job_execution_timeout_minutes"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TerminateJobsOnUpdate; v != nil {
+		tfMap["terminate_jobs_on_update"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
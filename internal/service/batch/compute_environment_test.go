@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/batch"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -521,6 +522,50 @@ func TestAccBatchComputeEnvironment_createFargateSpot(t *testing.T) {
 	})
 }
 
+func TestAccBatchComputeEnvironment_updatePolicy(t *testing.T) {
+	var ce1, ce2 batch.ComputeEnvironmentDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_compute_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, batch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeEnvironmentConfig_updatePolicy(rName, "c4.large", 30, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComputeEnvironmentExists(resourceName, &ce1),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.allocation_strategy", "BEST_FIT_PROGRESSIVE"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.instance_type.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "compute_resources.0.instance_type.*", "c4.large"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.job_execution_timeout_minutes", "30"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.terminate_jobs_on_update", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeEnvironmentConfig_updatePolicy(rName, "c5.large", 60, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComputeEnvironmentExists(resourceName, &ce2),
+					testAccCheckComputeEnvironmentNotRecreated(&ce1, &ce2),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.instance_type.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "compute_resources.0.instance_type.*", "c5.large"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.job_execution_timeout_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.terminate_jobs_on_update", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBatchComputeEnvironment_updateState(t *testing.T) {
 	var ce batch.ComputeEnvironmentDetail
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1453,6 +1498,16 @@ func testAccCheckComputeEnvironmentExists(n string, v *batch.ComputeEnvironmentD
 	}
 }
 
+func testAccCheckComputeEnvironmentNotRecreated(i, j *batch.ComputeEnvironmentDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.Uuid) != aws.StringValue(j.Uuid) {
+			return fmt.Errorf("Batch Compute Environment (%s) recreated", aws.StringValue(i.ComputeEnvironmentName))
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).BatchConn
 
@@ -2008,6 +2063,36 @@ resource "aws_batch_compute_environment" "test" {
 `, rName))
 }
 
+func testAccComputeEnvironmentConfig_updatePolicy(rName, instanceType string, timeout int, terminate bool) string {
+	return acctest.ConfigCompose(testAccComputeEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_batch_compute_environment" "test" {
+  compute_environment_name = %[1]q
+
+  compute_resources {
+    allocation_strategy = "BEST_FIT_PROGRESSIVE"
+    instance_role       = aws_iam_instance_profile.ecs_instance.arn
+    instance_type       = [%[2]q]
+    max_vcpus           = 16
+    min_vcpus           = 0
+    security_group_ids = [
+      aws_security_group.test.id
+    ]
+    subnets = [
+      aws_subnet.test.id
+    ]
+    type = "EC2"
+  }
+
+  update_policy {
+    job_execution_timeout_minutes = %[3]d
+    terminate_jobs_on_update      = %[4]t
+  }
+
+  type = "MANAGED"
+}
+`, rName, instanceType, timeout, terminate))
+}
+
 func testAccComputeEnvironmentConfig_fargateDefaultServiceRole(rName string) string {
 	return acctest.ConfigCompose(testAccComputeEnvironmentConfig_base(rName), fmt.Sprintf(`
 resource "aws_batch_compute_environment" "test" {
@@ -0,0 +1,211 @@
+package cloudtrail
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceQuery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueryCreate,
+		ReadWithoutTimeout:   resourceQueryRead,
+		DeleteWithoutTimeout: resourceQueryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delivery_s3_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI beginning with s3://"),
+			},
+			"delivery_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_statement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10000),
+			},
+			"query_statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_scanned": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"events_matched": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"events_scanned": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"execution_time_in_millis": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"query_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceQueryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudTrailConn
+
+	input := &cloudtrail.StartQueryInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+	}
+
+	if v, ok := d.GetOk("delivery_s3_uri"); ok {
+		input.DeliveryS3Uri = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Starting CloudTrail Query: %s", input)
+	output, err := conn.StartQueryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error starting CloudTrail Query: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.QueryId))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitQueryFinished(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error waiting for CloudTrail Query (%s) to finish: %s", d.Id(), err)
+		}
+	}
+
+	return resourceQueryRead(ctx, d, meta)
+}
+
+func resourceQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudTrailConn
+
+	output, err := FindQueryByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudTrail Query (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading CloudTrail Query (%s): %s", d.Id(), err)
+	}
+
+	d.Set("delivery_s3_uri", output.DeliveryS3Uri)
+	d.Set("delivery_status", output.DeliveryStatus)
+	d.Set("error_message", output.ErrorMessage)
+	d.Set("query_statement", output.QueryString)
+	if output.QueryStatistics != nil {
+		if err := d.Set("query_statistics", []interface{}{flattenQueryStatisticsForDescribeQuery(output.QueryStatistics)}); err != nil {
+			return diag.Errorf("error setting query_statistics: %s", err)
+		}
+	} else {
+		d.Set("query_statistics", nil)
+	}
+	d.Set("query_status", output.QueryStatus)
+
+	return nil
+}
+
+func resourceQueryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudTrailConn
+
+	switch status := d.Get("query_status").(string); status {
+	case cloudtrail.QueryStatusQueued, cloudtrail.QueryStatusRunning:
+	default:
+		// Completed queries cannot be cancelled and are only removed from state.
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling CloudTrail Query: (%s)", d.Id())
+	_, err := conn.CancelQueryWithContext(ctx, &cloudtrail.CancelQueryInput{
+		QueryId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudtrail.ErrCodeInactiveQueryException, cloudtrail.ErrCodeQueryIdNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error cancelling CloudTrail Query (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenQueryStatisticsForDescribeQuery(apiObject *cloudtrail.QueryStatisticsForDescribeQuery) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BytesScanned; v != nil {
+		tfMap["bytes_scanned"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.CreationTime; v != nil {
+		tfMap["creation_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.EventsMatched; v != nil {
+		tfMap["events_matched"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.EventsScanned; v != nil {
+		tfMap["events_scanned"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ExecutionTimeInMillis; v != nil {
+		tfMap["execution_time_in_millis"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
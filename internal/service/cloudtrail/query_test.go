@@ -0,0 +1,79 @@
+package cloudtrail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudtrail "github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
+)
+
+func TestAccCloudTrailQuery_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueryExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "query_statement"),
+					resource.TestCheckResourceAttr(resourceName, "query_statistics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "query_statistics.0.events_matched", "0"),
+					resource.TestCheckResourceAttr(resourceName, "query_status", cloudtrail.QueryStatusFinished),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+		},
+	})
+}
+
+func testAccCheckQueryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudTrail Query ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudTrailConn
+
+		_, err := tfcloudtrail.FindQueryByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccQueryConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudtrail_event_data_store" "test" {
+  name = %[1]q
+
+  termination_protection_enabled = false # For ease of deletion.
+}
+
+resource "aws_cloudtrail_query" "test" {
+  query_statement = "SELECT eventID FROM ${element(split("/", aws_cloudtrail_event_data_store.test.arn), 1)} WHERE eventName = '%[1]s'"
+}
+`, rName)
+}
@@ -1,5 +1,9 @@
 package cloudwatch
 
+import (
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
 const (
 	ResNameDashboard   = "Dashboard"
 	ResNameMetricAlarm = "Metric Alarm"
@@ -32,3 +36,12 @@ func missingData_Values() []string {
 		missingDataNotBreaching,
 	}
 }
+
+const (
+	// metricStreamOutputFormatOpenTelemetry10 is not yet modeled by the AWS SDK.
+	metricStreamOutputFormatOpenTelemetry10 = "opentelemetry1.0"
+)
+
+func metricStreamOutputFormat_Values() []string {
+	return append(cloudwatch.MetricStreamOutputFormat_Values(), metricStreamOutputFormatOpenTelemetry10)
+}
@@ -2,6 +2,7 @@ package cloudwatch
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,7 +40,10 @@ func ResourceMetricStream() *schema.Resource {
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceMetricStreamCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -105,7 +110,7 @@ func ResourceMetricStream() *schema.Resource {
 			"output_format": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 255),
+				ValidateFunc: validation.StringInSlice(metricStreamOutputFormat_Values(), false),
 			},
 			"role_arn": {
 				Type:         schema.TypeString,
@@ -373,6 +378,32 @@ func resourceMetricStreamDelete(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// resourceMetricStreamCustomizeDiff rejects additional statistics that the stream's output format cannot carry.
+// OpenTelemetry output formats only support percentile statistics.
+func resourceMetricStreamCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if outputFormat := diff.Get("output_format").(string); outputFormat == cloudwatch.MetricStreamOutputFormatJson || outputFormat == "" {
+		return nil
+	}
+
+	for _, configurationRaw := range diff.Get("statistics_configuration").(*schema.Set).List() {
+		mConfiguration, ok := configurationRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, v := range mConfiguration["additional_statistics"].(*schema.Set).List() {
+			if statistic := v.(string); !metricStreamPercentileStatisticRegexp.MatchString(statistic) {
+				return fmt.Errorf("additional statistic %q is not supported with output format %q, only percentile statistics (e.g. p99) are supported", statistic, diff.Get("output_format").(string))
+			}
+		}
+	}
+
+	return nil
+}
+
+var metricStreamPercentileStatisticRegexp = regexp.MustCompile(`^p(\d{1,2})(\.\d{0,10})?$`)
+
 func FindMetricStreamByName(ctx context.Context, conn *cloudwatch.CloudWatch, name string) (*cloudwatch.GetMetricStreamOutput, error) {
 	input := &cloudwatch.GetMetricStreamInput{
 		Name: aws.String(name),
@@ -398,6 +429,31 @@ func FindMetricStreamByName(ctx context.Context, conn *cloudwatch.CloudWatch, na
 	return output, nil
 }
 
+func FindMetricStreams(ctx context.Context, conn *cloudwatch.CloudWatch) ([]*cloudwatch.MetricStreamEntry, error) {
+	input := &cloudwatch.ListMetricStreamsInput{}
+	var output []*cloudwatch.MetricStreamEntry
+
+	err := conn.ListMetricStreamsPagesWithContext(ctx, input, func(page *cloudwatch.ListMetricStreamsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Entries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func statusMetricStream(ctx context.Context, conn *cloudwatch.CloudWatch, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindMetricStreamByName(ctx, conn, name)
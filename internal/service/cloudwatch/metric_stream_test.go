@@ -377,6 +377,48 @@ func TestAccCloudWatchMetricStream_additional_statistics(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricStream_outputFormatOpenTelemetry(t *testing.T) {
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricStreamConfig_outputFormat(rName, "opentelemetry2.0", "p99"),
+				ExpectError: regexp.MustCompile(`expected output_format to be one of`),
+			},
+			{
+				Config:      testAccMetricStreamConfig_outputFormat(rName, "opentelemetry1.0", "tm99"),
+				ExpectError: regexp.MustCompile(`only percentile statistics`),
+			},
+			{
+				Config: testAccMetricStreamConfig_outputFormat(rName, "opentelemetry1.0", "p99"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "opentelemetry1.0"),
+					resource.TestCheckResourceAttr(resourceName, "statistics_configuration.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMetricStreamConfig_outputFormat(rName, "opentelemetry0.7", "p99"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "opentelemetry0.7"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckMetricStreamExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -710,3 +752,23 @@ resource "aws_cloudwatch_metric_stream" "test" {
 }
 `, rName, stat)
 }
+
+func testAccMetricStreamConfig_outputFormat(rName, outputFormat, stat string) string {
+	return acctest.ConfigCompose(testAccMetricStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = aws_iam_role.metric_stream_to_firehose.arn
+  firehose_arn  = aws_kinesis_firehose_delivery_stream.s3_stream.arn
+  output_format = %[2]q
+
+  statistics_configuration {
+    additional_statistics = [%[3]q]
+
+    include_metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+    }
+  }
+}
+`, rName, outputFormat, stat))
+}
@@ -0,0 +1,127 @@
+package cloudwatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceMetricStreams() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricStreamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"metric_streams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"firehose_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_update_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMetricStreamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	entries, err := FindMetricStreams(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Metric Streams: %s", err)
+	}
+
+	var arns, names []string
+
+	for _, v := range entries {
+		arns = append(arns, aws.StringValue(v.Arn))
+		names = append(names, aws.StringValue(v.Name))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	if err := d.Set("metric_streams", flattenMetricStreamEntries(entries)); err != nil {
+		return diag.Errorf("setting metric_streams: %s", err)
+	}
+	d.Set("names", names)
+
+	return nil
+}
+
+func flattenMetricStreamEntries(apiObjects []*cloudwatch.MetricStreamEntry) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"arn":           aws.StringValue(apiObject.Arn),
+			"firehose_arn":  aws.StringValue(apiObject.FirehoseArn),
+			"name":          aws.StringValue(apiObject.Name),
+			"output_format": aws.StringValue(apiObject.OutputFormat),
+			"state":         aws.StringValue(apiObject.State),
+		}
+
+		if v := apiObject.CreationDate; v != nil {
+			tfMap["creation_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastUpdateDate; v != nil {
+			tfMap["last_update_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
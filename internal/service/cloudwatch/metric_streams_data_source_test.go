@@ -0,0 +1,45 @@
+package cloudwatch_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchMetricStreamsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_cloudwatch_metric_streams.test"
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricStreamsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", resourceName, "name"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "metric_streams.*", map[string]string{
+						"name":          rName,
+						"output_format": "json",
+						"state":         "running",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetricStreamsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMetricStreamConfig_basic(rName), `
+data "aws_cloudwatch_metric_streams" "test" {
+  depends_on = [aws_cloudwatch_metric_stream.test]
+}
+`)
+}
@@ -3,8 +3,11 @@ package codestarconnections
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,6 +40,10 @@ func ResourceConnection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"console_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"host_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -120,6 +127,7 @@ func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(arn)
 	d.Set("arn", connection.ConnectionArn)
 	d.Set("connection_status", connection.ConnectionStatus)
+	d.Set("console_url", connectionConsoleURL(arn))
 	d.Set("host_arn", connection.HostArn)
 	d.Set("name", connection.ConnectionName)
 	d.Set("provider_type", connection.ProviderType)
@@ -176,3 +184,25 @@ func resourceConnectionDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// connectionConsoleURL returns the AWS console page on which a pending connection's
+// handshake with the third-party provider is completed.
+func connectionConsoleURL(connectionARN string) string {
+	parsedARN, err := arn.Parse(connectionARN)
+
+	if err != nil {
+		return ""
+	}
+
+	var consoleHost string
+	switch parsedARN.Partition {
+	case endpoints.AwsCnPartitionID:
+		consoleHost = "console.amazonaws.cn"
+	case endpoints.AwsUsGovPartitionID:
+		consoleHost = "console.amazonaws-us-gov.com"
+	default:
+		consoleHost = "console.aws.amazon.com"
+	}
+
+	return fmt.Sprintf("https://%[1]s/codesuite/settings/%[2]s/%[3]s/connections/%[4]s?region=%[3]s", consoleHost, parsedARN.AccountID, parsedARN.Region, strings.TrimPrefix(parsedARN.Resource, "connection/"))
+}
@@ -27,6 +27,10 @@ func DataSourceConnection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"console_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"host_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -41,6 +45,11 @@ func DataSourceConnection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"require_available": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tags": tftags.TagsSchemaComputed(),
 		},
 	}
@@ -89,9 +98,16 @@ func dataSourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	arn := aws.StringValue(connection.ConnectionArn)
+	consoleURL := connectionConsoleURL(arn)
+
+	if status := aws.StringValue(connection.ConnectionStatus); d.Get("require_available").(bool) && status != codestarconnections.ConnectionStatusAvailable {
+		return fmt.Errorf("CodeStar Connections Connection (%s) is not available (status: %s). A connection created by Terraform remains %s until the authentication handshake with the provider is completed in the AWS console: %s", arn, status, codestarconnections.ConnectionStatusPending, consoleURL)
+	}
+
 	d.SetId(arn)
 	d.Set("arn", arn)
 	d.Set("connection_status", connection.ConnectionStatus)
+	d.Set("console_url", consoleURL)
 	d.Set("host_arn", connection.HostArn)
 	d.Set("name", connection.ConnectionName)
 	d.Set("provider_type", connection.ProviderType)
@@ -2,6 +2,7 @@ package codestarconnections_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
@@ -29,6 +30,7 @@ func TestAccCodeStarConnectionsConnectionDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "provider_type", dataSourceName, "provider_type"),
 					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "name"),
 					resource.TestCheckResourceAttrPair(resourceName, "connection_status", dataSourceName, "connection_status"),
+					resource.TestCheckResourceAttrPair(resourceName, "console_url", dataSourceName, "console_url"),
 					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
 					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName2, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName2, "arn"),
@@ -62,6 +64,22 @@ func TestAccCodeStarConnectionsConnectionDataSource_tags(t *testing.T) {
 	})
 }
 
+func TestAccCodeStarConnectionsConnectionDataSource_requireAvailable(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codestarconnections.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConnectionDataSourceConfig_requireAvailable(rName),
+				ExpectError: regexp.MustCompile(`is not available \(status: PENDING\)`),
+			},
+		},
+	})
+}
+
 func testAccConnectionDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_codestarconnections_connection" "test" {
@@ -96,3 +114,17 @@ data "aws_codestarconnections_connection" "test" {
 }
 `, rName)
 }
+
+func testAccConnectionDataSourceConfig_requireAvailable(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "Bitbucket"
+}
+
+data "aws_codestarconnections_connection" "test" {
+  arn               = aws_codestarconnections_connection.test.arn
+  require_available = true
+}
+`, rName)
+}
@@ -36,6 +36,7 @@ func TestAccCodeStarConnectionsConnection_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "provider_type", codestarconnections.ProviderTypeBitbucket),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "connection_status", codestarconnections.ConnectionStatusPending),
+					resource.TestMatchResourceAttr(resourceName, "console_url", regexp.MustCompile(`^https://.+/codesuite/settings/\d{12}/.+/connections/.+`)),
 				),
 			},
 			{
@@ -12,6 +12,7 @@ func TestAccConnect_serial(t *testing.T) {
 		},
 		"ContactFlow": {
 			"basic":           testAccContactFlow_basic,
+			"contentFormat":   testAccContactFlow_contentFormat,
 			"disappears":      testAccContactFlow_disappears,
 			"filename":        testAccContactFlow_filename,
 			"dataSource_id":   testAccContactFlowDataSource_contactFlowID,
@@ -176,7 +176,7 @@ func resourceContactFlowRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("name", resp.ContactFlow.Name)
 	d.Set("description", resp.ContactFlow.Description)
 	d.Set("type", resp.ContactFlow.Type)
-	d.Set("content", resp.ContactFlow.Content)
+	d.Set("content", contactFlowContentToSet(d.Get("content").(string), aws.StringValue(resp.ContactFlow.Content)))
 
 	tags := KeyValueTags(resp.ContactFlow.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
@@ -290,6 +290,22 @@ func ContactFlowParseID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+// contactFlowContentToSet returns the content already in state if it is semantically
+// equivalent to the content returned by Connect, which does not preserve the
+// formatting or key order of the submitted flow JSON. Otherwise the returned
+// content is normalized so that it compares cleanly with the configured value.
+func contactFlowContentToSet(old, new string) string {
+	if verify.JSONStringsEqual(old, new) {
+		return old
+	}
+
+	if v, err := structure.NormalizeJsonString(new); err == nil {
+		return v
+	}
+
+	return new
+}
+
 func resourceContactFlowLoadFileContent(filename string) (string, error) {
 	filename, err := homedir.Expand(filename)
 	if err != nil {
@@ -169,7 +169,7 @@ func resourceContactFlowModuleRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("instance_id", instanceID)
 	d.Set("name", resp.ContactFlowModule.Name)
 	d.Set("description", resp.ContactFlowModule.Description)
-	d.Set("content", resp.ContactFlowModule.Content)
+	d.Set("content", contactFlowContentToSet(d.Get("content").(string), aws.StringValue(resp.ContactFlowModule.Content)))
 
 	tags := KeyValueTags(resp.ContactFlowModule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
@@ -64,6 +64,32 @@ func testAccContactFlow_basic(t *testing.T) {
 	})
 }
 
+func testAccContactFlow_contentFormat(t *testing.T) {
+	var v connect.DescribeContactFlowOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_contact_flow.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactFlowConfig_basic(rName, rName2, "Created"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactFlowExists(resourceName, &v),
+				),
+			},
+			{
+				Config:   testAccContactFlowConfig_compactContent(rName, rName2, "Created"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccContactFlow_filename(t *testing.T) {
 	var v connect.DescribeContactFlowOutput
 	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
@@ -261,6 +287,41 @@ resource "aws_connect_contact_flow" "test" {
 `, rName2, label))
 }
 
+func testAccContactFlowConfig_compactContent(rName, rName2, label string) string {
+	return acctest.ConfigCompose(
+		testAccContactFlowConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = %[2]q
+  type        = "CONTACT_FLOW"
+  content = jsonencode({
+    Actions = [
+      {
+        Identifier  = "12345678-1234-1234-1234-123456789012"
+        Parameters  = { Text = %[2]q }
+        Transitions = { Conditions = [], Errors = [], NextAction = "abcdef-abcd-abcd-abcd-abcdefghijkl" }
+        Type        = "MessageParticipant"
+      },
+      {
+        Identifier  = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+        Parameters  = {}
+        Transitions = {}
+        Type        = "DisconnectParticipant"
+      },
+    ]
+    StartAction = "12345678-1234-1234-1234-123456789012"
+    Version     = "2019-10-30"
+  })
+  tags = {
+    "Name"   = "Test Contact Flow",
+    "Method" = %[2]q
+  }
+}
+`, rName2, label))
+}
+
 func testAccContactFlowConfig_filename(rName, rName2 string, label string, filepath string) string {
 	return acctest.ConfigCompose(
 		testAccContactFlowConfig_base(rName),
@@ -0,0 +1,523 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"auto_rollback_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(codedeploy.AutoRollbackEvent_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"complete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_config_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"deployment_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"file_exists_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(codedeploy.FileExistsBehavior_Values(), false),
+			},
+			"ignore_application_stop_failures": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"override_alarm_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 10,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"ignore_poll_alarm_failure": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"revision": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_spec_content": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"sha256": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"github_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"commit_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"repository": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"revision_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								codedeploy.RevisionLocationTypeAppSpecContent,
+								codedeploy.RevisionLocationTypeGitHub,
+								codedeploy.RevisionLocationTypeS3,
+							}, false),
+						},
+						"s3_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bundle_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(codedeploy.BundleType_Values(), false),
+									},
+									"etag": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_outdated_instances_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameDeployment = "Deployment"
+)
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeployConn
+
+	input := &codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(d.Get("app_name").(string)),
+		DeploymentGroupName: aws.String(d.Get("deployment_group_name").(string)),
+		Revision:            expandRevisionLocation(d.Get("revision").([]interface{})),
+	}
+
+	// Overrides are only sent when configured so that the deployment group's settings apply otherwise.
+	if v, ok := d.GetOk("auto_rollback_configuration"); ok {
+		input.AutoRollbackConfiguration = BuildAutoRollbackConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("deployment_config_name"); ok {
+		input.DeploymentConfigName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("file_exists_behavior"); ok {
+		input.FileExistsBehavior = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ignore_application_stop_failures"); ok {
+		input.IgnoreApplicationStopFailures = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("override_alarm_configuration"); ok {
+		input.OverrideAlarmConfiguration = BuildAlarmConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("update_outdated_instances_only"); ok {
+		input.UpdateOutdatedInstancesOnly = aws.Bool(v.(bool))
+	}
+
+	output, err := conn.CreateDeploymentWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Deploy, create.ErrActionCreating, ResNameDeployment, d.Get("deployment_group_name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.DeploymentId))
+
+	if _, err := waitDeploymentCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if failures := deploymentLifecycleEventFailures(ctx, conn, d.Id()); failures != nil {
+			err = multierror.Append(err, failures)
+		}
+
+		return create.DiagError(names.Deploy, create.ErrActionWaitingForCreation, ResNameDeployment, d.Id(), err)
+	}
+
+	return resourceDeploymentRead(ctx, d, meta)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeployConn
+
+	deployment, err := FindDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Deploy, create.ErrActionReading, ResNameDeployment, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deploy, create.ErrActionReading, ResNameDeployment, d.Id(), err)
+	}
+
+	d.Set("app_name", deployment.ApplicationName)
+	if deployment.CompleteTime != nil {
+		d.Set("complete_time", aws.TimeValue(deployment.CompleteTime).Format(time.RFC3339))
+	} else {
+		d.Set("complete_time", nil)
+	}
+	d.Set("compute_platform", deployment.ComputePlatform)
+	if deployment.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(deployment.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("deployment_config_name", deployment.DeploymentConfigName)
+	d.Set("deployment_group_name", deployment.DeploymentGroupName)
+	d.Set("description", deployment.Description)
+	d.Set("file_exists_behavior", deployment.FileExistsBehavior)
+	d.Set("ignore_application_stop_failures", deployment.IgnoreApplicationStopFailures)
+	d.Set("status", deployment.Status)
+	d.Set("update_outdated_instances_only", deployment.UpdateOutdatedInstancesOnly)
+
+	// The API reports the effective auto rollback and alarm configurations, which are inherited from the
+	// deployment group when not overridden, so the configured overrides are not read back.
+
+	if err := d.Set("revision", flattenRevisionLocation(deployment.Revision, d.Get("revision").([]interface{}))); err != nil {
+		return create.DiagError(names.Deploy, create.ErrActionSetting, ResNameDeployment, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeployConn
+
+	deployment, err := FindDeploymentByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deploy, create.ErrActionDeleting, ResNameDeployment, d.Id(), err)
+	}
+
+	// Deployments cannot be deleted. Completed deployments are removed from state only.
+	switch aws.StringValue(deployment.Status) {
+	case codedeploy.DeploymentStatusFailed, codedeploy.DeploymentStatusStopped, codedeploy.DeploymentStatusSucceeded:
+		return nil
+	}
+
+	log.Printf("[INFO] Stopping CodeDeploy Deployment: %s", d.Id())
+	_, err = conn.StopDeploymentWithContext(ctx, &codedeploy.StopDeploymentInput{
+		DeploymentId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return create.DiagError(names.Deploy, create.ErrActionDeleting, ResNameDeployment, d.Id(), err)
+	}
+
+	if _, err := waitDeploymentStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.Deploy, create.ErrActionWaitingForDeletion, ResNameDeployment, d.Id(), err)
+	}
+
+	return nil
+}
+
+// deploymentLifecycleEventFailures returns the diagnostics of the failed lifecycle events
+// of a deployment's failed targets, or nil if none can be found.
+func deploymentLifecycleEventFailures(ctx context.Context, conn *codedeploy.CodeDeploy, id string) error {
+	targets, err := FindDeploymentTargetsByStatus(ctx, conn, id, codedeploy.TargetStatusFailed)
+
+	if err != nil {
+		log.Printf("[WARN] listing CodeDeploy Deployment (%s) failed targets: %s", id, err)
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, target := range targets {
+		var targetID string
+		var lifecycleEvents []*codedeploy.LifecycleEvent
+
+		switch {
+		case target.CloudFormationTarget != nil:
+			targetID, lifecycleEvents = aws.StringValue(target.CloudFormationTarget.TargetId), target.CloudFormationTarget.LifecycleEvents
+		case target.EcsTarget != nil:
+			targetID, lifecycleEvents = aws.StringValue(target.EcsTarget.TargetId), target.EcsTarget.LifecycleEvents
+		case target.InstanceTarget != nil:
+			targetID, lifecycleEvents = aws.StringValue(target.InstanceTarget.TargetId), target.InstanceTarget.LifecycleEvents
+		case target.LambdaTarget != nil:
+			targetID, lifecycleEvents = aws.StringValue(target.LambdaTarget.TargetId), target.LambdaTarget.LifecycleEvents
+		}
+
+		for _, event := range lifecycleEvents {
+			if aws.StringValue(event.Status) != codedeploy.LifecycleEventStatusFailed {
+				continue
+			}
+
+			message := "no diagnostics available"
+			if v := event.Diagnostics; v != nil {
+				message = fmt.Sprintf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.Message))
+
+				if script := aws.StringValue(v.ScriptName); script != "" {
+					message = fmt.Sprintf("%s (script %s)", message, script)
+				}
+			}
+
+			errs = multierror.Append(errs, fmt.Errorf("target (%s) lifecycle event %s failed: %s", targetID, aws.StringValue(event.LifecycleEventName), message))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func expandRevisionLocation(tfList []interface{}) *codedeploy.RevisionLocation {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &codedeploy.RevisionLocation{
+		RevisionType: aws.String(tfMap["revision_type"].(string)),
+	}
+
+	if v, ok := tfMap["app_spec_content"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AppSpecContent = &codedeploy.AppSpecContent{
+			Content: aws.String(v[0].(map[string]interface{})["content"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["github_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.GitHubLocation = &codedeploy.GitHubLocation{
+			CommitId:   aws.String(m["commit_id"].(string)),
+			Repository: aws.String(m["repository"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		s3Location := &codedeploy.S3Location{
+			Bucket:     aws.String(m["bucket"].(string)),
+			BundleType: aws.String(m["bundle_type"].(string)),
+			Key:        aws.String(m["key"].(string)),
+		}
+
+		if v, ok := m["etag"].(string); ok && v != "" {
+			s3Location.ETag = aws.String(v)
+		}
+
+		if v, ok := m["version"].(string); ok && v != "" {
+			s3Location.Version = aws.String(v)
+		}
+
+		apiObject.S3Location = s3Location
+	}
+
+	return apiObject
+}
+
+func flattenRevisionLocation(apiObject *codedeploy.RevisionLocation, configured []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"revision_type": aws.StringValue(apiObject.RevisionType),
+	}
+
+	if v := apiObject.AppSpecContent; v != nil {
+		content := aws.StringValue(v.Content)
+
+		// Preserve the configured content as the API may return it reformatted.
+		if len(configured) > 0 && configured[0] != nil {
+			if c, ok := configured[0].(map[string]interface{})["app_spec_content"].([]interface{}); ok && len(c) > 0 && c[0] != nil {
+				content = c[0].(map[string]interface{})["content"].(string)
+			}
+		}
+
+		tfMap["app_spec_content"] = []interface{}{map[string]interface{}{
+			"content": content,
+			"sha256":  aws.StringValue(v.Sha256),
+		}}
+	}
+
+	if v := apiObject.GitHubLocation; v != nil {
+		tfMap["github_location"] = []interface{}{map[string]interface{}{
+			"commit_id":  aws.StringValue(v.CommitId),
+			"repository": aws.StringValue(v.Repository),
+		}}
+	}
+
+	if v := apiObject.S3Location; v != nil {
+		tfMap["s3_location"] = []interface{}{map[string]interface{}{
+			"bucket":      aws.StringValue(v.Bucket),
+			"bundle_type": aws.StringValue(v.BundleType),
+			"etag":        aws.StringValue(v.ETag),
+			"key":         aws.StringValue(v.Key),
+			"version":     aws.StringValue(v.Version),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -323,7 +323,10 @@ func ResourceDeploymentGroup() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Set:      schema.HashString,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(codedeploy.AutoRollbackEvent_Values(), false),
+							},
 						},
 					},
 				},
@@ -0,0 +1,61 @@
+package deploy_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDeployDeployment_noInstances(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codedeploy.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentConfig_s3(rName),
+				ExpectError: regexp.MustCompile(`NO_INSTANCES`),
+			},
+		},
+	})
+}
+
+func testAccDeploymentConfig_s3(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_basic(rName, false), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "revision.zip"
+  content = "not a real archive"
+}
+
+resource "aws_codedeploy_deployment" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = aws_codedeploy_deployment_group.test.deployment_group_name
+  description           = %[1]q
+
+  revision {
+    revision_type = "S3"
+
+    s3_location {
+      bucket      = aws_s3_object.test.bucket
+      key         = aws_s3_object.test.key
+      bundle_type = "zip"
+      etag        = aws_s3_object.test.etag
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,86 @@
+package deploy
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDeploymentByID(ctx context.Context, conn *codedeploy.CodeDeploy, id string) (*codedeploy.DeploymentInfo, error) {
+	input := &codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+
+	output, err := conn.GetDeploymentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, codedeploy.ErrCodeDeploymentDoesNotExistException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeploymentInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeploymentInfo, nil
+}
+
+func FindDeploymentTargetsByStatus(ctx context.Context, conn *codedeploy.CodeDeploy, id, status string) ([]*codedeploy.DeploymentTarget, error) {
+	input := &codedeploy.ListDeploymentTargetsInput{
+		DeploymentId: aws.String(id),
+		TargetFilters: map[string][]*string{
+			codedeploy.TargetFilterNameTargetStatus: aws.StringSlice([]string{status}),
+		},
+	}
+	var targetIDs []*string
+
+	for {
+		page, err := conn.ListDeploymentTargetsWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		targetIDs = append(targetIDs, page.TargetIds...)
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	var output []*codedeploy.DeploymentTarget
+
+	// BatchGetDeploymentTargets accepts at most 25 target IDs.
+	const batchSize = 25
+	for i := 0; i < len(targetIDs); i += batchSize {
+		j := i + batchSize
+		if j > len(targetIDs) {
+			j = len(targetIDs)
+		}
+
+		page, err := conn.BatchGetDeploymentTargetsWithContext(ctx, &codedeploy.BatchGetDeploymentTargetsInput{
+			DeploymentId: aws.String(id),
+			TargetIds:    targetIDs[i:j],
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.DeploymentTargets...)
+	}
+
+	return output, nil
+}
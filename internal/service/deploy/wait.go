@@ -0,0 +1,76 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	deploymentPollInterval = 15 * time.Second
+)
+
+// waitDeploymentCompleted waits for a deployment to succeed, or, for blue/green deployments
+// that wait for traffic to be rerouted manually, to become ready.
+func waitDeploymentCompleted(ctx context.Context, conn *codedeploy.CodeDeploy, id string, timeout time.Duration) (*codedeploy.DeploymentInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			codedeploy.DeploymentStatusCreated,
+			codedeploy.DeploymentStatusQueued,
+			codedeploy.DeploymentStatusInProgress,
+			codedeploy.DeploymentStatusBaking,
+		},
+		Target: []string{
+			codedeploy.DeploymentStatusReady,
+			codedeploy.DeploymentStatusSucceeded,
+		},
+		Refresh:      statusDeployment(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: deploymentPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*codedeploy.DeploymentInfo); ok {
+		if v := output.ErrorInformation; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDeploymentStopped(ctx context.Context, conn *codedeploy.CodeDeploy, id string, timeout time.Duration) (*codedeploy.DeploymentInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			codedeploy.DeploymentStatusCreated,
+			codedeploy.DeploymentStatusQueued,
+			codedeploy.DeploymentStatusInProgress,
+			codedeploy.DeploymentStatusBaking,
+			codedeploy.DeploymentStatusReady,
+		},
+		Target: []string{
+			codedeploy.DeploymentStatusFailed,
+			codedeploy.DeploymentStatusStopped,
+			codedeploy.DeploymentStatusSucceeded,
+		},
+		Refresh:      statusDeployment(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: deploymentPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*codedeploy.DeploymentInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
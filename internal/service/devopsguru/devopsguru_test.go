@@ -0,0 +1,38 @@
+package devopsguru_test
+
+import (
+	"testing"
+)
+
+// DevOps Guru configuration is account and region scoped, so tests must run serially.
+func TestAccDevOpsGuru_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"EventSourcesConfig": {
+			"basic": testAccEventSourcesConfig_basic,
+		},
+		"NotificationChannel": {
+			"basic":      testAccNotificationChannel_basic,
+			"disappears": testAccNotificationChannel_disappears,
+			"filters":    testAccNotificationChannel_filters,
+		},
+		"ResourceCollection": {
+			"cloudformation": testAccResourceCollection_cloudFormation,
+			"tags":           testAccResourceCollection_tags,
+		},
+		"ServiceIntegration": {
+			"basic": testAccServiceIntegration_basic,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package devopsguru
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceEventSourcesConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEventSourcesConfigPut,
+		ReadWithoutTimeout:   resourceEventSourcesConfigRead,
+		UpdateWithoutTimeout: resourceEventSourcesConfigPut,
+		DeleteWithoutTimeout: resourceEventSourcesConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"event_sources": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amazon_code_guru_profiler": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(devopsguru.EventSourceOptInStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameEventSourcesConfig = "Event Sources Config"
+)
+
+func resourceEventSourcesConfigPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+	region := meta.(*conns.AWSClient).Region
+
+	in := &devopsguru.UpdateEventSourcesConfigInput{
+		EventSources: expandEventSourcesConfig(d.Get("event_sources").([]interface{})),
+	}
+
+	if _, err := conn.UpdateEventSourcesConfigWithContext(ctx, in); err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionUpdating, ResNameEventSourcesConfig, region, err)
+	}
+
+	if d.Id() == "" {
+		d.SetId(region)
+	}
+
+	return resourceEventSourcesConfigRead(ctx, d, meta)
+}
+
+func resourceEventSourcesConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	out, err := FindEventSourcesConfig(ctx, conn)
+
+	if err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionReading, ResNameEventSourcesConfig, d.Id(), err)
+	}
+
+	if err := d.Set("event_sources", flattenEventSourcesConfig(out)); err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionSetting, ResNameEventSourcesConfig, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceEventSourcesConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	// Removing the resource disables all event sources.
+	log.Printf("[INFO] Disabling DevOpsGuru event sources (%s)", d.Id())
+
+	_, err := conn.UpdateEventSourcesConfigWithContext(ctx, &devopsguru.UpdateEventSourcesConfigInput{
+		EventSources: &devopsguru.EventSourcesConfig{
+			AmazonCodeGuruProfiler: &devopsguru.AmazonCodeGuruProfilerIntegration{
+				Status: aws.String(devopsguru.EventSourceOptInStatusDisabled),
+			},
+		},
+	})
+
+	if err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionDeleting, ResNameEventSourcesConfig, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEventSourcesConfig(tfList []interface{}) *devopsguru.EventSourcesConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &devopsguru.EventSourcesConfig{}
+
+	if v, ok := tfMap["amazon_code_guru_profiler"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AmazonCodeGuruProfiler = &devopsguru.AmazonCodeGuruProfilerIntegration{
+			Status: aws.String(v[0].(map[string]interface{})["status"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenEventSourcesConfig(apiObject *devopsguru.EventSourcesConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AmazonCodeGuruProfiler; v != nil {
+		tfMap["amazon_code_guru_profiler"] = []interface{}{map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
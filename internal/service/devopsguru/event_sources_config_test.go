@@ -0,0 +1,53 @@
+package devopsguru_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccEventSourcesConfig_basic(t *testing.T) {
+	resourceName := "aws_devopsguru_event_sources_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(devopsguru.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventSourcesConfigConfig_basic("ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "event_sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_sources.0.amazon_code_guru_profiler.0.status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventSourcesConfigConfig_basic("DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "event_sources.0.amazon_code_guru_profiler.0.status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEventSourcesConfigConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_devopsguru_event_sources_config" "test" {
+  event_sources {
+    amazon_code_guru_profiler {
+      status = %[1]q
+    }
+  }
+}
+`, status)
+}
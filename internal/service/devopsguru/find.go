@@ -0,0 +1,118 @@
+package devopsguru
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindEventSourcesConfig(ctx context.Context, conn *devopsguru.DevOpsGuru) (*devopsguru.EventSourcesConfig, error) {
+	in := &devopsguru.DescribeEventSourcesConfigInput{}
+	out, err := conn.DescribeEventSourcesConfigWithContext(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.EventSources == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.EventSources, nil
+}
+
+func FindNotificationChannelByID(ctx context.Context, conn *devopsguru.DevOpsGuru, id string) (*devopsguru.NotificationChannel, error) {
+	in := &devopsguru.ListNotificationChannelsInput{}
+	var out *devopsguru.NotificationChannel
+
+	err := conn.ListNotificationChannelsPagesWithContext(ctx, in, func(page *devopsguru.ListNotificationChannelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Channels {
+			if aws.StringValue(v.Id) == id {
+				out = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Config == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
+
+func FindResourceCollectionByType(ctx context.Context, conn *devopsguru.DevOpsGuru, collectionType string) (*devopsguru.ResourceCollectionFilter, error) {
+	in := &devopsguru.GetResourceCollectionInput{
+		ResourceCollectionType: aws.String(collectionType),
+	}
+	out := &devopsguru.ResourceCollectionFilter{}
+
+	err := conn.GetResourceCollectionPagesWithContext(ctx, in, func(page *devopsguru.GetResourceCollectionOutput, lastPage bool) bool {
+		if page == nil || page.ResourceCollection == nil {
+			return !lastPage
+		}
+
+		if v := page.ResourceCollection.CloudFormation; v != nil {
+			if out.CloudFormation == nil {
+				out.CloudFormation = &devopsguru.CloudFormationCollectionFilter{}
+			}
+
+			out.CloudFormation.StackNames = append(out.CloudFormation.StackNames, v.StackNames...)
+		}
+
+		out.Tags = append(out.Tags, page.ResourceCollection.Tags...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, devopsguru.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if (out.CloudFormation == nil || len(out.CloudFormation.StackNames) == 0) && len(out.Tags) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
+
+func FindServiceIntegration(ctx context.Context, conn *devopsguru.DevOpsGuru) (*devopsguru.ServiceIntegrationConfig, error) {
+	in := &devopsguru.DescribeServiceIntegrationInput{}
+	out, err := conn.DescribeServiceIntegrationWithContext(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ServiceIntegration == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.ServiceIntegration, nil
+}
@@ -0,0 +1,215 @@
+package devopsguru
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceNotificationChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNotificationChannelCreate,
+		ReadWithoutTimeout:   resourceNotificationChannelRead,
+		DeleteWithoutTimeout: resourceNotificationChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(devopsguru.NotificationMessageType_Values(), false),
+							},
+						},
+						"severities": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(devopsguru.InsightSeverity_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"sns": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameNotificationChannel = "Notification Channel"
+)
+
+func resourceNotificationChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	in := &devopsguru.AddNotificationChannelInput{
+		Config: &devopsguru.NotificationChannelConfig{
+			Sns: expandSNSChannelConfig(d.Get("sns").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("filters"); ok {
+		in.Config.Filters = expandNotificationFilterConfig(v.([]interface{}))
+	}
+
+	out, err := conn.AddNotificationChannelWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionCreating, ResNameNotificationChannel, "", err)
+	}
+
+	if out == nil || out.Id == nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionCreating, ResNameNotificationChannel, "", errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.Id))
+
+	return resourceNotificationChannelRead(ctx, d, meta)
+}
+
+func resourceNotificationChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	out, err := FindNotificationChannelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DevOpsGuru NotificationChannel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionReading, ResNameNotificationChannel, d.Id(), err)
+	}
+
+	if err := d.Set("filters", flattenNotificationFilterConfig(out.Config.Filters)); err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionSetting, ResNameNotificationChannel, d.Id(), err)
+	}
+
+	if err := d.Set("sns", flattenSNSChannelConfig(out.Config.Sns)); err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionSetting, ResNameNotificationChannel, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceNotificationChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	log.Printf("[INFO] Deleting DevOpsGuru NotificationChannel %s", d.Id())
+
+	_, err := conn.RemoveNotificationChannelWithContext(ctx, &devopsguru.RemoveNotificationChannelInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, devopsguru.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionDeleting, ResNameNotificationChannel, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSNSChannelConfig(tfList []interface{}) *devopsguru.SnsChannelConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &devopsguru.SnsChannelConfig{}
+
+	if v, ok := tfMap["topic_arn"].(string); ok && v != "" {
+		apiObject.TopicArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandNotificationFilterConfig(tfList []interface{}) *devopsguru.NotificationFilterConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &devopsguru.NotificationFilterConfig{}
+
+	if v, ok := tfMap["message_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MessageTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["severities"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Severities = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenSNSChannelConfig(apiObject *devopsguru.SnsChannelConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"topic_arn": aws.StringValue(apiObject.TopicArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNotificationFilterConfig(apiObject *devopsguru.NotificationFilterConfig) []interface{} {
+	if apiObject == nil || (len(apiObject.MessageTypes) == 0 && len(apiObject.Severities) == 0) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"message_types": aws.StringValueSlice(apiObject.MessageTypes),
+		"severities":    aws.StringValueSlice(apiObject.Severities),
+	}
+
+	return []interface{}{tfMap}
+}
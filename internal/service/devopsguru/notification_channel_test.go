@@ -0,0 +1,187 @@
+package devopsguru_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdevopsguru "github.com/hashicorp/terraform-provider-aws/internal/service/devopsguru"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccNotificationChannel_basic(t *testing.T) {
+	var channel devopsguru.NotificationChannel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devopsguru_notification_channel.test"
+	snsTopicResourceName := "aws_sns_topic.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(devopsguru.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNotificationChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "filters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sns.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "sns.0.topic_arn", snsTopicResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNotificationChannel_disappears(t *testing.T) {
+	var channel devopsguru.NotificationChannel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devopsguru_notification_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(devopsguru.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNotificationChannelExists(resourceName, &channel),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdevopsguru.ResourceNotificationChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccNotificationChannel_filters(t *testing.T) {
+	var channel devopsguru.NotificationChannel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devopsguru_notification_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(devopsguru.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelConfig_filters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNotificationChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "filters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.message_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filters.0.message_types.*", "NEW_INSIGHT"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.severities.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filters.0.severities.*", "HIGH"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filters.0.severities.*", "MEDIUM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNotificationChannelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DevOpsGuruConn
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_devopsguru_notification_channel" {
+			continue
+		}
+
+		_, err := tfdevopsguru.FindNotificationChannelByID(ctx, conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.DevOpsGuru, create.ErrActionCheckingDestroyed, tfdevopsguru.ResNameNotificationChannel, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckNotificationChannelExists(name string, channel *devopsguru.NotificationChannel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DevOpsGuru, create.ErrActionCheckingExistence, tfdevopsguru.ResNameNotificationChannel, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.DevOpsGuru, create.ErrActionCheckingExistence, tfdevopsguru.ResNameNotificationChannel, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DevOpsGuruConn
+		ctx := context.Background()
+		resp, err := tfdevopsguru.FindNotificationChannelByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.DevOpsGuru, create.ErrActionCheckingExistence, tfdevopsguru.ResNameNotificationChannel, rs.Primary.ID, err)
+		}
+
+		*channel = *resp
+
+		return nil
+	}
+}
+
+func testAccNotificationChannelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccNotificationChannelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccNotificationChannelConfig_base(rName), `
+resource "aws_devopsguru_notification_channel" "test" {
+  sns {
+    topic_arn = aws_sns_topic.test.arn
+  }
+}
+`)
+}
+
+func testAccNotificationChannelConfig_filters(rName string) string {
+	return acctest.ConfigCompose(testAccNotificationChannelConfig_base(rName), `
+resource "aws_devopsguru_notification_channel" "test" {
+  sns {
+    topic_arn = aws_sns_topic.test.arn
+  }
+
+  filters {
+    message_types = ["NEW_INSIGHT"]
+    severities    = ["HIGH", "MEDIUM"]
+  }
+}
+`)
+}
@@ -0,0 +1,254 @@
+package devopsguru
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceResourceCollection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceCollectionCreate,
+		ReadWithoutTimeout:   resourceResourceCollectionRead,
+		UpdateWithoutTimeout: resourceResourceCollectionUpdate,
+		DeleteWithoutTimeout: resourceResourceCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloudformation": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"cloudformation", "tags"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stack_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_boundary_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringMatch(regexp.MustCompile(`^(?i)DevOps-Guru-`), `must begin with "DevOps-Guru-"`),
+							),
+						},
+						"tag_values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{devopsguru.ResourceCollectionTypeAwsCloudFormation, devopsguru.ResourceCollectionTypeAwsTags}, false),
+			},
+		},
+
+		CustomizeDiff: resourceResourceCollectionCustomizeDiff,
+	}
+}
+
+const (
+	ResNameResourceCollection = "Resource Collection"
+)
+
+func resourceResourceCollectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	collectionType := d.Get("type").(string)
+	in := &devopsguru.UpdateResourceCollectionInput{
+		Action:             aws.String(devopsguru.UpdateResourceCollectionActionAdd),
+		ResourceCollection: expandUpdateResourceCollectionFilter(d.Get("cloudformation").([]interface{}), d.Get("tags").([]interface{})),
+	}
+
+	if _, err := conn.UpdateResourceCollectionWithContext(ctx, in); err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionCreating, ResNameResourceCollection, collectionType, err)
+	}
+
+	d.SetId(collectionType)
+
+	return resourceResourceCollectionRead(ctx, d, meta)
+}
+
+func resourceResourceCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	out, err := FindResourceCollectionByType(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DevOpsGuru ResourceCollection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionReading, ResNameResourceCollection, d.Id(), err)
+	}
+
+	if err := d.Set("cloudformation", flattenCloudFormationCollectionFilter(out.CloudFormation)); err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionSetting, ResNameResourceCollection, d.Id(), err)
+	}
+
+	if err := d.Set("tags", flattenTagCollectionFilters(out.Tags)); err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionSetting, ResNameResourceCollection, d.Id(), err)
+	}
+
+	d.Set("type", d.Id())
+
+	return nil
+}
+
+func resourceResourceCollectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	if d.HasChanges("cloudformation", "tags") {
+		oCFN, nCFN := d.GetChange("cloudformation")
+		oTags, nTags := d.GetChange("tags")
+
+		in := &devopsguru.UpdateResourceCollectionInput{
+			Action:             aws.String(devopsguru.UpdateResourceCollectionActionRemove),
+			ResourceCollection: expandUpdateResourceCollectionFilter(oCFN.([]interface{}), oTags.([]interface{})),
+		}
+
+		if _, err := conn.UpdateResourceCollectionWithContext(ctx, in); err != nil {
+			return create.DiagError(names.DevOpsGuru, create.ErrActionUpdating, ResNameResourceCollection, d.Id(), err)
+		}
+
+		in = &devopsguru.UpdateResourceCollectionInput{
+			Action:             aws.String(devopsguru.UpdateResourceCollectionActionAdd),
+			ResourceCollection: expandUpdateResourceCollectionFilter(nCFN.([]interface{}), nTags.([]interface{})),
+		}
+
+		if _, err := conn.UpdateResourceCollectionWithContext(ctx, in); err != nil {
+			return create.DiagError(names.DevOpsGuru, create.ErrActionUpdating, ResNameResourceCollection, d.Id(), err)
+		}
+	}
+
+	return resourceResourceCollectionRead(ctx, d, meta)
+}
+
+func resourceResourceCollectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	log.Printf("[INFO] Deleting DevOpsGuru ResourceCollection %s", d.Id())
+
+	_, err := conn.UpdateResourceCollectionWithContext(ctx, &devopsguru.UpdateResourceCollectionInput{
+		Action:             aws.String(devopsguru.UpdateResourceCollectionActionRemove),
+		ResourceCollection: expandUpdateResourceCollectionFilter(d.Get("cloudformation").([]interface{}), d.Get("tags").([]interface{})),
+	})
+
+	if tfawserr.ErrCodeEquals(err, devopsguru.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionDeleting, ResNameResourceCollection, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceResourceCollectionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	switch collectionType := d.Get("type").(string); collectionType {
+	case devopsguru.ResourceCollectionTypeAwsCloudFormation:
+		if v := d.Get("cloudformation").([]interface{}); len(v) == 0 {
+			return fmt.Errorf("cloudformation must be configured when type is %q", collectionType)
+		}
+	case devopsguru.ResourceCollectionTypeAwsTags:
+		if v := d.Get("tags").([]interface{}); len(v) == 0 {
+			return fmt.Errorf("tags must be configured when type is %q", collectionType)
+		}
+	}
+
+	return nil
+}
+
+func expandUpdateResourceCollectionFilter(cfnList, tagsList []interface{}) *devopsguru.UpdateResourceCollectionFilter {
+	apiObject := &devopsguru.UpdateResourceCollectionFilter{}
+
+	if len(cfnList) > 0 && cfnList[0] != nil {
+		tfMap := cfnList[0].(map[string]interface{})
+
+		apiObject.CloudFormation = &devopsguru.UpdateCloudFormationCollectionFilter{
+			StackNames: flex.ExpandStringSet(tfMap["stack_names"].(*schema.Set)),
+		}
+	}
+
+	for _, tfMapRaw := range tagsList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.Tags = append(apiObject.Tags, &devopsguru.UpdateTagCollectionFilter{
+			AppBoundaryKey: aws.String(tfMap["app_boundary_key"].(string)),
+			TagValues:      flex.ExpandStringSet(tfMap["tag_values"].(*schema.Set)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenCloudFormationCollectionFilter(apiObject *devopsguru.CloudFormationCollectionFilter) []interface{} {
+	if apiObject == nil || len(apiObject.StackNames) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"stack_names": aws.StringValueSlice(apiObject.StackNames),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTagCollectionFilters(apiObjects []*devopsguru.TagCollectionFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"app_boundary_key": aws.StringValue(apiObject.AppBoundaryKey),
+			"tag_values":       aws.StringValueSlice(apiObject.TagValues),
+		})
+	}
+
+	return tfList
+}
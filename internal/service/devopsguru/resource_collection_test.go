@@ -0,0 +1,160 @@
+package devopsguru_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdevopsguru "github.com/hashicorp/terraform-provider-aws/internal/service/devopsguru"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccResourceCollection_cloudFormation(t *testing.T) {
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devopsguru_resource_collection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(devopsguru.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCollectionConfig_cloudFormation(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "AWS_CLOUD_FORMATION"),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation.0.stack_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cloudformation.0.stack_names.*", rName1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceCollectionConfig_cloudFormation(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation.0.stack_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cloudformation.0.stack_names.*", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceCollection_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devopsguru_resource_collection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(devopsguru.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCollectionConfig_tags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "AWS_TAGS"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.0.app_boundary_key", "DevOps-Guru-tf-acc-test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.0.tag_values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.0.tag_values.*", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckResourceCollectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DevOpsGuruConn
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_devopsguru_resource_collection" {
+			continue
+		}
+
+		_, err := tfdevopsguru.FindResourceCollectionByType(ctx, conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.DevOpsGuru, create.ErrActionCheckingDestroyed, tfdevopsguru.ResNameResourceCollection, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckResourceCollectionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DevOpsGuru, create.ErrActionCheckingExistence, tfdevopsguru.ResNameResourceCollection, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.DevOpsGuru, create.ErrActionCheckingExistence, tfdevopsguru.ResNameResourceCollection, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DevOpsGuruConn
+		ctx := context.Background()
+		_, err := tfdevopsguru.FindResourceCollectionByType(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.DevOpsGuru, create.ErrActionCheckingExistence, tfdevopsguru.ResNameResourceCollection, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceCollectionConfig_cloudFormation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_devopsguru_resource_collection" "test" {
+  type = "AWS_CLOUD_FORMATION"
+
+  cloudformation {
+    stack_names = [%[1]q]
+  }
+}
+`, rName)
+}
+
+func testAccResourceCollectionConfig_tags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_devopsguru_resource_collection" "test" {
+  type = "AWS_TAGS"
+
+  tags {
+    app_boundary_key = "DevOps-Guru-tf-acc-test"
+    tag_values       = [%[1]q]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,160 @@
+package devopsguru
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceServiceIntegration() *schema.Resource {
+	optInStatusSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"opt_in_status": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(devopsguru.OptInStatus_Values(), false),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceIntegrationPut,
+		ReadWithoutTimeout:   resourceServiceIntegrationRead,
+		UpdateWithoutTimeout: resourceServiceIntegrationPut,
+		DeleteWithoutTimeout: resourceServiceIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"logs_anomaly_detection": optInStatusSchema(),
+			"ops_center":             optInStatusSchema(),
+		},
+	}
+}
+
+const (
+	ResNameServiceIntegration = "Service Integration"
+)
+
+func resourceServiceIntegrationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+	region := meta.(*conns.AWSClient).Region
+
+	in := &devopsguru.UpdateServiceIntegrationInput{
+		ServiceIntegration: &devopsguru.UpdateServiceIntegrationConfig{},
+	}
+
+	if v := expandOptInStatus(d.Get("logs_anomaly_detection").([]interface{})); v != nil {
+		in.ServiceIntegration.LogsAnomalyDetection = &devopsguru.LogsAnomalyDetectionIntegrationConfig{
+			OptInStatus: v,
+		}
+	}
+
+	if v := expandOptInStatus(d.Get("ops_center").([]interface{})); v != nil {
+		in.ServiceIntegration.OpsCenter = &devopsguru.OpsCenterIntegrationConfig{
+			OptInStatus: v,
+		}
+	}
+
+	if _, err := conn.UpdateServiceIntegrationWithContext(ctx, in); err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionUpdating, ResNameServiceIntegration, region, err)
+	}
+
+	if d.Id() == "" {
+		d.SetId(region)
+	}
+
+	return resourceServiceIntegrationRead(ctx, d, meta)
+}
+
+func resourceServiceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	out, err := FindServiceIntegration(ctx, conn)
+
+	if err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionReading, ResNameServiceIntegration, d.Id(), err)
+	}
+
+	if v := out.LogsAnomalyDetection; v != nil {
+		if err := d.Set("logs_anomaly_detection", flattenOptInStatus(v.OptInStatus)); err != nil {
+			return create.DiagError(names.DevOpsGuru, create.ErrActionSetting, ResNameServiceIntegration, d.Id(), err)
+		}
+	} else {
+		d.Set("logs_anomaly_detection", nil)
+	}
+
+	if v := out.OpsCenter; v != nil {
+		if err := d.Set("ops_center", flattenOptInStatus(v.OptInStatus)); err != nil {
+			return create.DiagError(names.DevOpsGuru, create.ErrActionSetting, ResNameServiceIntegration, d.Id(), err)
+		}
+	} else {
+		d.Set("ops_center", nil)
+	}
+
+	return nil
+}
+
+func resourceServiceIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn
+
+	// Removing the resource disables all service integrations.
+	log.Printf("[INFO] Disabling DevOpsGuru service integrations (%s)", d.Id())
+
+	_, err := conn.UpdateServiceIntegrationWithContext(ctx, &devopsguru.UpdateServiceIntegrationInput{
+		ServiceIntegration: &devopsguru.UpdateServiceIntegrationConfig{
+			LogsAnomalyDetection: &devopsguru.LogsAnomalyDetectionIntegrationConfig{
+				OptInStatus: aws.String(devopsguru.OptInStatusDisabled),
+			},
+			OpsCenter: &devopsguru.OpsCenterIntegrationConfig{
+				OptInStatus: aws.String(devopsguru.OptInStatusDisabled),
+			},
+		},
+	})
+
+	if err != nil {
+		return create.DiagError(names.DevOpsGuru, create.ErrActionDeleting, ResNameServiceIntegration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandOptInStatus(tfList []interface{}) *string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	if v, ok := tfList[0].(map[string]interface{})["opt_in_status"].(string); ok && v != "" {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func flattenOptInStatus(apiObject *string) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"opt_in_status": aws.StringValue(apiObject),
+	}}
+}
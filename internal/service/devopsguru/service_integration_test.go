@@ -0,0 +1,56 @@
+package devopsguru_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccServiceIntegration_basic(t *testing.T) {
+	resourceName := "aws_devopsguru_service_integration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(devopsguru.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceIntegrationConfig_basic("ENABLED", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logs_anomaly_detection.0.opt_in_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "ops_center.0.opt_in_status", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceIntegrationConfig_basic("DISABLED", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logs_anomaly_detection.0.opt_in_status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "ops_center.0.opt_in_status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceIntegrationConfig_basic(logsAnomalyDetection, opsCenter string) string {
+	return fmt.Sprintf(`
+resource "aws_devopsguru_service_integration" "test" {
+  logs_anomaly_detection {
+    opt_in_status = %[1]q
+  }
+
+  ops_center {
+    opt_in_status = %[2]q
+  }
+}
+`, logsAnomalyDetection, opsCenter)
+}
@@ -1,6 +1,8 @@
 package directconnect
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -296,3 +298,21 @@ func FindLocations(conn *directconnect.DirectConnect, input *directconnect.Descr
 
 	return output.Locations, nil
 }
+
+func FindMacSecKeyByConnectionIDAndSecretARN(conn *directconnect.DirectConnect, connectionID, secretARN string) (*directconnect.MacSecKey, error) {
+	connection, err := FindConnectionByID(conn, connectionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range connection.MacSecKeys {
+		if aws.StringValue(key.SecretARN) == secretARN {
+			return key, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: fmt.Sprintf("MACsec key (%s) not associated with Direct Connect Connection (%s)", secretARN, connectionID),
+	}
+}
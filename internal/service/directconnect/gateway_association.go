@@ -203,26 +203,47 @@ func resourceGatewayAssociationUpdate(d *schema.ResourceData, meta interface{})
 	conn := meta.(*conns.AWSClient).DirectConnectConn
 
 	associationID := d.Get("dx_gateway_association_id").(string)
-	input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
-		AssociationId: aws.String(associationID),
-	}
 
-	oraw, nraw := d.GetChange("allowed_prefixes")
-	o, n := oraw.(*schema.Set), nraw.(*schema.Set)
+	// A cross-account association is updated by accepting a new proposal from the associated gateway's owner.
+	if proposalID := d.Get("proposal_id").(string); d.HasChange("proposal_id") && proposalID != "" {
+		input := &directconnect.AcceptDirectConnectGatewayAssociationProposalInput{
+			AssociatedGatewayOwnerAccount: aws.String(d.Get("associated_gateway_owner_account_id").(string)),
+			DirectConnectGatewayId:        aws.String(d.Get("dx_gateway_id").(string)),
+			ProposalId:                    aws.String(proposalID),
+		}
 
-	if add := n.Difference(o); add.Len() > 0 {
-		input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
-	}
+		if v, ok := d.GetOk("allowed_prefixes"); ok && v.(*schema.Set).Len() > 0 {
+			input.OverrideAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(v.(*schema.Set).List())
+		}
 
-	if del := o.Difference(n); del.Len() > 0 {
-		input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
-	}
+		log.Printf("[DEBUG] Accepting Direct Connect Gateway Association Proposal: %s", input)
+		_, err := conn.AcceptDirectConnectGatewayAssociationProposal(input)
 
-	log.Printf("[DEBUG] Updating Direct Connect Gateway Association: %s", input)
-	_, err := conn.UpdateDirectConnectGatewayAssociation(input)
+		if err != nil {
+			return fmt.Errorf("error accepting Direct Connect Gateway Association Proposal (%s): %w", proposalID, err)
+		}
+	} else {
+		input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
+			AssociationId: aws.String(associationID),
+		}
 
-	if err != nil {
-		return fmt.Errorf("error updating Direct Connect Gateway Association (%s): %w", d.Id(), err)
+		oraw, nraw := d.GetChange("allowed_prefixes")
+		o, n := oraw.(*schema.Set), nraw.(*schema.Set)
+
+		if add := n.Difference(o); add.Len() > 0 {
+			input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
+		}
+
+		if del := o.Difference(n); del.Len() > 0 {
+			input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
+		}
+
+		log.Printf("[DEBUG] Updating Direct Connect Gateway Association: %s", input)
+		_, err := conn.UpdateDirectConnectGatewayAssociation(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Direct Connect Gateway Association (%s): %w", d.Id(), err)
+		}
 	}
 
 	if _, err := waitGatewayAssociationUpdated(conn, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -20,6 +20,7 @@ func ResourceGatewayAssociationProposal() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGatewayAssociationProposalCreate,
 		Read:   resourceGatewayAssociationProposalRead,
+		Update: resourceGatewayAssociationProposalUpdate,
 		Delete: resourceGatewayAssociationProposalDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -29,6 +30,7 @@ func ResourceGatewayAssociationProposal() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			// Accepting the proposal with overridden prefixes changes the returned RequestedAllowedPrefixesToDirectConnectGateway value (allowed_prefixes attribute).
 			// We only want to force a new resource if this value changes and the current proposal state is "requested".
+			// Otherwise the association already exists and the change is proposed to it in-place.
 			customdiff.ForceNewIf("allowed_prefixes", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				conn := meta.(*conns.AWSClient).DirectConnectConn
 
@@ -171,6 +173,41 @@ func resourceGatewayAssociationProposalRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+func resourceGatewayAssociationProposalUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DirectConnectConn
+
+	directConnectGatewayID := d.Get("dx_gateway_id").(string)
+	associatedGatewayID := d.Get("associated_gateway_id").(string)
+	input := &directconnect.CreateDirectConnectGatewayAssociationProposalInput{
+		DirectConnectGatewayId:           aws.String(directConnectGatewayID),
+		DirectConnectGatewayOwnerAccount: aws.String(d.Get("dx_gateway_owner_account_id").(string)),
+		GatewayId:                        aws.String(associatedGatewayID),
+	}
+
+	oraw, nraw := d.GetChange("allowed_prefixes")
+	o, n := oraw.(*schema.Set), nraw.(*schema.Set)
+
+	if add := n.Difference(o); add.Len() > 0 {
+		input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
+	}
+
+	if del := o.Difference(n); del.Len() > 0 {
+		input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
+	}
+
+	log.Printf("[DEBUG] Creating Direct Connect Gateway Association Proposal: %s", input)
+	output, err := conn.CreateDirectConnectGatewayAssociationProposal(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Direct Connect Gateway Association Proposal (%s/%s): %w", directConnectGatewayID, associatedGatewayID, err)
+	}
+
+	// The new proposal must be accepted by the Direct Connect gateway owner for the change to take effect.
+	d.SetId(aws.StringValue(output.DirectConnectGatewayAssociationProposal.ProposalId))
+
+	return resourceGatewayAssociationProposalRead(d, meta)
+}
+
 func resourceGatewayAssociationProposalDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DirectConnectConn
 
@@ -309,6 +309,41 @@ func TestAccDirectConnectGatewayAssociation_allowedPrefixesVPNGatewayCrossAccoun
 	})
 }
 
+func TestAccDirectConnectGatewayAssociation_allowedPrefixesVPNGatewayCrossAccountProposal(t *testing.T) {
+	resourceName := "aws_dx_gateway_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	var ga1, ga2 directconnect.GatewayAssociation
+	var gap1, gap2 directconnect.GatewayAssociationProposal
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckAlternateAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, directconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckGatewayAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayAssociationConfig_allowedPrefixesVPNCrossAccountProposal(rName, rBgpAsn, "10.255.255.0/30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayAssociationExists(resourceName, &ga1, &gap1),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_prefixes.*", "10.255.255.0/30"),
+				),
+			},
+			{
+				Config: testAccGatewayAssociationConfig_allowedPrefixesVPNCrossAccountProposal(rName, rBgpAsn, "10.255.255.8/30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayAssociationExists(resourceName, &ga2, &gap2),
+					testAccCheckGatewayAssociationNotRecreated(&ga1, &ga2),
+					testAccCheckGatewayAssociationProposalRecreated(&gap1, &gap2),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_prefixes.*", "10.255.255.8/30"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDirectConnectGatewayAssociation_recreateProposal(t *testing.T) {
 	resourceName := "aws_dx_gateway_association.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -773,3 +808,27 @@ resource "aws_dx_gateway_association" "test" {
 }
 `)
 }
+
+func testAccGatewayAssociationConfig_allowedPrefixesVPNCrossAccountProposal(rName string, rBgpAsn int, allowedPrefix string) string {
+	return acctest.ConfigCompose(
+		testAccGatewayAssociationConfigBase_vpnGatewayCrossAccount(rName, rBgpAsn),
+		fmt.Sprintf(`
+# Creator
+resource "aws_dx_gateway_association_proposal" "test" {
+  dx_gateway_id               = aws_dx_gateway.test.id
+  dx_gateway_owner_account_id = aws_dx_gateway.test.owner_account_id
+  associated_gateway_id       = aws_vpn_gateway_attachment.test.vpn_gateway_id
+
+  allowed_prefixes = [%[1]q]
+}
+
+# Accepter
+resource "aws_dx_gateway_association" "test" {
+  provider = "awsalternate"
+
+  proposal_id                         = aws_dx_gateway_association_proposal.test.id
+  dx_gateway_id                       = aws_dx_gateway.test.id
+  associated_gateway_owner_account_id = data.aws_caller_identity.creator.account_id
+}
+`, allowedPrefix))
+}
@@ -1,6 +1,7 @@
 package directconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -14,16 +15,24 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
+const (
+	macSecKeyStateAssociated  = "associated"
+	macSecKeyStateAssociating = "associating"
+)
+
 func ResourceMacSecKeyAssociation() *schema.Resource {
 	return &schema.Resource{
-		// MacSecKey resource only supports create (Associate), read (Describe) and delete (Disassociate)
+		// Updates rotate the CKN/CAK pair by associating the new key before disassociating the old one.
 		Create: resourceMacSecKeyCreate,
 		Read:   resourceMacSecKeyRead,
+		Update: resourceMacSecKeyUpdate,
 		Delete: resourceMacSecKeyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceMacSecKeyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"cak": {
 				Type:     schema.TypeString,
@@ -31,7 +40,6 @@ func ResourceMacSecKeyAssociation() *schema.Resource {
 				// CAK requires CKN
 				RequiredWith: []string{"ckn"},
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[a-fA-F0-9]{64}$`), "Must be 64-character hex code string"),
-				ForceNew:     true,
 			},
 			"ckn": {
 				Type:         schema.TypeString,
@@ -39,7 +47,6 @@ func ResourceMacSecKeyAssociation() *schema.Resource {
 				Optional:     true,
 				AtLeastOneOf: []string{"ckn", "secret_arn"},
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[a-fA-F0-9]{64}$`), "Must be 64-character hex code string"),
-				ForceNew:     true,
 			},
 			"connection_id": {
 				Type:     schema.TypeString,
@@ -51,7 +58,6 @@ func ResourceMacSecKeyAssociation() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				AtLeastOneOf: []string{"ckn", "secret_arn"},
-				ForceNew:     true,
 			},
 			"start_on": {
 				Type:     schema.TypeString,
@@ -128,6 +134,70 @@ func resourceMacSecKeyRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceMacSecKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DirectConnectConn
+
+	oldSecretARN, connectionID, err := MacSecKeyParseID(d.Id())
+	if err != nil {
+		return fmt.Errorf("unexpected format of ID (%s), expected secretArn_connectionId", d.Id())
+	}
+
+	ckn := d.Get("ckn").(string)
+	input := &directconnect.AssociateMacSecKeyInput{
+		Cak:          aws.String(d.Get("cak").(string)),
+		Ckn:          aws.String(ckn),
+		ConnectionId: aws.String(connectionID),
+	}
+
+	log.Printf("[DEBUG] Rotating MACSec secret key on Direct Connect Connection: %s", connectionID)
+	output, err := conn.AssociateMacSecKey(input)
+
+	if err != nil {
+		return fmt.Errorf("error associating MACSec secret key on Direct Connect Connection (%s): %w", connectionID, err)
+	}
+
+	var secretARN string
+
+	for _, key := range output.MacSecKeys {
+		if key != nil && aws.StringValue(key.Ckn) == ckn {
+			secretARN = aws.StringValue(key.SecretARN)
+		}
+	}
+
+	if secretARN == "" {
+		return fmt.Errorf("error associating MACSec secret key on Direct Connect Connection (%s): CKN not found in response", connectionID)
+	}
+
+	// Keep the old key associated until the new one is in use so the connection is never left without a valid key.
+	if _, err := waitMacSecKeyAssociated(conn, connectionID, secretARN); err != nil {
+		// Roll back to the old key, which remains the resource's key.
+		log.Printf("[DEBUG] Disassociating new MACSec secret key on Direct Connect Connection: %s", connectionID)
+		if _, disassociateErr := conn.DisassociateMacSecKey(&directconnect.DisassociateMacSecKeyInput{
+			ConnectionId: aws.String(connectionID),
+			SecretARN:    aws.String(secretARN),
+		}); disassociateErr != nil {
+			log.Printf("[WARN] Unable to disassociate new MACSec secret key (%s) on Direct Connect Connection (%s): %s", secretARN, connectionID, disassociateErr)
+		}
+
+		return fmt.Errorf("error waiting for MACSec secret key (%s) on Direct Connect Connection (%s) to associate: %w", secretARN, connectionID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", secretARN, connectionID))
+	d.Set("secret_arn", secretARN)
+
+	log.Printf("[DEBUG] Disassociating previous MACSec secret key on Direct Connect Connection: %s", connectionID)
+	_, err = conn.DisassociateMacSecKey(&directconnect.DisassociateMacSecKeyInput{
+		ConnectionId: aws.String(connectionID),
+		SecretARN:    aws.String(oldSecretARN),
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to disassociate previous MACSec secret key on Direct Connect Connection (%s): %w", connectionID, err)
+	}
+
+	return resourceMacSecKeyRead(d, meta)
+}
+
 func resourceMacSecKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DirectConnectConn
 
@@ -146,6 +216,31 @@ func resourceMacSecKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceMacSecKeyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// Switching to a different existing secret is not a rotation.
+	if diff.HasChange("secret_arn") {
+		return diff.ForceNew("secret_arn")
+	}
+
+	if diff.HasChanges("cak", "ckn") {
+		if diff.Get("ckn").(string) == "" {
+			return diff.ForceNew("cak")
+		}
+
+		for _, key := range []string{"secret_arn", "start_on", "state"} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // MacSecKeyParseSecretARN parses the secret ARN returned from a CMK or secret_arn
 func MacSecKeyParseSecretARN(output *directconnect.AssociateMacSecKeyOutput) string {
 	var result string
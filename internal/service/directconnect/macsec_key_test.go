@@ -86,6 +86,54 @@ func TestAccDirectConnectMacSecKey_withSecret(t *testing.T) {
 	})
 }
 
+func TestAccDirectConnectMacSecKey_rotate(t *testing.T) {
+	// Requires an existing MACsec-capable DX connection set as environmental variable
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	resourceName := "aws_dx_macsec_key_association.test"
+	ckn1 := testAccDirecConnectMacSecGenerateHex()
+	cak1 := testAccDirecConnectMacSecGenerateHex()
+	ckn2 := testAccDirecConnectMacSecGenerateHex()
+	cak2 := testAccDirecConnectMacSecGenerateHex()
+	var secretArn string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, directconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMacSecConfig_withCkn(ckn1, cak1, connectionId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ckn", ckn1),
+					resource.TestCheckResourceAttrWith(resourceName, "secret_arn", func(value string) error {
+						secretArn = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccMacSecConfig_withCkn(ckn2, cak2, connectionId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "connection_id", connectionId),
+					resource.TestCheckResourceAttr(resourceName, "ckn", ckn2),
+					resource.TestCheckResourceAttr(resourceName, "state", "associated"),
+					resource.TestCheckResourceAttrWith(resourceName, "secret_arn", func(value string) error {
+						if value == secretArn {
+							return fmt.Errorf("secret_arn not rotated: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 // testAccDirecConnectMacSecGenerateKey generates a 64-character hex string to be used as CKN or CAK
 func testAccDirecConnectMacSecGenerateHex() string {
 	s := make([]byte, 32)
@@ -86,3 +86,19 @@ func statusLagState(conn *directconnect.DirectConnect, id string) resource.State
 		return output, aws.StringValue(output.LagState), nil
 	}
 }
+
+func statusMacSecKeyState(conn *directconnect.DirectConnect, connectionID, secretARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMacSecKeyByConnectionIDAndSecretARN(conn, connectionID, secretARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
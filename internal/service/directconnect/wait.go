@@ -16,6 +16,7 @@ const (
 	connectionDisassociatedTimeout = 1 * time.Minute
 	hostedConnectionDeletedTimeout = 10 * time.Minute
 	lagDeletedTimeout              = 10 * time.Minute
+	macSecKeyAssociatedTimeout     = 10 * time.Minute
 )
 
 func waitConnectionConfirmed(conn *directconnect.DirectConnect, id string) (*directconnect.Connection, error) { //nolint:unparam
@@ -180,3 +181,20 @@ func waitLagDeleted(conn *directconnect.DirectConnect, id string) (*directconnec
 
 	return nil, err
 }
+
+func waitMacSecKeyAssociated(conn *directconnect.DirectConnect, connectionID, secretARN string) (*directconnect.MacSecKey, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{macSecKeyStateAssociating},
+		Target:  []string{macSecKeyStateAssociated},
+		Refresh: statusMacSecKeyState(conn, connectionID, secretARN),
+		Timeout: macSecKeyAssociatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*directconnect.MacSecKey); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -1,6 +1,9 @@
 package ec2
 
 import (
+	"strings"
+	"unicode"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -10,3 +13,51 @@ import (
 func suppressEqualCIDRBlockDiffs(k, old, new string, d *schema.ResourceData) bool {
 	return verify.CIDRBlocksEqual(old, new)
 }
+
+// suppressEquivalentVerifiedAccessPolicyDiffs provides custom difference suppression for
+// Verified Access (Cedar) policy documents that differ only in whitespace.
+func suppressEquivalentVerifiedAccessPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeVerifiedAccessPolicy(old) == normalizeVerifiedAccessPolicy(new)
+}
+
+// normalizeVerifiedAccessPolicy collapses runs of whitespace outside of string literals
+// into a single space and trims leading and trailing whitespace.
+func normalizeVerifiedAccessPolicy(policy string) string {
+	var sb strings.Builder
+	inString, escaped, pendingSpace := false, false, false
+
+	for _, r := range strings.TrimSpace(policy) {
+		if inString {
+			sb.WriteRune(r)
+
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '"':
+				inString = false
+			}
+
+			continue
+		}
+
+		if unicode.IsSpace(r) {
+			pendingSpace = true
+			continue
+		}
+
+		if pendingSpace {
+			sb.WriteRune(' ')
+			pendingSpace = false
+		}
+
+		if r == '"' {
+			inString = true
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
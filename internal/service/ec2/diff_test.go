@@ -0,0 +1,67 @@
+package ec2
+
+import (
+	"testing"
+)
+
+func TestNormalizeVerifiedAccessPolicy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "empty",
+			old:      "",
+			new:      "",
+			expected: true,
+		},
+		{
+			name:     "identical",
+			old:      "permit(principal, action, resource) when { context.http_request.method == \"GET\" };",
+			new:      "permit(principal, action, resource) when { context.http_request.method == \"GET\" };",
+			expected: true,
+		},
+		{
+			name: "whitespace",
+			old:  "permit(principal, action, resource) when { context.http_request.method == \"GET\" };",
+			new: `
+permit(principal, action, resource)
+when {
+  context.http_request.method == "GET"
+};
+`,
+			expected: true,
+		},
+		{
+			name:     "whitespace in string literal",
+			old:      "permit(principal, action, resource) when { context.identity.name == \"a b\" };",
+			new:      "permit(principal, action, resource) when { context.identity.name == \"a  b\" };",
+			expected: false,
+		},
+		{
+			name:     "escaped quote in string literal",
+			old:      "permit(principal, action, resource) when { context.identity.name == \"a\\\" b\" };",
+			new:      "permit(principal,  action, resource) when { context.identity.name == \"a\\\" b\" };",
+			expected: true,
+		},
+		{
+			name:     "different",
+			old:      "permit(principal, action, resource);",
+			new:      "forbid(principal, action, resource);",
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := normalizeVerifiedAccessPolicy(testCase.old) == normalizeVerifiedAccessPolicy(testCase.new); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func DataSourceAMI() *schema.Resource {
@@ -28,6 +31,15 @@ func DataSourceAMI() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allowed_owners": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
 			"architecture": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -181,6 +193,19 @@ func DataSourceAMI() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"region_image_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidRegionName,
+				},
+			},
 			"root_device_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -225,7 +250,8 @@ func DataSourceAMI() *schema.Resource {
 
 // dataSourceAwsAmiDescriptionRead performs the AMI lookup.
 func dataSourceAMIRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	client := meta.(*conns.AWSClient)
+	conn := client.EC2Conn
 
 	params := &ec2.DescribeImagesInput{
 		IncludeDeprecated: aws.Bool(d.Get("include_deprecated").(bool)),
@@ -243,15 +269,66 @@ func dataSourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 		params.Filters = BuildFiltersDataSource(v.(*schema.Set))
 	}
 
+	nameRegex := d.Get("name_regex").(string)
+	mostRecent := d.Get("most_recent").(bool)
+	allowedOwners := flex.ExpandStringValueList(d.Get("allowed_owners").([]interface{}))
+
 	log.Printf("[DEBUG] Reading AMI: %s", params)
-	resp, err := conn.DescribeImages(params)
+	image, err := findAMIForDataSource(client, conn, "ec2.DescribeImages:"+params.String(), params, nameRegex, mostRecent)
 	if err != nil {
 		return err
 	}
 
+	if err := checkAMIOwnerAllowed(image, client.Region, allowedOwners); err != nil {
+		return err
+	}
+
+	// Resolve the same AMI in each of the additional regions.
+	regionImageIDs := map[string]string{
+		client.Region: aws.StringValue(image.ImageId),
+	}
+
+	for _, v := range d.Get("regions").(*schema.Set).List() {
+		region := v.(string)
+
+		if _, ok := regionImageIDs[region]; ok {
+			continue
+		}
+
+		regionalConn := ec2.New(client.RegionalSession(names.EC2, region))
+
+		log.Printf("[DEBUG] Reading AMI in %s: %s", region, params)
+		regionalImage, err := findAMIForDataSource(client, regionalConn, fmt.Sprintf("ec2.DescribeImages:%s:%s", region, params), params, nameRegex, mostRecent)
+		if err != nil {
+			return fmt.Errorf("reading AMI in region (%s): %w", region, err)
+		}
+
+		if err := checkAMIOwnerAllowed(regionalImage, region, allowedOwners); err != nil {
+			return err
+		}
+
+		regionImageIDs[region] = aws.StringValue(regionalImage.ImageId)
+	}
+
+	if err := d.Set("region_image_ids", regionImageIDs); err != nil {
+		return fmt.Errorf("setting region_image_ids: %w", err)
+	}
+
+	return amiDescriptionAttributes(d, image, meta)
+}
+
+// findAMIForDataSource returns the single AMI matching the specified criteria.
+func findAMIForDataSource(client *conns.AWSClient, conn *ec2.EC2, cacheKey string, params *ec2.DescribeImagesInput, nameRegex string, mostRecent bool) (*ec2.Image, error) {
+	resp, err := conns.CachedRead(client, cacheKey, func() (*ec2.DescribeImagesOutput, error) {
+		return conn.DescribeImages(params)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var filteredImages []*ec2.Image
-	if nameRegex, ok := d.GetOk("name_regex"); ok {
-		r := regexp.MustCompile(nameRegex.(string))
+	if nameRegex != "" {
+		r := regexp.MustCompile(nameRegex)
 		for _, image := range resp.Images {
 			// Check for a very rare case where the response would include no
 			// image name. No name means nothing to attempt a match against,
@@ -267,16 +344,17 @@ func dataSourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		filteredImages = resp.Images[:]
+		// Copy the cached images before sorting.
+		filteredImages = append(filteredImages, resp.Images...)
 	}
 
 	if len(filteredImages) < 1 {
-		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+		return nil, fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
 	}
 
 	if len(filteredImages) > 1 {
-		if !d.Get("most_recent").(bool) {
-			return fmt.Errorf("Your query returned more than one result. Please try a more " +
+		if !mostRecent {
+			return nil, fmt.Errorf("Your query returned more than one result. Please try a more " +
 				"specific search criteria, or set `most_recent` attribute to true.")
 		}
 		sort.Slice(filteredImages, func(i, j int) bool {
@@ -286,7 +364,33 @@ func dataSourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 		})
 	}
 
-	return amiDescriptionAttributes(d, filteredImages[0], meta)
+	return filteredImages[0], nil
+}
+
+// checkAMIOwnerAllowed returns an error if the AMI's owner is not one of the allowed owners.
+// Owners may be specified as AWS account IDs or owner aliases (e.g. "amazon").
+// An empty list of allowed owners allows any owner.
+func checkAMIOwnerAllowed(image *ec2.Image, region string, allowedOwners []string) error {
+	if len(allowedOwners) == 0 {
+		return nil
+	}
+
+	ownerID := aws.StringValue(image.OwnerId)
+	ownerAlias := aws.StringValue(image.ImageOwnerAlias)
+
+	for _, v := range allowedOwners {
+		if v == ownerID || (ownerAlias != "" && v == ownerAlias) {
+			return nil
+		}
+	}
+
+	owner := ownerID
+	if ownerAlias != "" {
+		owner = fmt.Sprintf("%s (%s)", ownerID, ownerAlias)
+	}
+
+	return fmt.Errorf("AMI (%s) %q in region (%s) is owned by %s, which is not one of the allowed_owners: %s",
+		aws.StringValue(image.ImageId), aws.StringValue(image.Name), region, owner, strings.Join(allowedOwners, ", "))
 }
 
 // populate the numerous fields that the image description returns.
@@ -202,6 +202,50 @@ func TestAccEC2AMIDataSource_gp3BlockDevice(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMIDataSource_regions(t *testing.T) {
+	datasourceName := "data.aws_ami.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIDataSourceConfig_regions(acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIIDDataSource(datasourceName),
+					resource.TestCheckResourceAttr(datasourceName, "region_image_ids.%", "2"),
+					resource.TestCheckResourceAttrPair(datasourceName, fmt.Sprintf("region_image_ids.%s", acctest.Region()), datasourceName, "image_id"),
+					resource.TestMatchResourceAttr(datasourceName, fmt.Sprintf("region_image_ids.%s", acctest.AlternateRegion()), regexp.MustCompile("^ami-")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2AMIDataSource_allowedOwners(t *testing.T) {
+	datasourceName := "data.aws_ami.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIDataSourceConfig_allowedOwners(`"amazon"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIIDDataSource(datasourceName),
+					resource.TestCheckResourceAttr(datasourceName, "image_owner_alias", "amazon"),
+				),
+			},
+			{
+				Config:      testAccAMIDataSourceConfig_allowedOwners(`"123456789012"`),
+				ExpectError: regexp.MustCompile(`which is not one of the allowed_owners: 123456789012`),
+			},
+		},
+	})
+}
+
 func testAccCheckAMIIDDataSource(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -327,3 +371,33 @@ data "aws_ami" "test" {
 }
 `)
 }
+
+func testAccAMIDataSourceConfig_regions(region string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+  regions     = [%[1]q]
+
+  filter {
+    name   = "name"
+    values = ["amzn2-ami-hvm-*-x86_64-gp2"]
+  }
+}
+`, region)
+}
+
+func testAccAMIDataSourceConfig_allowedOwners(allowedOwners string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test" {
+  most_recent    = true
+  owners         = ["amazon"]
+  allowed_owners = [%[1]s]
+
+  filter {
+    name   = "name"
+    values = ["amzn2-ami-hvm-*-x86_64-gp2"]
+  }
+}
+`, allowedOwners)
+}
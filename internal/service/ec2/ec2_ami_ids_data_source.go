@@ -74,7 +74,9 @@ func dataSourceAMIIDsRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Reading AMI IDs: %s", params)
-	resp, err := conn.DescribeImages(params)
+	resp, err := conns.CachedRead(meta.(*conns.AWSClient), "ec2.DescribeImages:"+params.String(), func() (*ec2.DescribeImagesOutput, error) {
+		return conn.DescribeImages(params)
+	})
 	if err != nil {
 		return err
 	}
@@ -100,7 +102,8 @@ func dataSourceAMIIDsRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		filteredImages = resp.Images[:]
+		// Copy the cached images before sorting.
+		filteredImages = append(filteredImages, resp.Images...)
 	}
 
 	sort.Slice(filteredImages, func(i, j int) bool {
@@ -37,6 +37,11 @@ func DataSourceInstances() *schema.Resource {
 					ValidateFunc: validation.StringInSlice(ec2.InstanceStateName_Values(), false),
 				},
 			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"private_ips": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -80,15 +85,12 @@ func dataSourceInstancesRead(d *schema.ResourceData, meta interface{}) error {
 		input.Filters = nil
 	}
 
-	output, err := FindInstances(conn, input)
-
-	if err != nil {
-		return fmt.Errorf("error reading EC2 Instances: %w", err)
-	}
-
+	// Only the projected attributes of each page of instances are retained.
+	input.MaxResults = aws.Int64(1000)
+	limit := d.Get("limit").(int)
 	var instanceIDs, privateIPs, publicIPs []string
 
-	for _, v := range output {
+	err := FindInstancesFunc(conn, input, func(v *ec2.Instance) bool {
 		instanceIDs = append(instanceIDs, aws.StringValue(v.InstanceId))
 		if privateIP := aws.StringValue(v.PrivateIpAddress); privateIP != "" {
 			privateIPs = append(privateIPs, privateIP)
@@ -96,6 +98,12 @@ func dataSourceInstancesRead(d *schema.ResourceData, meta interface{}) error {
 		if publicIP := aws.StringValue(v.PublicIpAddress); publicIP != "" {
 			publicIPs = append(publicIPs, publicIP)
 		}
+
+		return limit == 0 || len(instanceIDs) < limit
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instances: %w", err)
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
@@ -67,6 +67,25 @@ func TestAccEC2InstancesDataSource_instanceStateNames(t *testing.T) {
 	})
 }
 
+func TestAccEC2InstancesDataSource_limit(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_limit(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_instances.test", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_instances.test", "private_ips.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2InstancesDataSource_empty(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -158,6 +177,33 @@ data "aws_instances" "test" {
 `, rName))
 }
 
+func testAccInstancesDataSourceConfig_limit(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  count         = 2
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_instances" "test" {
+  instance_tags = {
+    Name = aws_instance.test[0].tags["Name"]
+  }
+
+  limit = 1
+
+  depends_on = [aws_instance.test]
+}
+`, rName))
+}
+
 func testAccInstancesDataSourceConfig_instanceStateNames(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
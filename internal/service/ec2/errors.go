@@ -16,6 +16,7 @@ const (
 	errCodeDependencyViolation                            = "DependencyViolation"
 	errCodeGatewayNotAttached                             = "Gateway.NotAttached"
 	errCodeIncorrectState                                 = "IncorrectState"
+	errCodeInsufficientCIDRBlocks                         = "InsufficientCidrBlocks"
 	errCodeInvalidAMIIDNotFound                           = "InvalidAMIID.NotFound"
 	errCodeInvalidAMIIDUnavailable                        = "InvalidAMIID.Unavailable"
 	errCodeInvalidAddressNotFound                         = "InvalidAddress.NotFound"
@@ -89,6 +90,10 @@ const (
 	errCodeInvalidTransitGatewayPolicyTableIdNotFound     = "InvalidTransitGatewayPolicyTableId.NotFound"
 	errCodeInvalidTransitGatewayIDNotFound                = "InvalidTransitGatewayID.NotFound"
 	errCodeInvalidTransitGatewayMulticastDomainIdNotFound = "InvalidTransitGatewayMulticastDomainId.NotFound"
+	errCodeInvalidVerifiedAccessEndpointIdNotFound        = "InvalidVerifiedAccessEndpointId.NotFound"
+	errCodeInvalidVerifiedAccessGroupIdNotFound           = "InvalidVerifiedAccessGroupId.NotFound"
+	errCodeInvalidVerifiedAccessInstanceIdNotFound        = "InvalidVerifiedAccessInstanceId.NotFound"
+	errCodeInvalidVerifiedAccessTrustProviderIdNotFound   = "InvalidVerifiedAccessTrustProviderId.NotFound"
 	errCodeInvalidVolumeNotFound                          = "InvalidVolume.NotFound"
 	errCodeInvalidVPCCIDRBlockAssociationIDNotFound       = "InvalidVpcCidrBlockAssociationID.NotFound"
 	errCodeInvalidVPCEndpointIdNotFound                   = "InvalidVpcEndpointId.NotFound"
@@ -103,6 +108,7 @@ const (
 	errCodePrefixListVersionMismatch                      = "PrefixListVersionMismatch"
 	errCodeResourceNotReady                               = "ResourceNotReady"
 	errCodeSnapshotCreationPerVolumeRateExceeded          = "SnapshotCreationPerVolumeRateExceeded"
+	errCodeUnauthorizedOperation                          = "UnauthorizedOperation"
 	errCodeUnsupportedOperation                           = "UnsupportedOperation"
 	errCodeVolumeInUse                                    = "VolumeInUse"
 )
@@ -1008,6 +1008,21 @@ func FindImageLaunchPermission(ctx context.Context, conn *ec2.EC2, imageID, acco
 func FindInstances(conn *ec2.EC2, input *ec2.DescribeInstancesInput) ([]*ec2.Instance, error) {
 	var output []*ec2.Instance
 
+	err := FindInstancesFunc(conn, input, func(v *ec2.Instance) bool {
+		output = append(output, v)
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindInstancesFunc calls fn for each instance matching the input, in order, until fn returns false.
+func FindInstancesFunc(conn *ec2.EC2, input *ec2.DescribeInstancesInput, fn func(*ec2.Instance) bool) error {
 	err := conn.DescribeInstancesPages(input, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
@@ -1016,8 +1031,8 @@ func FindInstances(conn *ec2.EC2, input *ec2.DescribeInstancesInput) ([]*ec2.Ins
 		for _, v := range page.Reservations {
 			if v != nil {
 				for _, v := range v.Instances {
-					if v != nil {
-						output = append(output, v)
+					if v != nil && !fn(v) {
+						return false
 					}
 				}
 			}
@@ -1027,17 +1042,13 @@ func FindInstances(conn *ec2.EC2, input *ec2.DescribeInstancesInput) ([]*ec2.Ins
 	})
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceIDNotFound) {
-		return nil, &resource.NotFoundError{
+		return &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return err
 }
 
 func FindInstance(conn *ec2.EC2, input *ec2.DescribeInstancesInput) (*ec2.Instance, error) {
@@ -2452,14 +2463,29 @@ func FindSubnet(conn *ec2.EC2, input *ec2.DescribeSubnetsInput) (*ec2.Subnet, er
 func FindSubnets(conn *ec2.EC2, input *ec2.DescribeSubnetsInput) ([]*ec2.Subnet, error) {
 	var output []*ec2.Subnet
 
+	err := FindSubnetsFunc(conn, input, func(v *ec2.Subnet) bool {
+		output = append(output, v)
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindSubnetsFunc calls fn for each subnet matching the input, in order, until fn returns false.
+func FindSubnetsFunc(conn *ec2.EC2, input *ec2.DescribeSubnetsInput, fn func(*ec2.Subnet) bool) error {
 	err := conn.DescribeSubnetsPages(input, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
 		for _, v := range page.Subnets {
-			if v != nil {
-				output = append(output, v)
+			if v != nil && !fn(v) {
+				return false
 			}
 		}
 
@@ -2467,17 +2493,13 @@ func FindSubnets(conn *ec2.EC2, input *ec2.DescribeSubnetsInput) ([]*ec2.Subnet,
 	})
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidSubnetIDNotFound) {
-		return nil, &resource.NotFoundError{
+		return &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return err
 }
 
 func FindSubnetCIDRReservationBySubnetIDAndReservationID(conn *ec2.EC2, subnetID, reservationID string) (*ec2.SubnetCidrReservation, error) {
@@ -5551,6 +5573,28 @@ func FindIPAMPoolAllocationByTwoPartKey(conn *ec2.EC2, allocationID, poolID stri
 	return output, nil
 }
 
+func FindIPAMPoolAllocationByResourceIDAndCIDR(conn *ec2.EC2, poolID, resourceID, cidrBlock string) (*ec2.IpamPoolAllocation, error) {
+	input := &ec2.GetIpamPoolAllocationsInput{
+		IpamPoolId: aws.String(poolID),
+	}
+
+	output, err := FindIPAMPoolAllocations(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.StringValue(v.ResourceId) == resourceID && aws.StringValue(v.Cidr) == cidrBlock {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
 func FindIPAMPoolCIDR(conn *ec2.EC2, input *ec2.GetIpamPoolCidrsInput) (*ec2.IpamPoolCidr, error) {
 	output, err := FindIPAMPoolCIDRs(conn, input)
 
@@ -6539,3 +6583,358 @@ func FindNetworkPerformanceMetricSubscriptionByFourPartKey(ctx context.Context,
 
 	return nil, &resource.NotFoundError{}
 }
+
+func FindVerifiedAccessEndpoint(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessEndpointsInput) (*ec2.VerifiedAccessEndpoint, error) {
+	output, err := FindVerifiedAccessEndpoints(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil || output[0].Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessEndpoints(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessEndpointsInput) ([]*ec2.VerifiedAccessEndpoint, error) {
+	var output []*ec2.VerifiedAccessEndpoint
+
+	err := conn.DescribeVerifiedAccessEndpointsPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessEndpoints {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessEndpointIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessEndpointByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.VerifiedAccessEndpoint, error) {
+	input := &ec2.DescribeVerifiedAccessEndpointsInput{
+		VerifiedAccessEndpointIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessEndpoint(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(output.Status.Code); status == ec2.VerifiedAccessEndpointStatusCodeDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessEndpointId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessEndpointPolicyByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.GetVerifiedAccessEndpointPolicyOutput, error) {
+	input := &ec2.GetVerifiedAccessEndpointPolicyInput{
+		VerifiedAccessEndpointId: aws.String(id),
+	}
+
+	output, err := conn.GetVerifiedAccessEndpointPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessEndpointIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessGroup(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessGroupsInput) (*ec2.VerifiedAccessGroup, error) {
+	output, err := FindVerifiedAccessGroups(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessGroups(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessGroupsInput) ([]*ec2.VerifiedAccessGroup, error) {
+	var output []*ec2.VerifiedAccessGroup
+
+	err := conn.DescribeVerifiedAccessGroupsPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessGroups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessGroupIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessGroupByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.VerifiedAccessGroup, error) {
+	input := &ec2.DescribeVerifiedAccessGroupsInput{
+		VerifiedAccessGroupIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessGroup(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessGroupId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessGroupPolicyByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.GetVerifiedAccessGroupPolicyOutput, error) {
+	input := &ec2.GetVerifiedAccessGroupPolicyInput{
+		VerifiedAccessGroupId: aws.String(id),
+	}
+
+	output, err := conn.GetVerifiedAccessGroupPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessGroupIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessInstance(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessInstancesInput) (*ec2.VerifiedAccessInstance, error) {
+	output, err := FindVerifiedAccessInstances(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessInstances(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessInstancesInput) ([]*ec2.VerifiedAccessInstance, error) {
+	var output []*ec2.VerifiedAccessInstance
+
+	err := conn.DescribeVerifiedAccessInstancesPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessInstances {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessInstanceByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.VerifiedAccessInstance, error) {
+	input := &ec2.DescribeVerifiedAccessInstancesInput{
+		VerifiedAccessInstanceIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessInstance(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessInstanceId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessInstanceTrustProviderAttachmentExists(ctx context.Context, conn *ec2.EC2, instanceID, trustProviderID string) error {
+	output, err := FindVerifiedAccessInstanceByID(ctx, conn, instanceID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range output.VerifiedAccessTrustProviders {
+		if aws.StringValue(v.VerifiedAccessTrustProviderId) == trustProviderID {
+			return nil
+		}
+	}
+
+	return &resource.NotFoundError{
+		LastError: fmt.Errorf("Verified Access Instance (%s) Trust Provider (%s) attachment not found", instanceID, trustProviderID),
+	}
+}
+
+func FindVerifiedAccessTrustProvider(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessTrustProvidersInput) (*ec2.VerifiedAccessTrustProvider, error) {
+	output, err := FindVerifiedAccessTrustProviders(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessTrustProviders(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessTrustProvidersInput) ([]*ec2.VerifiedAccessTrustProvider, error) {
+	var output []*ec2.VerifiedAccessTrustProvider
+
+	err := conn.DescribeVerifiedAccessTrustProvidersPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessTrustProvidersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessTrustProviders {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessTrustProviderIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessTrustProviderByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.VerifiedAccessTrustProvider, error) {
+	input := &ec2.DescribeVerifiedAccessTrustProvidersInput{
+		VerifiedAccessTrustProviderIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessTrustProvider(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessTrustProviderId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
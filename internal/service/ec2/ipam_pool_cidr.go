@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -34,10 +35,11 @@ func ResourceIPAMPoolCIDR() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"cidr": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"netmask_length"},
 				ValidateFunc: validation.Any(
 					verify.ValidIPv4CIDRNetworkAddress,
 					verify.ValidIPv6CIDRNetworkAddress,
@@ -68,6 +70,13 @@ func ResourceIPAMPoolCIDR() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"netmask_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidr"},
+				ValidateFunc:  validation.IntBetween(0, 128),
+			},
 		},
 	}
 }
@@ -84,6 +93,28 @@ func resourceIPAMPoolCIDRCreate(d *schema.ResourceData, meta interface{}) error
 		input.Cidr = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("netmask_length"); ok {
+		sourcePoolID, err := findIPAMPoolSourcePoolID(conn, poolID)
+
+		if err != nil {
+			return fmt.Errorf("creating IPAM Pool (%s) CIDR: %w", poolID, err)
+		}
+
+		// Nothing is reserved by a preview, so sibling pool CIDRs sized from the same source pool
+		// must not preview until the previous one's provisioned CIDR has been allocated.
+		mutexKey := "ipam_pool_cidr_source_pool_" + sourcePoolID
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		cidr, err := previewIPAMPoolCIDRFromSourcePool(conn, sourcePoolID, v.(int))
+
+		if err != nil {
+			return fmt.Errorf("creating IPAM Pool (%s) CIDR: %w", poolID, err)
+		}
+
+		input.Cidr = aws.String(cidr)
+	}
+
 	if v, ok := d.GetOk("cidr_authorization_context"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.CidrAuthorizationContext = expandIPAMCIDRAuthorizationContext(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -97,7 +128,7 @@ func resourceIPAMPoolCIDRCreate(d *schema.ResourceData, meta interface{}) error
 	cidrBlock := aws.StringValue(output.IpamPoolCidr.Cidr)
 	d.SetId(IPAMPoolCIDRCreateResourceID(cidrBlock, poolID))
 
-	if _, err := WaitIPAMPoolCIDRCreated(conn, cidrBlock, poolID, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := WaitIPAMPoolCIDRCreated(conn, cidrBlock, poolID, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("waiting for IPAM Pool CIDR (%s) create: %w", d.Id(), err)
 	}
 
@@ -159,9 +190,64 @@ func resourceIPAMPoolCIDRDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("waiting for IPAM Pool CIDR (%s) delete: %w", d.Id(), err)
 	}
 
+	// A CIDR provisioned to a child pool is allocated from its source pool.
+	// Wait for that allocation to be released so the source pool's CIDR can itself be deprovisioned.
+	pool, err := FindIPAMPoolByID(conn, poolID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IPAM Pool (%s): %w", poolID, err)
+	}
+
+	if sourcePoolID := aws.StringValue(pool.SourceIpamPoolId); sourcePoolID != "" {
+		if _, err := WaitIPAMPoolAllocationReleased(conn, sourcePoolID, poolID, cidrBlock, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("waiting for IPAM Pool CIDR (%s) release from source IPAM Pool (%s): %w", d.Id(), sourcePoolID, err)
+		}
+	}
+
 	return nil
 }
 
+// findIPAMPoolSourcePoolID returns the ID of the pool's source pool.
+func findIPAMPoolSourcePoolID(conn *ec2.EC2, poolID string) (string, error) {
+	pool, err := FindIPAMPoolByID(conn, poolID)
+
+	if err != nil {
+		return "", fmt.Errorf("reading IPAM Pool (%s): %w", poolID, err)
+	}
+
+	sourcePoolID := aws.StringValue(pool.SourceIpamPoolId)
+
+	if sourcePoolID == "" {
+		return "", fmt.Errorf("netmask_length can only be used with an IPAM Pool that has a source IPAM Pool")
+	}
+
+	return sourcePoolID, nil
+}
+
+// previewIPAMPoolCIDRFromSourcePool returns the next CIDR of the given netmask length available in the source pool.
+func previewIPAMPoolCIDRFromSourcePool(conn *ec2.EC2, sourcePoolID string, netmaskLength int) (string, error) {
+	output, err := conn.AllocateIpamPoolCidr(&ec2.AllocateIpamPoolCidrInput{
+		ClientToken:     aws.String(resource.UniqueId()),
+		IpamPoolId:      aws.String(sourcePoolID),
+		NetmaskLength:   aws.Int64(int64(netmaskLength)),
+		PreviewNextCidr: aws.Bool(true),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("previewing next CIDR from source IPAM Pool (%s): %w", sourcePoolID, err)
+	}
+
+	if output == nil || output.IpamPoolAllocation == nil {
+		return "", fmt.Errorf("previewing next CIDR from source IPAM Pool (%s): empty response", sourcePoolID)
+	}
+
+	return aws.StringValue(output.IpamPoolAllocation.Cidr), nil
+}
+
 const ipamPoolCIDRIDSeparator = "_"
 
 func IPAMPoolCIDRCreateResourceID(cidrBlock, poolID string) string {
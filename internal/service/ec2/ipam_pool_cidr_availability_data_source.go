@@ -0,0 +1,106 @@
+package ec2
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceIPAMPoolCIDRAvailability() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIPAMPoolCIDRAvailabilityRead,
+
+		Schema: map[string]*schema.Schema{
+			"available": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disallowed_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.Any(
+						verify.ValidIPv4CIDRNetworkAddress,
+						verify.ValidIPv6CIDRNetworkAddress,
+					),
+				},
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"netmask_length": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+		},
+	}
+}
+
+func dataSourceIPAMPoolCIDRAvailabilityRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	poolID := d.Get("ipam_pool_id").(string)
+	netmaskLength := d.Get("netmask_length").(int)
+	input := &ec2.AllocateIpamPoolCidrInput{
+		ClientToken:     aws.String(resource.UniqueId()),
+		IpamPoolId:      aws.String(poolID),
+		NetmaskLength:   aws.Int64(int64(netmaskLength)),
+		PreviewNextCidr: aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk("disallowed_cidrs"); ok && v.(*schema.Set).Len() > 0 {
+		input.DisallowedCidrs = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	output, err := conn.AllocateIpamPoolCidr(input)
+
+	// The pool has no free space for a CIDR of the requested size.
+	if tfawserr.ErrCodeEquals(err, errCodeInsufficientCIDRBlocks) {
+		var awsErr awserr.Error
+
+		if errors.As(err, &awsErr) {
+			d.SetId(fmt.Sprintf("%s_%d", poolID, netmaskLength))
+			d.Set("available", false)
+			d.Set("cidr", "")
+			d.Set("message", awsErr.Message())
+
+			return nil
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("previewing next CIDR from IPAM Pool (%s): %w", poolID, err)
+	}
+
+	if output == nil || output.IpamPoolAllocation == nil {
+		return fmt.Errorf("previewing next CIDR from IPAM Pool (%s): empty response", poolID)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%d", poolID, netmaskLength))
+	d.Set("available", true)
+	d.Set("cidr", output.IpamPoolAllocation.Cidr)
+	d.Set("message", "")
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIPAMPoolCIDRAvailabilityDataSource_basic(t *testing.T) {
+	datasourceName := "data.aws_vpc_ipam_pool_cidr_availability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMPoolCIDRAvailabilityDataSourceConfig_basic(28),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "available", "true"),
+					resource.TestCheckResourceAttr(datasourceName, "cidr", "172.2.0.0/28"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "message", ""),
+					resource.TestCheckResourceAttr(datasourceName, "netmask_length", "28"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIPAMPoolCIDRAvailabilityDataSource_unavailable(t *testing.T) {
+	datasourceName := "data.aws_vpc_ipam_pool_cidr_availability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMPoolCIDRAvailabilityDataSourceConfig_basic(16),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "available", "false"),
+					resource.TestCheckResourceAttr(datasourceName, "cidr", ""),
+					resource.TestCheckResourceAttrSet(datasourceName, "message"),
+					resource.TestCheckResourceAttr(datasourceName, "netmask_length", "16"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIPAMPoolCIDRAvailabilityDataSourceConfig_basic(netmaskLength int) string {
+	return acctest.ConfigCompose(
+		testAccIPAMPreviewNextCIDRDataSourceConfig_base,
+		fmt.Sprintf(`
+data "aws_vpc_ipam_pool_cidr_availability" "test" {
+  ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  netmask_length = %[1]d
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+`, netmaskLength))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -41,6 +42,60 @@ func TestAccIPAMPoolCIDR_basic(t *testing.T) {
 	})
 }
 
+func TestAccIPAMPoolCIDR_netmaskLength(t *testing.T) {
+	var cidr ec2.IpamPoolCidr
+	resourceName := "aws_vpc_ipam_pool_cidr.child"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMPoolCIDRDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMPoolCIDRConfig_netmaskLength(28),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMPoolCIDRExists(resourceName, &cidr),
+					resource.TestCheckResourceAttr(resourceName, "cidr", "10.0.0.0/28"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_pool_id", "aws_vpc_ipam_pool.child", "id"),
+					resource.TestCheckResourceAttr(resourceName, "netmask_length", "28"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"netmask_length"},
+			},
+		},
+	})
+}
+
+func TestAccIPAMPoolCIDR_netmaskLengthSiblings(t *testing.T) {
+	var cidr1, cidr2 ec2.IpamPoolCidr
+	resource1Name := "aws_vpc_ipam_pool_cidr.child1"
+	resource2Name := "aws_vpc_ipam_pool_cidr.child2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMPoolCIDRDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMPoolCIDRConfig_netmaskLengthSiblings(28),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMPoolCIDRExists(resource1Name, &cidr1),
+					testAccCheckIPAMPoolCIDRExists(resource2Name, &cidr2),
+					testAccCheckIPAMPoolCIDRsDiffer(&cidr1, &cidr2),
+					resource.TestCheckResourceAttr(resource1Name, "netmask_length", "28"),
+					resource.TestCheckResourceAttr(resource2Name, "netmask_length", "28"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIPAMPoolCIDR_disappears(t *testing.T) {
 	var cidr ec2.IpamPoolCidr
 	resourceName := "aws_vpc_ipam_pool_cidr.test"
@@ -119,6 +174,16 @@ func testAccCheckIPAMPoolCIDRExists(n string, v *ec2.IpamPoolCidr) resource.Test
 	}
 }
 
+func testAccCheckIPAMPoolCIDRsDiffer(cidr1, cidr2 *ec2.IpamPoolCidr) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v1, v2 := aws.StringValue(cidr1.Cidr), aws.StringValue(cidr2.Cidr); v1 == v2 {
+			return fmt.Errorf("IPAM Pool CIDRs are the same: %s", v1)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckIPAMPoolCIDRDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
 
@@ -179,3 +244,58 @@ resource "aws_vpc_ipam_pool_cidr" "test" {
 }
 `, cidr))
 }
+
+func testAccIPAMPoolCIDRConfig_netmaskLength(netmaskLength int) string {
+	return acctest.ConfigCompose(testAccIPAMPoolCIDRConfig_base, testAccIPAMPoolCIDRConfig_privatePool, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "10.0.0.0/24"
+}
+
+resource "aws_vpc_ipam_pool" "child" {
+  address_family      = "ipv4"
+  ipam_scope_id       = aws_vpc_ipam.test.private_default_scope_id
+  locale              = data.aws_region.current.name
+  source_ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+
+resource "aws_vpc_ipam_pool_cidr" "child" {
+  ipam_pool_id   = aws_vpc_ipam_pool.child.id
+  netmask_length = %[1]d
+}
+`, netmaskLength))
+}
+
+func testAccIPAMPoolCIDRConfig_netmaskLengthSiblings(netmaskLength int) string {
+	return acctest.ConfigCompose(testAccIPAMPoolCIDRConfig_base, testAccIPAMPoolCIDRConfig_privatePool, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "10.0.0.0/24"
+}
+
+resource "aws_vpc_ipam_pool" "child" {
+  address_family      = "ipv4"
+  ipam_scope_id       = aws_vpc_ipam.test.private_default_scope_id
+  locale              = data.aws_region.current.name
+  source_ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+
+resource "aws_vpc_ipam_pool_cidr" "child1" {
+  ipam_pool_id   = aws_vpc_ipam_pool.child.id
+  netmask_length = %[1]d
+}
+
+resource "aws_vpc_ipam_pool_cidr" "child2" {
+  ipam_pool_id   = aws_vpc_ipam_pool.child.id
+  netmask_length = %[1]d
+}
+`, netmaskLength))
+}
@@ -1317,6 +1317,26 @@ func StatusIPAMPoolState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	}
 }
 
+const (
+	IPAMPoolAllocationStatusAllocated = "allocated"
+)
+
+func StatusIPAMPoolAllocation(conn *ec2.EC2, poolID, resourceID, cidrBlock string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindIPAMPoolAllocationByResourceIDAndCIDR(conn, poolID, resourceID, cidrBlock)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, IPAMPoolAllocationStatusAllocated, nil
+	}
+}
+
 func StatusIPAMPoolCIDRState(conn *ec2.EC2, cidrBlock, poolID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindIPAMPoolCIDRByTwoPartKey(conn, cidrBlock, poolID)
@@ -1348,3 +1368,19 @@ func StatusIPAMScopeState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.State), nil
 	}
 }
+
+func StatusVerifiedAccessEndpointStatus(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVerifiedAccessEndpointByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.Code), nil
+	}
+}
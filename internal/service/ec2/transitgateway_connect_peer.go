@@ -52,6 +52,34 @@ func ResourceTransitGatewayConnectPeer() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.Valid4ByteASN,
 			},
+			"bgp_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bgp_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"inside_cidr_blocks": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -167,6 +195,9 @@ func resourceTransitGatewayConnectPeerRead(ctx context.Context, d *schema.Resour
 	}.String()
 	d.Set("arn", arn)
 	d.Set("bgp_asn", strconv.FormatInt(aws.Int64Value(transitGatewayConnectPeer.ConnectPeerConfiguration.BgpConfigurations[0].PeerAsn), 10))
+	if err := d.Set("bgp_configurations", flattenTransitGatewayAttachmentBGPConfigurations(transitGatewayConnectPeer.ConnectPeerConfiguration.BgpConfigurations)); err != nil {
+		return diag.Errorf("setting bgp_configurations: %s", err)
+	}
 	d.Set("inside_cidr_blocks", aws.StringValueSlice(transitGatewayConnectPeer.ConnectPeerConfiguration.InsideCidrBlocks))
 	d.Set("peer_address", transitGatewayConnectPeer.ConnectPeerConfiguration.PeerAddress)
 	d.Set("transit_gateway_address", transitGatewayConnectPeer.ConnectPeerConfiguration.TransitGatewayAddress)
@@ -222,3 +253,51 @@ func resourceTransitGatewayConnectPeerDelete(ctx context.Context, d *schema.Reso
 
 	return nil
 }
+
+func flattenTransitGatewayAttachmentBGPConfiguration(apiObject *ec2.TransitGatewayAttachmentBgpConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BgpStatus; v != nil {
+		tfMap["bgp_status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PeerAddress; v != nil {
+		tfMap["peer_address"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PeerAsn; v != nil {
+		tfMap["peer_asn"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	if v := apiObject.TransitGatewayAddress; v != nil {
+		tfMap["transit_gateway_address"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TransitGatewayAsn; v != nil {
+		tfMap["transit_gateway_asn"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	return tfMap
+}
+
+func flattenTransitGatewayAttachmentBGPConfigurations(apiObjects []*ec2.TransitGatewayAttachmentBgpConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTransitGatewayAttachmentBGPConfiguration(apiObject))
+	}
+
+	return tfList
+}
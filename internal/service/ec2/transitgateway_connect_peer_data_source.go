@@ -33,6 +33,34 @@ func DataSourceTransitGatewayConnectPeer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"bgp_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bgp_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"filter": DataSourceFiltersSchema(),
 			"inside_cidr_blocks": {
 				Type:     schema.TypeList,
@@ -92,6 +120,9 @@ func dataSourceTransitGatewayConnectPeerRead(ctx context.Context, d *schema.Reso
 	}.String()
 	d.Set("arn", arn)
 	d.Set("bgp_asn", strconv.FormatInt(aws.Int64Value(transitGatewayConnectPeer.ConnectPeerConfiguration.BgpConfigurations[0].PeerAsn), 10))
+	if err := d.Set("bgp_configurations", flattenTransitGatewayAttachmentBGPConfigurations(transitGatewayConnectPeer.ConnectPeerConfiguration.BgpConfigurations)); err != nil {
+		return diag.Errorf("setting bgp_configurations: %s", err)
+	}
 	d.Set("inside_cidr_blocks", aws.StringValueSlice(transitGatewayConnectPeer.ConnectPeerConfiguration.InsideCidrBlocks))
 	d.Set("peer_address", transitGatewayConnectPeer.ConnectPeerConfiguration.PeerAddress)
 	d.Set("transit_gateway_address", transitGatewayConnectPeer.ConnectPeerConfiguration.TransitGatewayAddress)
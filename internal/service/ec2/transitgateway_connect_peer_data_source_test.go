@@ -26,6 +26,7 @@ func testAccTransitGatewayConnectPeerDataSource_Filter(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_asn", resourceName, "bgp_asn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_configurations.#", resourceName, "bgp_configurations.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "inside_cidr_blocks.#", resourceName, "inside_cidr_blocks.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "peer_address", resourceName, "peer_address"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
@@ -54,6 +55,7 @@ func testAccTransitGatewayConnectPeerDataSource_ID(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_asn", resourceName, "bgp_asn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_configurations.#", resourceName, "bgp_configurations.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "inside_cidr_blocks.#", resourceName, "inside_cidr_blocks.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "peer_address", resourceName, "peer_address"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
@@ -31,6 +31,8 @@ func testAccTransitGatewayConnectPeer_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTransitGatewayConnectPeerExists(resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "bgp_asn", "64512"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_configurations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_configurations.0.peer_asn", "64512"),
 					resource.TestCheckResourceAttr(resourceName, "inside_cidr_blocks.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "peer_address", "1.1.1.1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
@@ -0,0 +1,246 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceTransitGatewayPrefixListReferences() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransitGatewayPrefixListReferencesCreate,
+		Read:   resourceTransitGatewayPrefixListReferencesRead,
+		Update: resourceTransitGatewayPrefixListReferencesUpdate,
+		Delete: resourceTransitGatewayPrefixListReferencesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"prefix_list_reference": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blackhole": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"prefix_list_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"transit_gateway_attachment_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayPrefixListReferencesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+
+	if err := syncTransitGatewayPrefixListReferences(conn, transitGatewayRouteTableID, nil, d.Get("prefix_list_reference").(*schema.Set).List()); err != nil {
+		return fmt.Errorf("creating EC2 Transit Gateway Route Table (%s) prefix list references: %w", transitGatewayRouteTableID, err)
+	}
+
+	d.SetId(transitGatewayRouteTableID)
+
+	return resourceTransitGatewayPrefixListReferencesRead(d, meta)
+}
+
+func resourceTransitGatewayPrefixListReferencesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.GetTransitGatewayPrefixListReferencesInput{
+		TransitGatewayRouteTableId: aws.String(d.Id()),
+	}
+
+	output, err := FindTransitGatewayPrefixListReferences(conn, input)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) not found, removing prefix list references from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Transit Gateway Route Table (%s) prefix list references: %w", d.Id(), err)
+	}
+
+	var tfList []interface{}
+
+	for _, v := range output {
+		if aws.StringValue(v.State) == ec2.TransitGatewayPrefixListReferenceStateDeleting {
+			continue
+		}
+
+		tfList = append(tfList, flattenTransitGatewayPrefixListReference(v))
+	}
+
+	if err := d.Set("prefix_list_reference", tfList); err != nil {
+		return fmt.Errorf("setting prefix_list_reference: %w", err)
+	}
+	d.Set("transit_gateway_route_table_id", d.Id())
+
+	return nil
+}
+
+func resourceTransitGatewayPrefixListReferencesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("prefix_list_reference") {
+		o, n := d.GetChange("prefix_list_reference")
+
+		if err := syncTransitGatewayPrefixListReferences(conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return fmt.Errorf("updating EC2 Transit Gateway Route Table (%s) prefix list references: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTransitGatewayPrefixListReferencesRead(d, meta)
+}
+
+func resourceTransitGatewayPrefixListReferencesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route Table (%s) prefix list references", d.Id())
+	err := syncTransitGatewayPrefixListReferences(conn, d.Id(), d.Get("prefix_list_reference").(*schema.Set).List(), nil)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Transit Gateway Route Table (%s) prefix list references: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// syncTransitGatewayPrefixListReferences deletes, modifies and creates prefix list references, keyed by prefix list ID, to move from the old to the new set.
+func syncTransitGatewayPrefixListReferences(conn *ec2.EC2, transitGatewayRouteTableID string, old, new []interface{}) error {
+	oldMap := make(map[string]map[string]interface{}, len(old))
+	for _, v := range old {
+		tfMap := v.(map[string]interface{})
+		oldMap[tfMap["prefix_list_id"].(string)] = tfMap
+	}
+
+	newMap := make(map[string]map[string]interface{}, len(new))
+	for _, v := range new {
+		tfMap := v.(map[string]interface{})
+		newMap[tfMap["prefix_list_id"].(string)] = tfMap
+	}
+
+	for prefixListID := range oldMap {
+		if _, ok := newMap[prefixListID]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting EC2 Transit Gateway Prefix List Reference: %s", TransitGatewayPrefixListReferenceCreateResourceID(transitGatewayRouteTableID, prefixListID))
+		_, err := conn.DeleteTransitGatewayPrefixListReference(&ec2.DeleteTransitGatewayPrefixListReferenceInput{
+			PrefixListId:               aws.String(prefixListID),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		})
+
+		// The reference, or its prefix list, may already have been deleted.
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidPrefixListIDNotFound, errCodeInvalidRouteNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting prefix list (%s) reference: %w", prefixListID, err)
+		}
+
+		if _, err := WaitTransitGatewayPrefixListReferenceStateDeleted(conn, transitGatewayRouteTableID, prefixListID); err != nil {
+			return fmt.Errorf("waiting for prefix list (%s) reference delete: %w", prefixListID, err)
+		}
+	}
+
+	for prefixListID, tfMap := range newMap {
+		blackhole := tfMap["blackhole"].(bool)
+		transitGatewayAttachmentID := tfMap["transit_gateway_attachment_id"].(string)
+
+		if oldTfMap, ok := oldMap[prefixListID]; ok {
+			if oldTfMap["blackhole"].(bool) == blackhole && oldTfMap["transit_gateway_attachment_id"].(string) == transitGatewayAttachmentID {
+				continue
+			}
+
+			input := &ec2.ModifyTransitGatewayPrefixListReferenceInput{
+				Blackhole:                  aws.Bool(blackhole),
+				PrefixListId:               aws.String(prefixListID),
+				TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+			}
+
+			if transitGatewayAttachmentID != "" {
+				input.TransitGatewayAttachmentId = aws.String(transitGatewayAttachmentID)
+			}
+
+			log.Printf("[DEBUG] Updating EC2 Transit Gateway Prefix List Reference: %s", input)
+			if _, err := conn.ModifyTransitGatewayPrefixListReference(input); err != nil {
+				return fmt.Errorf("updating prefix list (%s) reference: %w", prefixListID, err)
+			}
+
+			if _, err := WaitTransitGatewayPrefixListReferenceStateUpdated(conn, transitGatewayRouteTableID, prefixListID); err != nil {
+				return fmt.Errorf("waiting for prefix list (%s) reference update: %w", prefixListID, err)
+			}
+
+			continue
+		}
+
+		input := &ec2.CreateTransitGatewayPrefixListReferenceInput{
+			Blackhole:                  aws.Bool(blackhole),
+			PrefixListId:               aws.String(prefixListID),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		if transitGatewayAttachmentID != "" {
+			input.TransitGatewayAttachmentId = aws.String(transitGatewayAttachmentID)
+		}
+
+		log.Printf("[DEBUG] Creating EC2 Transit Gateway Prefix List Reference: %s", input)
+		if _, err := conn.CreateTransitGatewayPrefixListReference(input); err != nil {
+			return fmt.Errorf("creating prefix list (%s) reference: %w", prefixListID, err)
+		}
+
+		if _, err := WaitTransitGatewayPrefixListReferenceStateCreated(conn, transitGatewayRouteTableID, prefixListID); err != nil {
+			return fmt.Errorf("waiting for prefix list (%s) reference create: %w", prefixListID, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenTransitGatewayPrefixListReference(apiObject *ec2.TransitGatewayPrefixListReference) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"blackhole":                     aws.BoolValue(apiObject.Blackhole),
+		"prefix_list_id":                aws.StringValue(apiObject.PrefixListId),
+		"transit_gateway_attachment_id": "",
+	}
+
+	if v := apiObject.TransitGatewayAttachment; v != nil {
+		tfMap["transit_gateway_attachment_id"] = aws.StringValue(v.TransitGatewayAttachmentId)
+	}
+
+	return tfMap
+}
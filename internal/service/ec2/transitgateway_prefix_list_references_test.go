@@ -0,0 +1,82 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTransitGatewayPrefixListReferences_basic(t *testing.T) {
+	resourceName := "aws_ec2_transit_gateway_prefix_list_references.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTransitGateway(t)
+			testAccPreCheckManagedPrefixList(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayPrefixListReferenceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayPrefixListReferencesConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prefix_list_reference.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "prefix_list_reference.*", map[string]string{
+						"blackhole":                     "true",
+						"transit_gateway_attachment_id": "",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "prefix_list_reference.*.transit_gateway_attachment_id", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayPrefixListReferencesConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prefix_list_reference.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "prefix_list_reference.*.transit_gateway_attachment_id", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "prefix_list_reference.*.transit_gateway_attachment_id", "aws_ec2_transit_gateway_vpc_attachment.test.1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayPrefixListReferencesConfig_basic(rName string, blackhole bool) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableAggregateConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  count = 2
+
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = "%[1]s-${count.index}"
+}
+
+resource "aws_ec2_transit_gateway_prefix_list_references" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  prefix_list_reference {
+    prefix_list_id                = aws_ec2_managed_prefix_list.test[0].id
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test[0].id
+  }
+
+  prefix_list_reference {
+    blackhole                     = %[2]t
+    prefix_list_id                = aws_ec2_managed_prefix_list.test[1].id
+    transit_gateway_attachment_id = %[2]t ? null : aws_ec2_transit_gateway_vpc_attachment.test[1].id
+  }
+}
+`, rName, blackhole))
+}
@@ -0,0 +1,143 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceTransitGatewayRouteTableAssociations() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransitGatewayRouteTableAssociationsCreate,
+		Read:   resourceTransitGatewayRouteTableAssociationsRead,
+		Update: resourceTransitGatewayRouteTableAssociationsUpdate,
+		Delete: resourceTransitGatewayRouteTableAssociationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"transit_gateway_attachment_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayRouteTableAssociationsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+
+	for _, v := range d.Get("transit_gateway_attachment_ids").(*schema.Set).List() {
+		if err := transitGatewayRouteTableAssociationUpdate(conn, transitGatewayRouteTableID, v.(string), true); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(transitGatewayRouteTableID)
+
+	return resourceTransitGatewayRouteTableAssociationsRead(d, meta)
+}
+
+func resourceTransitGatewayRouteTableAssociationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	transitGatewayAttachmentIDs, err := findTransitGatewayRouteTableAssociatedAttachmentIDs(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) not found, removing associations from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Transit Gateway Route Table (%s) associations: %w", d.Id(), err)
+	}
+
+	d.Set("transit_gateway_attachment_ids", transitGatewayAttachmentIDs)
+	d.Set("transit_gateway_route_table_id", d.Id())
+
+	return nil
+}
+
+func resourceTransitGatewayRouteTableAssociationsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("transit_gateway_attachment_ids") {
+		o, n := d.GetChange("transit_gateway_attachment_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		for _, v := range os.Difference(ns).List() {
+			if err := transitGatewayRouteTableAssociationUpdate(conn, d.Id(), v.(string), false); err != nil {
+				return err
+			}
+		}
+
+		for _, v := range ns.Difference(os).List() {
+			if err := transitGatewayRouteTableAssociationUpdate(conn, d.Id(), v.(string), true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceTransitGatewayRouteTableAssociationsRead(d, meta)
+}
+
+func resourceTransitGatewayRouteTableAssociationsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route Table (%s) associations", d.Id())
+	for _, v := range d.Get("transit_gateway_attachment_ids").(*schema.Set).List() {
+		err := transitGatewayRouteTableAssociationUpdate(conn, d.Id(), v.(string), false)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findTransitGatewayRouteTableAssociatedAttachmentIDs returns the IDs of all attachments associated with the specified route table.
+func findTransitGatewayRouteTableAssociatedAttachmentIDs(conn *ec2.EC2, transitGatewayRouteTableID string) ([]string, error) {
+	input := &ec2.GetTransitGatewayRouteTableAssociationsInput{
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	output, err := FindTransitGatewayRouteTableAssociations(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+
+	for _, v := range output {
+		switch aws.StringValue(v.State) {
+		case ec2.TransitGatewayAssociationStateAssociated, ec2.TransitGatewayAssociationStateAssociating:
+			ids = append(ids, aws.StringValue(v.TransitGatewayAttachmentId))
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,109 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTransitGatewayRouteTableAssociations_basic(t *testing.T) {
+	resourceName := "aws_ec2_transit_gateway_route_table_associations.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableAssociationsConfig_basic(rName, "aws_ec2_transit_gateway_vpc_attachment.test[*].id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayRouteTableAssociationsConfig_basic(rName, "[aws_ec2_transit_gateway_vpc_attachment.test[1].id]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableAggregateConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  cidr_block = cidrsubnet(aws_vpc.test[count.index].cidr_block, 8, 0)
+  vpc_id     = aws_vpc.test[count.index].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  count = 2
+
+  subnet_ids                                      = [aws_subnet.test[count.index].id]
+  transit_gateway_default_route_table_association = false
+  transit_gateway_default_route_table_propagation = false
+  transit_gateway_id                              = aws_ec2_transit_gateway.test.id
+  vpc_id                                          = aws_vpc.test[count.index].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccTransitGatewayRouteTableAssociationsConfig_basic(rName, attachmentIDs string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableAggregateConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_route_table_associations" "test" {
+  transit_gateway_attachment_ids = %[1]s
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`, attachmentIDs))
+}
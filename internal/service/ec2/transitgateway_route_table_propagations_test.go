@@ -0,0 +1,56 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTransitGatewayRouteTablePropagations_basic(t *testing.T) {
+	resourceName := "aws_ec2_transit_gateway_route_table_propagations.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTablePropagationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, "aws_ec2_transit_gateway_vpc_attachment.test[*].id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, "[aws_ec2_transit_gateway_vpc_attachment.test[0].id]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, attachmentIDs string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableAggregateConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_route_table_propagations" "test" {
+  transit_gateway_attachment_ids = %[1]s
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`, attachmentIDs))
+}
@@ -100,6 +100,9 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"disappearsTransitGateway":   testAccTransitGatewayPrefixListReference_disappears_TransitGateway,
 			"TransitGatewayAttachmentId": testAccTransitGatewayPrefixListReference_TransitGatewayAttachmentID,
 		},
+		"PrefixListReferences": {
+			"basic": testAccTransitGatewayPrefixListReferences_basic,
+		},
 		"Route": {
 			"basic":                              testAccTransitGatewayRoute_basic,
 			"basicIpv6":                          testAccTransitGatewayRoute_basic_ipv6,
@@ -117,10 +120,16 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"basic":      testAccTransitGatewayRouteTableAssociation_basic,
 			"disappears": testAccTransitGatewayRouteTableAssociation_disappears,
 		},
+		"RouteTableAssociations": {
+			"basic": testAccTransitGatewayRouteTableAssociations_basic,
+		},
 		"RouteTablePropagation": {
 			"basic":      testAccTransitGatewayRouteTablePropagation_basic,
 			"disappears": testAccTransitGatewayRouteTablePropagation_disappears,
 		},
+		"RouteTablePropagations": {
+			"basic": testAccTransitGatewayRouteTablePropagations_basic,
+		},
 		"VpcAttachment": {
 			"basic":                testAccTransitGatewayVPCAttachment_basic,
 			"disappears":           testAccTransitGatewayVPCAttachment_disappears,
@@ -0,0 +1,525 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVerifiedAccessEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVerifiedAccessEndpointCreate,
+		ReadWithoutTimeout:   resourceVerifiedAccessEndpointRead,
+		UpdateWithoutTimeout: resourceVerifiedAccessEndpointUpdate,
+		DeleteWithoutTimeout: resourceVerifiedAccessEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"application_domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"attachment_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.VerifiedAccessEndpointAttachmentType_Values(), false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device_validation_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_certificate_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"endpoint_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_domain_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.VerifiedAccessEndpointType_Values(), false),
+			},
+			"load_balancer_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"load_balancer_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ec2.VerifiedAccessEndpointProtocol_Values(), false),
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"load_balancer_options", "network_interface_options"},
+			},
+			"network_interface_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ec2.VerifiedAccessEndpointProtocol_Values(), false),
+						},
+					},
+				},
+				ExactlyOneOf: []string{"load_balancer_options", "network_interface_options"},
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentVerifiedAccessPolicyDiffs,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"verified_access_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"verified_access_instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateVerifiedAccessEndpointInput{
+		ApplicationDomain:     aws.String(d.Get("application_domain").(string)),
+		AttachmentType:        aws.String(d.Get("attachment_type").(string)),
+		ClientToken:           aws.String(resource.UniqueId()),
+		DomainCertificateArn:  aws.String(d.Get("domain_certificate_arn").(string)),
+		EndpointDomainPrefix:  aws.String(d.Get("endpoint_domain_prefix").(string)),
+		EndpointType:          aws.String(d.Get("endpoint_type").(string)),
+		TagSpecifications:     tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeVerifiedAccessEndpoint),
+		VerifiedAccessGroupId: aws.String(d.Get("verified_access_group_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("load_balancer_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoadBalancerOptions = expandCreateVerifiedAccessEndpointLoadBalancerOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("network_interface_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.NetworkInterfaceOptions = expandCreateVerifiedAccessEndpointENIOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("policy_document"); ok {
+		input.PolicyDocument = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	output, err := conn.CreateVerifiedAccessEndpointWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Verified Access Endpoint: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VerifiedAccessEndpoint.VerifiedAccessEndpointId))
+
+	if _, err := WaitVerifiedAccessEndpointCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Verified Access Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceVerifiedAccessEndpointRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	endpoint, err := FindVerifiedAccessEndpointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Verified Access Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_domain", endpoint.ApplicationDomain)
+	d.Set("attachment_type", endpoint.AttachmentType)
+	d.Set("description", endpoint.Description)
+	d.Set("device_validation_domain", endpoint.DeviceValidationDomain)
+	d.Set("domain_certificate_arn", endpoint.DomainCertificateArn)
+	d.Set("endpoint_domain", endpoint.EndpointDomain)
+	d.Set("endpoint_type", endpoint.EndpointType)
+	if v := endpoint.LoadBalancerOptions; v != nil {
+		if err := d.Set("load_balancer_options", []interface{}{flattenVerifiedAccessEndpointLoadBalancerOptions(v)}); err != nil {
+			return diag.Errorf("setting load_balancer_options: %s", err)
+		}
+	} else {
+		d.Set("load_balancer_options", nil)
+	}
+	if v := endpoint.NetworkInterfaceOptions; v != nil {
+		if err := d.Set("network_interface_options", []interface{}{flattenVerifiedAccessEndpointENIOptions(v)}); err != nil {
+			return diag.Errorf("setting network_interface_options: %s", err)
+		}
+	} else {
+		d.Set("network_interface_options", nil)
+	}
+	d.Set("security_group_ids", aws.StringValueSlice(endpoint.SecurityGroupIds))
+	d.Set("verified_access_group_id", endpoint.VerifiedAccessGroupId)
+	d.Set("verified_access_instance_id", endpoint.VerifiedAccessInstanceId)
+
+	// The endpoint domain prefix is not returned by the API; derive it from the endpoint domain.
+	if v := aws.StringValue(endpoint.EndpointDomain); v != "" {
+		if prefix, _, ok := strings.Cut(v, "."); ok {
+			d.Set("endpoint_domain_prefix", prefix)
+		}
+	}
+
+	policy, err := FindVerifiedAccessEndpointPolicyByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading Verified Access Endpoint (%s) policy: %s", d.Id(), err)
+	}
+
+	if aws.BoolValue(policy.PolicyEnabled) {
+		d.Set("policy_document", policy.PolicyDocument)
+	} else {
+		d.Set("policy_document", nil)
+	}
+
+	tags := KeyValueTags(endpoint.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVerifiedAccessEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChanges("description", "load_balancer_options", "network_interface_options", "verified_access_group_id") {
+		input := &ec2.ModifyVerifiedAccessEndpointInput{
+			ClientToken:              aws.String(resource.UniqueId()),
+			VerifiedAccessEndpointId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("load_balancer_options") {
+			if v, ok := d.GetOk("load_balancer_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LoadBalancerOptions = expandModifyVerifiedAccessEndpointLoadBalancerOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("network_interface_options") {
+			if v, ok := d.GetOk("network_interface_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.NetworkInterfaceOptions = expandModifyVerifiedAccessEndpointENIOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("verified_access_group_id") {
+			input.VerifiedAccessGroupId = aws.String(d.Get("verified_access_group_id").(string))
+		}
+
+		_, err := conn.ModifyVerifiedAccessEndpointWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Verified Access Endpoint (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitVerifiedAccessEndpointUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Verified Access Endpoint (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy_document") {
+		input := &ec2.ModifyVerifiedAccessEndpointPolicyInput{
+			ClientToken:              aws.String(resource.UniqueId()),
+			VerifiedAccessEndpointId: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("policy_document"); ok {
+			input.PolicyDocument = aws.String(v.(string))
+			input.PolicyEnabled = aws.Bool(true)
+		} else {
+			input.PolicyEnabled = aws.Bool(false)
+		}
+
+		_, err := conn.ModifyVerifiedAccessEndpointPolicyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Verified Access Endpoint (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Verified Access Endpoint (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVerifiedAccessEndpointRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting Verified Access Endpoint: %s", d.Id())
+	_, err := conn.DeleteVerifiedAccessEndpointWithContext(ctx, &ec2.DeleteVerifiedAccessEndpointInput{
+		ClientToken:              aws.String(resource.UniqueId()),
+		VerifiedAccessEndpointId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessEndpointIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Verified Access Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitVerifiedAccessEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Verified Access Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCreateVerifiedAccessEndpointLoadBalancerOptions(tfMap map[string]interface{}) *ec2.CreateVerifiedAccessEndpointLoadBalancerOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.CreateVerifiedAccessEndpointLoadBalancerOptions{}
+
+	if v, ok := tfMap["load_balancer_arn"].(string); ok && v != "" {
+		apiObject.LoadBalancerArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCreateVerifiedAccessEndpointENIOptions(tfMap map[string]interface{}) *ec2.CreateVerifiedAccessEndpointEniOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.CreateVerifiedAccessEndpointEniOptions{}
+
+	if v, ok := tfMap["network_interface_id"].(string); ok && v != "" {
+		apiObject.NetworkInterfaceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandModifyVerifiedAccessEndpointLoadBalancerOptions(tfMap map[string]interface{}) *ec2.ModifyVerifiedAccessEndpointLoadBalancerOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ModifyVerifiedAccessEndpointLoadBalancerOptions{}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandModifyVerifiedAccessEndpointENIOptions(tfMap map[string]interface{}) *ec2.ModifyVerifiedAccessEndpointEniOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ModifyVerifiedAccessEndpointEniOptions{}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenVerifiedAccessEndpointLoadBalancerOptions(apiObject *ec2.VerifiedAccessEndpointLoadBalancerOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LoadBalancerArn; v != nil {
+		tfMap["load_balancer_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Port; v != nil {
+		tfMap["port"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Protocol; v != nil {
+		tfMap["protocol"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SubnetIds; v != nil {
+		tfMap["subnet_ids"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenVerifiedAccessEndpointENIOptions(apiObject *ec2.VerifiedAccessEndpointEniOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NetworkInterfaceId; v != nil {
+		tfMap["network_interface_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Port; v != nil {
+		tfMap["port"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Protocol; v != nil {
+		tfMap["protocol"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
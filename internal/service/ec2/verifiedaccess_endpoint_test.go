@@ -0,0 +1,252 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedAccessEndpoint_basic(t *testing.T) {
+	var v ec2.VerifiedAccessEndpoint
+	resourceName := "aws_verifiedaccess_endpoint.test"
+	groupResourceName := "aws_verifiedaccess_group.test"
+	eniResourceName := "aws_network_interface.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessEndpointConfig_basic(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_domain", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "attachment_type", "vpc"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_domain"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_domain_prefix", "example"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "network-interface"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_options.0.network_interface_id", eniResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_options.0.port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_options.0.protocol", "https"),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", ""),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "verified_access_group_id", groupResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "verified_access_instance_id", groupResourceName, "verified_access_instance_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessEndpointConfig_basic(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessEndpoint_disappears(t *testing.T) {
+	var v ec2.VerifiedAccessEndpoint
+	resourceName := "aws_verifiedaccess_endpoint.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessEndpointConfig_basic(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVerifiedAccessEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessEndpoint_policy(t *testing.T) {
+	var v ec2.VerifiedAccessEndpoint
+	resourceName := "aws_verifiedaccess_endpoint.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+	policy := `permit(principal, action, resource) when { context.http_request.method == "GET" };`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessEndpointConfig_policy(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), policy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", policy),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessEndpointConfig_basic(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVerifiedAccessEndpointExists(n string, v *ec2.VerifiedAccessEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Access Endpoint ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindVerifiedAccessEndpointByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVerifiedAccessEndpointDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedaccess_endpoint" {
+			continue
+		}
+
+		_, err := tfec2.FindVerifiedAccessEndpointByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Access Endpoint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVerifiedAccessEndpointConfig_base(rName, key, certificate string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 1),
+		testAccVerifiedAccessGroupConfig_basic(rName, "test"),
+		fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_acm_certificate" "test" {
+  certificate_body = "%[2]s"
+  private_key      = "%[3]s"
+}
+`, rName, certificate, key))
+}
+
+func testAccVerifiedAccessEndpointConfig_basic(rName, key, certificate, description string) string {
+	return acctest.ConfigCompose(testAccVerifiedAccessEndpointConfig_base(rName, key, certificate), fmt.Sprintf(`
+resource "aws_verifiedaccess_endpoint" "test" {
+  application_domain       = "example.com"
+  attachment_type          = "vpc"
+  description              = %[1]q
+  domain_certificate_arn   = aws_acm_certificate.test.arn
+  endpoint_domain_prefix   = "example"
+  endpoint_type            = "network-interface"
+  security_group_ids       = [aws_security_group.test.id]
+  verified_access_group_id = aws_verifiedaccess_group.test.id
+
+  network_interface_options {
+    network_interface_id = aws_network_interface.test.id
+    port                 = 443
+    protocol             = "https"
+  }
+}
+`, description))
+}
+
+func testAccVerifiedAccessEndpointConfig_policy(rName, key, certificate, policy string) string {
+	return acctest.ConfigCompose(testAccVerifiedAccessEndpointConfig_base(rName, key, certificate), fmt.Sprintf(`
+resource "aws_verifiedaccess_endpoint" "test" {
+  application_domain       = "example.com"
+  attachment_type          = "vpc"
+  description              = "test"
+  domain_certificate_arn   = aws_acm_certificate.test.arn
+  endpoint_domain_prefix   = "example"
+  endpoint_type            = "network-interface"
+  policy_document          = %[1]q
+  security_group_ids       = [aws_security_group.test.id]
+  verified_access_group_id = aws_verifiedaccess_group.test.id
+
+  network_interface_options {
+    network_interface_id = aws_network_interface.test.id
+    port                 = 443
+    protocol             = "https"
+  }
+}
+`, policy))
+}
@@ -0,0 +1,221 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVerifiedAccessGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVerifiedAccessGroupCreate,
+		ReadWithoutTimeout:   resourceVerifiedAccessGroupRead,
+		UpdateWithoutTimeout: resourceVerifiedAccessGroupUpdate,
+		DeleteWithoutTimeout: resourceVerifiedAccessGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentVerifiedAccessPolicyDiffs,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"verified_access_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateVerifiedAccessGroupInput{
+		ClientToken:              aws.String(resource.UniqueId()),
+		TagSpecifications:        tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeVerifiedAccessGroup),
+		VerifiedAccessInstanceId: aws.String(d.Get("verified_access_instance_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_document"); ok {
+		input.PolicyDocument = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateVerifiedAccessGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Verified Access Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VerifiedAccessGroup.VerifiedAccessGroupId))
+
+	return resourceVerifiedAccessGroupRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	group, err := FindVerifiedAccessGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Verified Access Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", group.VerifiedAccessGroupArn)
+	d.Set("creation_time", group.CreationTime)
+	d.Set("description", group.Description)
+	d.Set("last_updated_time", group.LastUpdatedTime)
+	d.Set("owner", group.Owner)
+	d.Set("verified_access_instance_id", group.VerifiedAccessInstanceId)
+
+	policy, err := FindVerifiedAccessGroupPolicyByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading Verified Access Group (%s) policy: %s", d.Id(), err)
+	}
+
+	if aws.BoolValue(policy.PolicyEnabled) {
+		d.Set("policy_document", policy.PolicyDocument)
+	} else {
+		d.Set("policy_document", nil)
+	}
+
+	tags := KeyValueTags(group.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVerifiedAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChanges("description", "verified_access_instance_id") {
+		input := &ec2.ModifyVerifiedAccessGroupInput{
+			ClientToken:           aws.String(resource.UniqueId()),
+			VerifiedAccessGroupId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("verified_access_instance_id") {
+			input.VerifiedAccessInstanceId = aws.String(d.Get("verified_access_instance_id").(string))
+		}
+
+		_, err := conn.ModifyVerifiedAccessGroupWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Verified Access Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy_document") {
+		input := &ec2.ModifyVerifiedAccessGroupPolicyInput{
+			ClientToken:           aws.String(resource.UniqueId()),
+			VerifiedAccessGroupId: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("policy_document"); ok {
+			input.PolicyDocument = aws.String(v.(string))
+			input.PolicyEnabled = aws.Bool(true)
+		} else {
+			input.PolicyEnabled = aws.Bool(false)
+		}
+
+		_, err := conn.ModifyVerifiedAccessGroupPolicyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Verified Access Group (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Verified Access Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVerifiedAccessGroupRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting Verified Access Group: %s", d.Id())
+	_, err := conn.DeleteVerifiedAccessGroupWithContext(ctx, &ec2.DeleteVerifiedAccessGroupInput{
+		ClientToken:           aws.String(resource.UniqueId()),
+		VerifiedAccessGroupId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessGroupIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Verified Access Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
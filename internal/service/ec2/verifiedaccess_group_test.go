@@ -0,0 +1,219 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedAccessGroup_basic(t *testing.T) {
+	var v ec2.VerifiedAccessGroup
+	resourceName := "aws_verifiedaccess_group.test"
+	instanceResourceName := "aws_verifiedaccess_instance.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessGroupConfig_basic(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_time"),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner"),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "verified_access_instance_id", instanceResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessGroupConfig_basic(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessGroup_disappears(t *testing.T) {
+	var v ec2.VerifiedAccessGroup
+	resourceName := "aws_verifiedaccess_group.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessGroupConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVerifiedAccessGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessGroup_policy(t *testing.T) {
+	var v ec2.VerifiedAccessGroup
+	resourceName := "aws_verifiedaccess_group.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	policy1 := `permit(principal, action, resource) when { context.http_request.method == "GET" };`
+	policy1Reformatted := `
+permit(principal, action, resource)
+when {
+  context.http_request.method == "GET"
+};
+`
+	policy2 := `permit(principal, action, resource) when { context.http_request.method == "POST" };`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessGroupConfig_policy(rName, policy1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", policy1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccVerifiedAccessGroupConfig_policy(rName, policy1Reformatted),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccVerifiedAccessGroupConfig_policy(rName, policy2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", policy2),
+				),
+			},
+			{
+				Config: testAccVerifiedAccessGroupConfig_basic(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVerifiedAccessGroupExists(n string, v *ec2.VerifiedAccessGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Access Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindVerifiedAccessGroupByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVerifiedAccessGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedaccess_group" {
+			continue
+		}
+
+		_, err := tfec2.FindVerifiedAccessGroupByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Access Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVerifiedAccessGroupConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_instance" "test" {}
+
+resource "aws_verifiedaccess_trust_provider" "test" {
+  policy_reference_name    = %[1]q
+  trust_provider_type      = "user"
+  user_trust_provider_type = "iam-identity-center"
+}
+
+resource "aws_verifiedaccess_instance_trust_provider_attachment" "test" {
+  verified_access_instance_id       = aws_verifiedaccess_instance.test.id
+  verified_access_trust_provider_id = aws_verifiedaccess_trust_provider.test.id
+}
+`, rName)
+}
+
+func testAccVerifiedAccessGroupConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccVerifiedAccessGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_verifiedaccess_group" "test" {
+  description                 = %[1]q
+  verified_access_instance_id = aws_verifiedaccess_instance_trust_provider_attachment.test.verified_access_instance_id
+}
+`, description))
+}
+
+func testAccVerifiedAccessGroupConfig_policy(rName, policy string) string {
+	return acctest.ConfigCompose(testAccVerifiedAccessGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_verifiedaccess_group" "test" {
+  description                 = "test"
+  policy_document             = %[1]q
+  verified_access_instance_id = aws_verifiedaccess_instance_trust_provider_attachment.test.verified_access_instance_id
+}
+`, policy))
+}
@@ -0,0 +1,236 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVerifiedAccessInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVerifiedAccessInstanceCreate,
+		ReadWithoutTimeout:   resourceVerifiedAccessInstanceRead,
+		UpdateWithoutTimeout: resourceVerifiedAccessInstanceUpdate,
+		DeleteWithoutTimeout: resourceVerifiedAccessInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"verified_access_trust_providers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_trust_provider_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trust_provider_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_trust_provider_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"verified_access_trust_provider_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateVerifiedAccessInstanceInput{
+		ClientToken:       aws.String(resource.UniqueId()),
+		TagSpecifications: tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeVerifiedAccessInstance),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateVerifiedAccessInstanceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Verified Access Instance: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VerifiedAccessInstance.VerifiedAccessInstanceId))
+
+	return resourceVerifiedAccessInstanceRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindVerifiedAccessInstanceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Verified Access Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("creation_time", output.CreationTime)
+	d.Set("description", output.Description)
+	d.Set("last_updated_time", output.LastUpdatedTime)
+	if err := d.Set("verified_access_trust_providers", flattenVerifiedAccessTrustProvidersCondensed(output.VerifiedAccessTrustProviders)); err != nil {
+		return diag.Errorf("setting verified_access_trust_providers: %s", err)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVerifiedAccessInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("description") {
+		input := &ec2.ModifyVerifiedAccessInstanceInput{
+			ClientToken:              aws.String(resource.UniqueId()),
+			Description:              aws.String(d.Get("description").(string)),
+			VerifiedAccessInstanceId: aws.String(d.Id()),
+		}
+
+		_, err := conn.ModifyVerifiedAccessInstanceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Verified Access Instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Verified Access Instance (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVerifiedAccessInstanceRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting Verified Access Instance: %s", d.Id())
+	_, err := conn.DeleteVerifiedAccessInstanceWithContext(ctx, &ec2.DeleteVerifiedAccessInstanceInput{
+		ClientToken:              aws.String(resource.UniqueId()),
+		VerifiedAccessInstanceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Verified Access Instance (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenVerifiedAccessTrustProviderCondensed(apiObject *ec2.VerifiedAccessTrustProviderCondensed) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Description; v != nil {
+		tfMap["description"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DeviceTrustProviderType; v != nil {
+		tfMap["device_trust_provider_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TrustProviderType; v != nil {
+		tfMap["trust_provider_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UserTrustProviderType; v != nil {
+		tfMap["user_trust_provider_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VerifiedAccessTrustProviderId; v != nil {
+		tfMap["verified_access_trust_provider_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenVerifiedAccessTrustProvidersCondensed(apiObjects []*ec2.VerifiedAccessTrustProviderCondensed) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenVerifiedAccessTrustProviderCondensed(apiObject))
+	}
+
+	return tfList
+}
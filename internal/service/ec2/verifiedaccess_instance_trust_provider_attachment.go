@@ -0,0 +1,138 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceVerifiedAccessInstanceTrustProviderAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVerifiedAccessInstanceTrustProviderAttachmentCreate,
+		ReadWithoutTimeout:   resourceVerifiedAccessInstanceTrustProviderAttachmentRead,
+		DeleteWithoutTimeout: resourceVerifiedAccessInstanceTrustProviderAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"verified_access_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"verified_access_trust_provider_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessInstanceTrustProviderAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID := d.Get("verified_access_instance_id").(string)
+	trustProviderID := d.Get("verified_access_trust_provider_id").(string)
+	id := VerifiedAccessInstanceTrustProviderAttachmentCreateResourceID(instanceID, trustProviderID)
+	input := &ec2.AttachVerifiedAccessTrustProviderInput{
+		ClientToken:                   aws.String(resource.UniqueId()),
+		VerifiedAccessInstanceId:      aws.String(instanceID),
+		VerifiedAccessTrustProviderId: aws.String(trustProviderID),
+	}
+
+	_, err := conn.AttachVerifiedAccessTrustProviderWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Verified Access Instance Trust Provider Attachment (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceVerifiedAccessInstanceTrustProviderAttachmentRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessInstanceTrustProviderAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID, trustProviderID, err := VerifiedAccessInstanceTrustProviderAttachmentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = FindVerifiedAccessInstanceTrustProviderAttachmentExists(ctx, conn, instanceID, trustProviderID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Instance Trust Provider Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Verified Access Instance Trust Provider Attachment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("verified_access_instance_id", instanceID)
+	d.Set("verified_access_trust_provider_id", trustProviderID)
+
+	return nil
+}
+
+func resourceVerifiedAccessInstanceTrustProviderAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID, trustProviderID, err := VerifiedAccessInstanceTrustProviderAttachmentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Verified Access Instance Trust Provider Attachment: %s", d.Id())
+	_, err = conn.DetachVerifiedAccessTrustProviderWithContext(ctx, &ec2.DetachVerifiedAccessTrustProviderInput{
+		ClientToken:                   aws.String(resource.UniqueId()),
+		VerifiedAccessInstanceId:      aws.String(instanceID),
+		VerifiedAccessTrustProviderId: aws.String(trustProviderID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound, errCodeInvalidVerifiedAccessTrustProviderIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Verified Access Instance Trust Provider Attachment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const verifiedAccessInstanceTrustProviderAttachmentIDSeparator = "_"
+
+func VerifiedAccessInstanceTrustProviderAttachmentCreateResourceID(instanceID, trustProviderID string) string {
+	parts := []string{instanceID, trustProviderID}
+	id := strings.Join(parts, verifiedAccessInstanceTrustProviderAttachmentIDSeparator)
+
+	return id
+}
+
+func VerifiedAccessInstanceTrustProviderAttachmentParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, verifiedAccessInstanceTrustProviderAttachmentIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected VERIFIED-ACCESS-INSTANCE-ID%[2]sVERIFIED-ACCESS-TRUST-PROVIDER-ID", id, verifiedAccessInstanceTrustProviderAttachmentIDSeparator)
+}
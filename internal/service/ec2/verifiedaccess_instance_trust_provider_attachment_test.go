@@ -0,0 +1,137 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedAccessInstanceTrustProviderAttachment_basic(t *testing.T) {
+	resourceName := "aws_verifiedaccess_instance_trust_provider_attachment.test"
+	instanceResourceName := "aws_verifiedaccess_instance.test"
+	trustProviderResourceName := "aws_verifiedaccess_trust_provider.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessInstanceTrustProviderAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessInstanceTrustProviderAttachmentConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessInstanceTrustProviderAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "verified_access_instance_id", instanceResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "verified_access_trust_provider_id", trustProviderResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessInstanceTrustProviderAttachment_disappears(t *testing.T) {
+	resourceName := "aws_verifiedaccess_instance_trust_provider_attachment.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessInstanceTrustProviderAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessInstanceTrustProviderAttachmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessInstanceTrustProviderAttachmentExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVerifiedAccessInstanceTrustProviderAttachment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVerifiedAccessInstanceTrustProviderAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Access Instance Trust Provider Attachment ID is set")
+		}
+
+		instanceID, trustProviderID, err := tfec2.VerifiedAccessInstanceTrustProviderAttachmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		return tfec2.FindVerifiedAccessInstanceTrustProviderAttachmentExists(context.Background(), conn, instanceID, trustProviderID)
+	}
+}
+
+func testAccCheckVerifiedAccessInstanceTrustProviderAttachmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedaccess_instance_trust_provider_attachment" {
+			continue
+		}
+
+		instanceID, trustProviderID, err := tfec2.VerifiedAccessInstanceTrustProviderAttachmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		err = tfec2.FindVerifiedAccessInstanceTrustProviderAttachmentExists(context.Background(), conn, instanceID, trustProviderID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Access Instance Trust Provider Attachment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVerifiedAccessInstanceTrustProviderAttachmentConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_instance" "test" {}
+
+resource "aws_verifiedaccess_trust_provider" "test" {
+  policy_reference_name    = %[1]q
+  trust_provider_type      = "user"
+  user_trust_provider_type = "iam-identity-center"
+}
+
+resource "aws_verifiedaccess_instance_trust_provider_attachment" "test" {
+  verified_access_instance_id       = aws_verifiedaccess_instance.test.id
+  verified_access_trust_provider_id = aws_verifiedaccess_trust_provider.test.id
+}
+`, rName)
+}
@@ -0,0 +1,383 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVerifiedAccessTrustProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVerifiedAccessTrustProviderCreate,
+		ReadWithoutTimeout:   resourceVerifiedAccessTrustProviderRead,
+		UpdateWithoutTimeout: resourceVerifiedAccessTrustProviderUpdate,
+		DeleteWithoutTimeout: resourceVerifiedAccessTrustProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"device_trust_provider_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.DeviceTrustProviderType_Values(), false),
+			},
+			"oidc_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"client_secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"token_endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"user_info_endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"policy_reference_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"trust_provider_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.TrustProviderType_Values(), false),
+			},
+			"user_trust_provider_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.UserTrustProviderType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessTrustProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateVerifiedAccessTrustProviderInput{
+		ClientToken:         aws.String(resource.UniqueId()),
+		PolicyReferenceName: aws.String(d.Get("policy_reference_name").(string)),
+		TagSpecifications:   tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeVerifiedAccessTrustProvider),
+		TrustProviderType:   aws.String(d.Get("trust_provider_type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("device_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeviceOptions = expandCreateVerifiedAccessTrustProviderDeviceOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("device_trust_provider_type"); ok {
+		input.DeviceTrustProviderType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("oidc_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OidcOptions = expandCreateVerifiedAccessTrustProviderOIDCOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("user_trust_provider_type"); ok {
+		input.UserTrustProviderType = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateVerifiedAccessTrustProviderWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Verified Access Trust Provider: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VerifiedAccessTrustProvider.VerifiedAccessTrustProviderId))
+
+	return resourceVerifiedAccessTrustProviderRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessTrustProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindVerifiedAccessTrustProviderByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Trust Provider (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Verified Access Trust Provider (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", output.Description)
+	if v := output.DeviceOptions; v != nil {
+		if err := d.Set("device_options", []interface{}{flattenVerifiedAccessTrustProviderDeviceOptions(v)}); err != nil {
+			return diag.Errorf("setting device_options: %s", err)
+		}
+	} else {
+		d.Set("device_options", nil)
+	}
+	d.Set("device_trust_provider_type", output.DeviceTrustProviderType)
+	if v := output.OidcOptions; v != nil {
+		// The client secret is not returned by the API.
+		clientSecret := ""
+		if v, ok := d.GetOk("oidc_options.0.client_secret"); ok {
+			clientSecret = v.(string)
+		}
+
+		if err := d.Set("oidc_options", []interface{}{flattenVerifiedAccessTrustProviderOIDCOptions(v, clientSecret)}); err != nil {
+			return diag.Errorf("setting oidc_options: %s", err)
+		}
+	} else {
+		d.Set("oidc_options", nil)
+	}
+	d.Set("policy_reference_name", output.PolicyReferenceName)
+	d.Set("trust_provider_type", output.TrustProviderType)
+	d.Set("user_trust_provider_type", output.UserTrustProviderType)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVerifiedAccessTrustProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifyVerifiedAccessTrustProviderInput{
+			ClientToken:                   aws.String(resource.UniqueId()),
+			VerifiedAccessTrustProviderId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("oidc_options.0.scope") {
+			input.OidcOptions = &ec2.ModifyVerifiedAccessTrustProviderOidcOptions{
+				Scope: aws.String(d.Get("oidc_options.0.scope").(string)),
+			}
+		}
+
+		_, err := conn.ModifyVerifiedAccessTrustProviderWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Verified Access Trust Provider (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Verified Access Trust Provider (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVerifiedAccessTrustProviderRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessTrustProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting Verified Access Trust Provider: %s", d.Id())
+	_, err := conn.DeleteVerifiedAccessTrustProviderWithContext(ctx, &ec2.DeleteVerifiedAccessTrustProviderInput{
+		ClientToken:                   aws.String(resource.UniqueId()),
+		VerifiedAccessTrustProviderId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessTrustProviderIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Verified Access Trust Provider (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCreateVerifiedAccessTrustProviderDeviceOptions(tfMap map[string]interface{}) *ec2.CreateVerifiedAccessTrustProviderDeviceOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.CreateVerifiedAccessTrustProviderDeviceOptions{}
+
+	if v, ok := tfMap["tenant_id"].(string); ok && v != "" {
+		apiObject.TenantId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCreateVerifiedAccessTrustProviderOIDCOptions(tfMap map[string]interface{}) *ec2.CreateVerifiedAccessTrustProviderOidcOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.CreateVerifiedAccessTrustProviderOidcOptions{}
+
+	if v, ok := tfMap["authorization_endpoint"].(string); ok && v != "" {
+		apiObject.AuthorizationEndpoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["client_id"].(string); ok && v != "" {
+		apiObject.ClientId = aws.String(v)
+	}
+
+	if v, ok := tfMap["client_secret"].(string); ok && v != "" {
+		apiObject.ClientSecret = aws.String(v)
+	}
+
+	if v, ok := tfMap["issuer"].(string); ok && v != "" {
+		apiObject.Issuer = aws.String(v)
+	}
+
+	if v, ok := tfMap["scope"].(string); ok && v != "" {
+		apiObject.Scope = aws.String(v)
+	}
+
+	if v, ok := tfMap["token_endpoint"].(string); ok && v != "" {
+		apiObject.TokenEndpoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_info_endpoint"].(string); ok && v != "" {
+		apiObject.UserInfoEndpoint = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenVerifiedAccessTrustProviderDeviceOptions(apiObject *ec2.DeviceOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TenantId; v != nil {
+		tfMap["tenant_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenVerifiedAccessTrustProviderOIDCOptions(apiObject *ec2.OidcOptions, clientSecret string) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"client_secret": clientSecret,
+	}
+
+	if v := apiObject.AuthorizationEndpoint; v != nil {
+		tfMap["authorization_endpoint"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ClientId; v != nil {
+		tfMap["client_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Issuer; v != nil {
+		tfMap["issuer"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Scope; v != nil {
+		tfMap["scope"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TokenEndpoint; v != nil {
+		tfMap["token_endpoint"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UserInfoEndpoint; v != nil {
+		tfMap["user_info_endpoint"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
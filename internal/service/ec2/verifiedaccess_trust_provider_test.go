@@ -0,0 +1,341 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedAccessTrustProvider_basic(t *testing.T) {
+	var v ec2.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_basic(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "device_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "device_trust_provider_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_reference_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "trust_provider_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "user_trust_provider_type", "iam-identity-center"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_basic(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessTrustProvider_disappears(t *testing.T) {
+	var v ec2.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVerifiedAccessTrustProvider(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessTrustProvider_deviceOptions(t *testing.T) {
+	var v ec2.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	tenantID := sdkacctest.RandString(16)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_deviceOptions(rName, tenantID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "device_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "device_options.0.tenant_id", tenantID),
+					resource.TestCheckResourceAttr(resourceName, "device_trust_provider_type", "jamf"),
+					resource.TestCheckResourceAttr(resourceName, "trust_provider_type", "device"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessTrustProvider_oidcOptions(t *testing.T) {
+	var v ec2.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_oidcOptions(rName, "openid"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.authorization_endpoint", "https://example.com/authorization_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.client_id", "client_id"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.issuer", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.scope", "openid"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.token_endpoint", "https://example.com/token_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.user_info_endpoint", "https://example.com/user_info_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "user_trust_provider_type", "oidc"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oidc_options.0.client_secret"},
+			},
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_oidcOptions(rName, "openid profile"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.scope", "openid profile"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessTrustProvider_tags(t *testing.T) {
+	var v ec2.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVerifiedAccessTrustProviderExists(n string, v *ec2.VerifiedAccessTrustProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Access Trust Provider ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindVerifiedAccessTrustProviderByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVerifiedAccessTrustProviderDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedaccess_trust_provider" {
+			continue
+		}
+
+		_, err := tfec2.FindVerifiedAccessTrustProviderByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Access Trust Provider %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPreCheckVerifiedAccess(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeVerifiedAccessInstancesInput{
+		MaxResults: aws.Int64(5),
+	}
+
+	_, err := conn.DescribeVerifiedAccessInstances(input)
+
+	if acctest.PreCheckSkipError(err) || tfawserr.ErrCodeEquals(err, "InvalidAction") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccVerifiedAccessTrustProviderConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_trust_provider" "test" {
+  description              = %[2]q
+  policy_reference_name    = %[1]q
+  trust_provider_type      = "user"
+  user_trust_provider_type = "iam-identity-center"
+}
+`, rName, description)
+}
+
+func testAccVerifiedAccessTrustProviderConfig_deviceOptions(rName, tenantID string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_trust_provider" "test" {
+  device_trust_provider_type = "jamf"
+  policy_reference_name      = %[1]q
+  trust_provider_type        = "device"
+
+  device_options {
+    tenant_id = %[2]q
+  }
+}
+`, rName, tenantID)
+}
+
+func testAccVerifiedAccessTrustProviderConfig_oidcOptions(rName, scope string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_trust_provider" "test" {
+  policy_reference_name    = %[1]q
+  trust_provider_type      = "user"
+  user_trust_provider_type = "oidc"
+
+  oidc_options {
+    authorization_endpoint = "https://example.com/authorization_endpoint"
+    client_id              = "client_id"
+    client_secret          = "client_secret"
+    issuer                 = "https://example.com"
+    scope                  = %[2]q
+    token_endpoint         = "https://example.com/token_endpoint"
+    user_info_endpoint     = "https://example.com/user_info_endpoint"
+  }
+}
+`, rName, scope)
+}
+
+func testAccVerifiedAccessTrustProviderConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_trust_provider" "test" {
+  policy_reference_name    = %[1]q
+  trust_provider_type      = "user"
+  user_trust_provider_type = "iam-identity-center"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccVerifiedAccessTrustProviderConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_trust_provider" "test" {
+  policy_reference_name    = %[1]q
+  trust_provider_type      = "user"
+  user_trust_provider_type = "iam-identity-center"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
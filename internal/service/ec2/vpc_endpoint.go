@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -371,7 +370,7 @@ func resourceVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 		if d.HasChange("policy") {
 			o, n := d.GetChange("policy")
 
-			if equivalent, err := awspolicy.PoliciesAreEquivalent(o.(string), n.(string)); err != nil || !equivalent {
+			if equivalent, err := verify.PoliciesAreEquivalent(o.(string), n.(string)); err != nil || !equivalent {
 				policy, err := structure.NormalizeJsonString(d.Get("policy"))
 
 				if err != nil {
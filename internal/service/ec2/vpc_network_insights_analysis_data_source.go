@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"context"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,6 +18,10 @@ func DataSourceNetworkInsightsAnalysis() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceNetworkInsightsAnalysisRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"alternate_path_hints": {
 				Type:     schema.TypeList,
@@ -47,12 +52,14 @@ func DataSourceNetworkInsightsAnalysis() *schema.Resource {
 			},
 			"forward_path_components": networkInsightsAnalysisPathComponentsSchema,
 			"network_insights_analysis_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"start_analysis"},
 			},
 			"network_insights_path_id": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 			"path_found": {
@@ -60,6 +67,11 @@ func DataSourceNetworkInsightsAnalysis() *schema.Resource {
 				Computed: true,
 			},
 			"return_path_components": networkInsightsAnalysisPathComponentsSchema,
+			"start_analysis": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"network_insights_path_id"},
+			},
 			"start_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -73,6 +85,11 @@ func DataSourceNetworkInsightsAnalysis() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"warning_message": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -85,12 +102,38 @@ func dataSourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resour
 	conn := meta.(*conns.AWSClient).EC2Conn
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	if d.Get("start_analysis").(bool) {
+		networkInsightsPathID := d.Get("network_insights_path_id").(string)
+		input := &ec2.StartNetworkInsightsAnalysisInput{
+			NetworkInsightsPathId: aws.String(networkInsightsPathID),
+		}
+
+		log.Printf("[DEBUG] Starting EC2 Network Insights Analysis: %s", input)
+		output, err := conn.StartNetworkInsightsAnalysisWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("starting EC2 Network Insights Analysis (%s): %s", networkInsightsPathID, err)
+		}
+
+		d.Set("network_insights_analysis_id", output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId)
+
+		if d.Get("wait_for_completion").(bool) {
+			if _, err := WaitNetworkInsightsAnalysisCreated(ctx, conn, aws.StringValue(output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId), d.Timeout(schema.TimeoutRead)); err != nil {
+				return diag.Errorf("waiting for EC2 Network Insights Analysis (%s) create: %s", aws.StringValue(output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId), err)
+			}
+		}
+	}
+
 	input := &ec2.DescribeNetworkInsightsAnalysesInput{}
 
 	if v, ok := d.GetOk("network_insights_analysis_id"); ok {
 		input.NetworkInsightsAnalysisIds = aws.StringSlice([]string{v.(string)})
 	}
 
+	if v, ok := d.GetOk("network_insights_path_id"); ok {
+		input.NetworkInsightsPathId = aws.String(v.(string))
+	}
+
 	input.Filters = append(input.Filters, BuildCustomFilterList(
 		d.Get("filter").(*schema.Set),
 	)...)
@@ -43,6 +43,30 @@ func TestAccVPCNetworkInsightsAnalysisDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInsightsAnalysisDataSource_startAnalysis(t *testing.T) {
+	pathResourceName := "aws_ec2_network_insights_path.test"
+	datasourceName := "data.aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAnalysisDataSourceConfig_startAnalysis(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "forward_path_components.#"),
+					resource.TestCheckResourceAttrSet(datasourceName, "network_insights_analysis_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "network_insights_path_id", pathResourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "path_found", "true"),
+					resource.TestCheckResourceAttr(datasourceName, "status", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVPCNetworkInsightsAnalysisDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_network_insights_analysis" "test" {
@@ -59,3 +83,12 @@ data "aws_ec2_network_insights_analysis" "test" {
 }
 `, rName))
 }
+
+func testAccVPCNetworkInsightsAnalysisDataSourceConfig_startAnalysis(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), `
+data "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  start_analysis           = true
+}
+`)
+}
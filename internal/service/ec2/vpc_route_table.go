@@ -39,6 +39,79 @@ var routeTableValidTargets = []string{
 	"vpc_peering_connection_id",
 }
 
+// Route table route nested block definition.
+// Used in aws_route_table and aws_route_table_routes route sets.
+var routeTableRouteNestedBlock = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		///
+		// Destinations.
+		///
+		"cidr_block": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+		},
+		"destination_prefix_list_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"ipv6_cidr_block": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+		},
+
+		//
+		// Targets.
+		//
+		"carrier_gateway_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"core_network_arn": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"egress_only_gateway_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"gateway_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"instance_id": {
+			Type:       schema.TypeString,
+			Optional:   true,
+			Deprecated: "Use network_interface_id instead",
+		},
+		"local_gateway_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"nat_gateway_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"network_interface_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"transit_gateway_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"vpc_endpoint_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"vpc_peering_connection_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	},
+}
+
 func ResourceRouteTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRouteTableCreate,
@@ -79,77 +152,8 @@ func ResourceRouteTable() *schema.Resource {
 				Computed:   true,
 				Optional:   true,
 				ConfigMode: schema.SchemaConfigModeAttr,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						///
-						// Destinations.
-						///
-						"cidr_block": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
-						},
-						"destination_prefix_list_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"ipv6_cidr_block": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
-						},
-
-						//
-						// Targets.
-						//
-						"carrier_gateway_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"core_network_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"egress_only_gateway_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"gateway_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"instance_id": {
-							Type:       schema.TypeString,
-							Optional:   true,
-							Deprecated: "Use network_interface_id instead",
-						},
-						"local_gateway_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"nat_gateway_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"network_interface_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"transit_gateway_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"vpc_endpoint_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"vpc_peering_connection_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-					},
-				},
-				Set: resourceRouteTableHash,
+				Elem:       routeTableRouteNestedBlock,
+				Set:        resourceRouteTableHash,
 			},
 
 			"tags":     tftags.TagsSchema(),
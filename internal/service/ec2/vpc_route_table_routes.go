@@ -0,0 +1,221 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// routeTableRoutesConcurrency is the maximum number of route API calls in flight at once.
+const routeTableRoutesConcurrency = 10
+
+func ResourceRouteTableRoutes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRouteTableRoutesCreate,
+		Read:   resourceRouteTableRoutesRead,
+		Update: resourceRouteTableRoutesUpdate,
+		Delete: resourceRouteTableRoutesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     routeTableRouteNestedBlock,
+				Set:      resourceRouteTableHash,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRouteTableRoutesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	routeTableID := d.Get("route_table_id").(string)
+
+	if err := syncRouteTableRoutes(conn, routeTableID, d.Get("route").(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error creating Route Table (%s) routes: %w", routeTableID, err)
+	}
+
+	d.SetId(routeTableID)
+
+	return resourceRouteTableRoutesRead(d, meta)
+}
+
+func resourceRouteTableRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	routeTable, err := FindRouteTableByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route Table (%s) not found, removing routes from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route Table (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("route", flattenRoutes(conn, routeTable.Routes)); err != nil {
+		return fmt.Errorf("error setting route: %w", err)
+	}
+	d.Set("route_table_id", d.Id())
+
+	return nil
+}
+
+func resourceRouteTableRoutesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if err := syncRouteTableRoutes(conn, d.Id(), d.Get("route").(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating Route Table (%s) routes: %w", d.Id(), err)
+	}
+
+	return resourceRouteTableRoutesRead(d, meta)
+}
+
+func resourceRouteTableRoutesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting Route Table (%s) routes", d.Id())
+	err := syncRouteTableRoutes(conn, d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route Table (%s) routes: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// syncRouteTableRoutes makes the specified route table's routes exactly match the desired routes.
+// The route table is described once and the resulting deletes, replacements and creates are each run in parallel.
+// The local route, propagated routes and VPC endpoint routes are never modified.
+func syncRouteTableRoutes(conn *ec2.EC2, routeTableID string, desired []interface{}, timeout time.Duration) error {
+	routeTable, err := FindRouteTableByID(conn, routeTableID)
+
+	if err != nil {
+		return err
+	}
+
+	want := make(map[string]map[string]interface{}, len(desired))
+
+	for _, tfMapRaw := range desired {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if err := validNestedExactlyOneOf(tfMap, routeTableValidDestinations); err != nil {
+			return err
+		}
+		if err := validNestedExactlyOneOf(tfMap, routeTableValidTargets); err != nil {
+			return err
+		}
+
+		_, destination := routeTableRouteDestinationAttribute(tfMap)
+		want[destination] = tfMap
+	}
+
+	var deletes, replaces []func() error
+
+	for _, v := range flattenRoutes(conn, routeTable.Routes) {
+		tfMap := v.(map[string]interface{})
+		_, destination := routeTableRouteDestinationAttribute(tfMap)
+		wantMap, ok := want[destination]
+
+		if !ok {
+			deletes = append(deletes, func() error {
+				return routeTableDeleteRoute(conn, routeTableID, tfMap, timeout)
+			})
+
+			continue
+		}
+
+		delete(want, destination)
+
+		_, oldTarget := routeTableRouteTargetAttribute(tfMap)
+		_, newTarget := routeTableRouteTargetAttribute(wantMap)
+
+		if oldTarget != newTarget {
+			replaces = append(replaces, func() error {
+				return routeTableUpdateRoute(conn, routeTableID, wantMap, timeout)
+			})
+		}
+	}
+
+	var creates []func() error
+
+	for _, tfMap := range want {
+		tfMap := tfMap
+
+		creates = append(creates, func() error {
+			return routeTableAddRoute(conn, routeTableID, tfMap, timeout)
+		})
+	}
+
+	// Delete first so that destinations being re-targeted to a new route type don't conflict.
+	for _, fs := range [][]func() error{deletes, replaces, creates} {
+		if err := runRouteTableRoutesParallel(fs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runRouteTableRoutesParallel runs the specified functions with bounded concurrency.
+// All errors are returned.
+func runRouteTableRoutesParallel(fs []func() error) error {
+	var errs *multierror.Error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, routeTableRoutesConcurrency)
+
+	for _, f := range fs {
+		f := f
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := f(); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
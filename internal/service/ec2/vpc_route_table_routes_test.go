@@ -0,0 +1,206 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccVPCRouteTableRoutes_basic(t *testing.T) {
+	var routeTable ec2.RouteTable
+	resourceName := "aws_route_table_routes.test"
+	rtResourceName := "aws_route_table.test"
+	igwResourceName := "aws_internet_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	destinationCidr1 := "10.2.0.0/16"
+	destinationCidr2 := "10.3.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName, destinationCidr1, destinationCidr2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(rtResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					testAccCheckRouteTableRoute(resourceName, "cidr_block", destinationCidr1, "gateway_id", igwResourceName, "id"),
+					testAccCheckRouteTableRoute(resourceName, "cidr_block", destinationCidr2, "gateway_id", igwResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", rtResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutes_update(t *testing.T) {
+	var routeTable ec2.RouteTable
+	resourceName := "aws_route_table_routes.test"
+	rtResourceName := "aws_route_table.test"
+	igwResourceName := "aws_internet_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	destinationCidr1 := "10.2.0.0/16"
+	destinationCidr2 := "10.3.0.0/16"
+	destinationCidr3 := "10.4.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName, destinationCidr1, destinationCidr2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(rtResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+				),
+			},
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName, destinationCidr2, destinationCidr3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(rtResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					testAccCheckRouteTableRoute(resourceName, "cidr_block", destinationCidr2, "gateway_id", igwResourceName, "id"),
+					testAccCheckRouteTableRoute(resourceName, "cidr_block", destinationCidr3, "gateway_id", igwResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccVPCRouteTableRoutesConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(rtResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 1),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutes_outOfBandRoute(t *testing.T) {
+	var routeTable ec2.RouteTable
+	resourceName := "aws_route_table_routes.test"
+	rtResourceName := "aws_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	destinationCidr1 := "10.2.0.0/16"
+	destinationCidr2 := "10.3.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName, destinationCidr1, destinationCidr2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(rtResourceName, &routeTable),
+					testAccCheckRouteTableRoutesCreateRoute(&routeTable, "aws_internet_gateway.test", "10.9.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCRouteTableRoutesConfig_basic(rName, destinationCidr1, destinationCidr2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(rtResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRouteTableRoutesCreateRoute(routeTable *ec2.RouteTable, gatewayResourceName, destinationCidr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[gatewayResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", gatewayResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := conn.CreateRoute(&ec2.CreateRouteInput{
+			DestinationCidrBlock: aws.String(destinationCidr),
+			GatewayId:            aws.String(rs.Primary.ID),
+			RouteTableId:         routeTable.RouteTableId,
+		})
+
+		return err
+	}
+}
+
+func testAccVPCRouteTableRoutesConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [route]
+  }
+}
+`, rName)
+}
+
+func testAccVPCRouteTableRoutesConfig_basic(rName, destinationCidr1, destinationCidr2 string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesConfig_base(rName), fmt.Sprintf(`
+resource "aws_route_table_routes" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    cidr_block = %[1]q
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  route {
+    cidr_block = %[2]q
+    gateway_id = aws_internet_gateway.test.id
+  }
+}
+`, destinationCidr1, destinationCidr2))
+}
+
+func testAccVPCRouteTableRoutesConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesConfig_base(rName), `
+resource "aws_route_table_routes" "test" {
+  route_table_id = aws_route_table.test.id
+}
+`)
+}
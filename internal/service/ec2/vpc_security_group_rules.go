@@ -0,0 +1,526 @@
+package ec2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// securityGroupRulesBatchSize is the maximum number of rules sent in a single
+// Authorize, Revoke or Modify API call.
+const securityGroupRulesBatchSize = 50
+
+func ResourceSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityGroupRulesCreate,
+		ReadWithoutTimeout:   resourceSecurityGroupRulesRead,
+		UpdateWithoutTimeout: resourceSecurityGroupRulesUpdate,
+		DeleteWithoutTimeout: resourceSecurityGroupRulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceSecurityGroupRulesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"egress":  securityGroupRulesRuleSetNestedBlock,
+			"ingress": securityGroupRulesRuleSetNestedBlock,
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+var (
+	securityGroupRulesRuleSetNestedBlock = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     securityGroupRulesRuleNestedBlock,
+		Set:      securityGroupRulesRuleHash,
+	}
+
+	securityGroupRulesRuleNestedBlock = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cidr_ipv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			},
+			"cidr_ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validSecurityGroupRuleDescription,
+			},
+			"from_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+			"ip_protocol": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: ProtocolStateFunc,
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"referenced_security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"to_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+		},
+	}
+)
+
+func resourceSecurityGroupRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	securityGroupID := d.Get("security_group_id").(string)
+
+	if _, err := FindSecurityGroupByID(ctx, conn, securityGroupID); err != nil {
+		return diag.Errorf("reading Security Group (%s): %s", securityGroupID, err)
+	}
+
+	if err := syncSecurityGroupRules(ctx, conn, meta.(*conns.AWSClient).AccountID, securityGroupID, d.Get("ingress").(*schema.Set).List(), d.Get("egress").(*schema.Set).List()); err != nil {
+		return diag.Errorf("creating VPC Security Group Rules (%s): %s", securityGroupID, err)
+	}
+
+	d.SetId(securityGroupID)
+
+	return resourceSecurityGroupRulesRead(ctx, d, meta)
+}
+
+func resourceSecurityGroupRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	_, err := FindSecurityGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Group (%s) not found, removing VPC Security Group Rules from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Group (%s): %s", d.Id(), err)
+	}
+
+	rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading VPC Security Group Rules (%s): %s", d.Id(), err)
+	}
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	var egress, ingress []interface{}
+
+	for _, v := range rules {
+		if aws.BoolValue(v.IsEgress) {
+			egress = append(egress, flattenSecurityGroupRulesRule(v, accountID))
+		} else {
+			ingress = append(ingress, flattenSecurityGroupRulesRule(v, accountID))
+		}
+	}
+
+	if err := d.Set("egress", egress); err != nil {
+		return diag.Errorf("setting egress: %s", err)
+	}
+	if err := d.Set("ingress", ingress); err != nil {
+		return diag.Errorf("setting ingress: %s", err)
+	}
+	d.Set("security_group_id", d.Id())
+
+	return nil
+}
+
+func resourceSecurityGroupRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if err := syncSecurityGroupRules(ctx, conn, meta.(*conns.AWSClient).AccountID, d.Id(), d.Get("ingress").(*schema.Set).List(), d.Get("egress").(*schema.Set).List()); err != nil {
+		return diag.Errorf("updating VPC Security Group Rules (%s): %s", d.Id(), err)
+	}
+
+	return resourceSecurityGroupRulesRead(ctx, d, meta)
+}
+
+func resourceSecurityGroupRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting VPC Security Group Rules: %s", d.Id())
+	err := syncSecurityGroupRules(ctx, conn, meta.(*conns.AWSClient).AccountID, d.Id(), nil, nil)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting VPC Security Group Rules (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// syncSecurityGroupRules makes the specified security group's rules exactly match the desired ingress and egress rules.
+// Rules not in the desired set, including any added out-of-band, are revoked by rule ID.
+// Rules that differ only in description are modified in place and missing rules are authorized.
+// resourceSecurityGroupRulesCustomizeDiff requires each rule to specify exactly one source.
+// The raw configuration is inspected as set elements containing unknown values can't be read back reliably.
+func resourceSecurityGroupRulesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"egress", "ingress"} {
+		v := diff.GetRawConfig().GetAttr(key)
+
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		for it := v.ElementIterator(); it.Next(); {
+			_, rule := it.Element()
+
+			if err := validSecurityGroupRulesRuleSource(rule); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validSecurityGroupRulesRuleSource returns an error if a configured rule doesn't specify exactly one source.
+func validSecurityGroupRulesRuleSource(rule cty.Value) error {
+	if rule.IsNull() || !rule.IsKnown() {
+		return nil
+	}
+
+	var n int
+
+	for _, key := range []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"} {
+		// Unknown values count as specified, empty strings don't.
+		if v := rule.GetAttr(key); !v.IsNull() && (!v.IsKnown() || v.AsString() != "") {
+			n++
+		}
+	}
+
+	if n != 1 {
+		return fmt.Errorf("exactly one of cidr_ipv4, cidr_ipv6, prefix_list_id or referenced_security_group_id must be specified, got %d", n)
+	}
+
+	return nil
+}
+
+func syncSecurityGroupRules(ctx context.Context, conn *ec2.EC2, accountID, securityGroupID string, ingress, egress []interface{}) error {
+	rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return err
+	}
+
+	var currentEgress, currentIngress []*ec2.SecurityGroupRule
+
+	for _, v := range rules {
+		if aws.BoolValue(v.IsEgress) {
+			currentEgress = append(currentEgress, v)
+		} else {
+			currentIngress = append(currentIngress, v)
+		}
+	}
+
+	revoke, modify, authorize, err := diffSecurityGroupRules(currentIngress, ingress, accountID)
+
+	if err != nil {
+		return fmt.Errorf("ingress: %w", err)
+	}
+
+	for i := 0; i < len(revoke); i += securityGroupRulesBatchSize {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(revoke[i:minInt(i+securityGroupRulesBatchSize, len(revoke))]),
+		}
+
+		log.Printf("[DEBUG] Revoking VPC Security Group (%s) ingress rules: %s", securityGroupID, input)
+		if _, err := conn.RevokeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("revoking ingress rules: %w", err)
+		}
+	}
+
+	if err := modifySecurityGroupRules(ctx, conn, securityGroupID, modify); err != nil {
+		return fmt.Errorf("modifying ingress rules: %w", err)
+	}
+
+	for i := 0; i < len(authorize); i += securityGroupRulesBatchSize {
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: authorize[i:minInt(i+securityGroupRulesBatchSize, len(authorize))],
+		}
+
+		log.Printf("[DEBUG] Authorizing VPC Security Group (%s) ingress rules: %s", securityGroupID, input)
+		if _, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("authorizing ingress rules: %w", err)
+		}
+	}
+
+	revoke, modify, authorize, err = diffSecurityGroupRules(currentEgress, egress, accountID)
+
+	if err != nil {
+		return fmt.Errorf("egress: %w", err)
+	}
+
+	for i := 0; i < len(revoke); i += securityGroupRulesBatchSize {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(revoke[i:minInt(i+securityGroupRulesBatchSize, len(revoke))]),
+		}
+
+		log.Printf("[DEBUG] Revoking VPC Security Group (%s) egress rules: %s", securityGroupID, input)
+		if _, err := conn.RevokeSecurityGroupEgressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("revoking egress rules: %w", err)
+		}
+	}
+
+	if err := modifySecurityGroupRules(ctx, conn, securityGroupID, modify); err != nil {
+		return fmt.Errorf("modifying egress rules: %w", err)
+	}
+
+	for i := 0; i < len(authorize); i += securityGroupRulesBatchSize {
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: authorize[i:minInt(i+securityGroupRulesBatchSize, len(authorize))],
+		}
+
+		log.Printf("[DEBUG] Authorizing VPC Security Group (%s) egress rules: %s", securityGroupID, input)
+		if _, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("authorizing egress rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func modifySecurityGroupRules(ctx context.Context, conn *ec2.EC2, securityGroupID string, updates []*ec2.SecurityGroupRuleUpdate) error {
+	for i := 0; i < len(updates); i += securityGroupRulesBatchSize {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId:            aws.String(securityGroupID),
+			SecurityGroupRules: updates[i:minInt(i+securityGroupRulesBatchSize, len(updates))],
+		}
+
+		log.Printf("[DEBUG] Modifying VPC Security Group (%s) rules: %s", securityGroupID, input)
+		if _, err := conn.ModifySecurityGroupRulesWithContext(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffSecurityGroupRules compares the current rules in one direction with the desired rules.
+// It returns the IDs of rules to revoke, the rules whose description must be updated and the permissions to authorize.
+func diffSecurityGroupRules(current []*ec2.SecurityGroupRule, desired []interface{}, accountID string) ([]string, []*ec2.SecurityGroupRuleUpdate, []*ec2.IpPermission, error) {
+	want := make(map[string]map[string]interface{}, len(desired))
+
+	for _, tfMapRaw := range desired {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		want[securityGroupRulesRuleKey(tfMap)] = tfMap
+	}
+
+	var revoke []string
+	var modify []*ec2.SecurityGroupRuleUpdate
+
+	for _, v := range current {
+		tfMap := flattenSecurityGroupRulesRule(v, accountID)
+		key := securityGroupRulesRuleKey(tfMap)
+		wantMap, ok := want[key]
+
+		if !ok {
+			revoke = append(revoke, aws.StringValue(v.SecurityGroupRuleId))
+			continue
+		}
+
+		delete(want, key)
+
+		if wantMap["description"].(string) != tfMap["description"].(string) {
+			modify = append(modify, &ec2.SecurityGroupRuleUpdate{
+				SecurityGroupRule:   expandSecurityGroupRulesRuleRequest(wantMap),
+				SecurityGroupRuleId: v.SecurityGroupRuleId,
+			})
+		}
+	}
+
+	var authorize []*ec2.IpPermission
+
+	for _, tfMap := range want {
+		apiObject, err := expandSecurityGroupRulesIPPermission(tfMap)
+
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		authorize = append(authorize, apiObject)
+	}
+
+	return revoke, modify, authorize, nil
+}
+
+// securityGroupRulesRuleKey returns a string that uniquely identifies a rule, ignoring its description.
+func securityGroupRulesRuleKey(tfMap map[string]interface{}) string {
+	var buf bytes.Buffer
+
+	buf.WriteString(fmt.Sprintf("%s-", ProtocolForValue(tfMap["ip_protocol"].(string))))
+	buf.WriteString(fmt.Sprintf("%d-", tfMap["from_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", tfMap["to_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", tfMap["cidr_ipv4"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", tfMap["cidr_ipv6"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", tfMap["prefix_list_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", tfMap["referenced_security_group_id"].(string)))
+
+	return buf.String()
+}
+
+func securityGroupRulesRuleHash(v interface{}) int {
+	tfMap := v.(map[string]interface{})
+
+	return create.StringHashcode(fmt.Sprintf("%s%s-", securityGroupRulesRuleKey(tfMap), tfMap["description"].(string)))
+}
+
+func expandSecurityGroupRulesIPPermission(tfMap map[string]interface{}) (*ec2.IpPermission, error) {
+	apiObject := &ec2.IpPermission{
+		FromPort:   aws.Int64(int64(tfMap["from_port"].(int))),
+		IpProtocol: aws.String(ProtocolForValue(tfMap["ip_protocol"].(string))),
+		ToPort:     aws.Int64(int64(tfMap["to_port"].(int))),
+	}
+
+	var description *string
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		description = aws.String(v)
+	}
+
+	switch {
+	case tfMap["cidr_ipv4"].(string) != "":
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(tfMap["cidr_ipv4"].(string)),
+			Description: description,
+		}}
+	case tfMap["cidr_ipv6"].(string) != "":
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(tfMap["cidr_ipv6"].(string)),
+			Description: description,
+		}}
+	case tfMap["prefix_list_id"].(string) != "":
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  description,
+			PrefixListId: aws.String(tfMap["prefix_list_id"].(string)),
+		}}
+	case tfMap["referenced_security_group_id"].(string) != "":
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{{
+			Description: description,
+		}}
+
+		// [UserID/]GroupID.
+		if parts := strings.Split(tfMap["referenced_security_group_id"].(string), "/"); len(parts) == 2 {
+			apiObject.UserIdGroupPairs[0].GroupId = aws.String(parts[1])
+			apiObject.UserIdGroupPairs[0].UserId = aws.String(parts[0])
+		} else {
+			apiObject.UserIdGroupPairs[0].GroupId = aws.String(parts[0])
+		}
+	default:
+		return nil, fmt.Errorf("one of cidr_ipv4, cidr_ipv6, prefix_list_id or referenced_security_group_id must be specified")
+	}
+
+	return apiObject, nil
+}
+
+func expandSecurityGroupRulesRuleRequest(tfMap map[string]interface{}) *ec2.SecurityGroupRuleRequest {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		Description: aws.String(tfMap["description"].(string)),
+		FromPort:    aws.Int64(int64(tfMap["from_port"].(int))),
+		IpProtocol:  aws.String(ProtocolForValue(tfMap["ip_protocol"].(string))),
+		ToPort:      aws.Int64(int64(tfMap["to_port"].(int))),
+	}
+
+	if v, ok := tfMap["cidr_ipv4"].(string); ok && v != "" {
+		apiObject.CidrIpv4 = aws.String(v)
+	}
+
+	if v, ok := tfMap["cidr_ipv6"].(string); ok && v != "" {
+		apiObject.CidrIpv6 = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix_list_id"].(string); ok && v != "" {
+		apiObject.PrefixListId = aws.String(v)
+	}
+
+	if v, ok := tfMap["referenced_security_group_id"].(string); ok && v != "" {
+		// [UserID/]GroupID.
+		parts := strings.Split(v, "/")
+		apiObject.ReferencedGroupId = aws.String(parts[len(parts)-1])
+	}
+
+	return apiObject
+}
+
+func flattenSecurityGroupRulesRule(apiObject *ec2.SecurityGroupRule, accountID string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"cidr_ipv4":                    aws.StringValue(apiObject.CidrIpv4),
+		"cidr_ipv6":                    aws.StringValue(apiObject.CidrIpv6),
+		"description":                  aws.StringValue(apiObject.Description),
+		"from_port":                    int(aws.Int64Value(apiObject.FromPort)),
+		"ip_protocol":                  ProtocolForValue(aws.StringValue(apiObject.IpProtocol)),
+		"prefix_list_id":               aws.StringValue(apiObject.PrefixListId),
+		"referenced_security_group_id": "",
+		"to_port":                      int(aws.Int64Value(apiObject.ToPort)),
+	}
+
+	if v := apiObject.ReferencedGroupInfo; v != nil {
+		if v.UserId == nil || aws.StringValue(v.UserId) == accountID {
+			tfMap["referenced_security_group_id"] = aws.StringValue(v.GroupId)
+		} else {
+			// [UserID/]GroupID.
+			tfMap["referenced_security_group_id"] = strings.Join([]string{aws.StringValue(v.UserId), aws.StringValue(v.GroupId)}, "/")
+		}
+	}
+
+	return tfMap
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
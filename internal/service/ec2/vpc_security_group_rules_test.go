@@ -0,0 +1,293 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccVPCSecurityGroupRules_basic(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+	sgResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(sgResourceName, &group),
+					testAccCheckSecurityGroupRulesCount(sgResourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "egress.*", map[string]string{
+						"cidr_ipv4":   "0.0.0.0/0",
+						"from_port":   "-1",
+						"ip_protocol": "-1",
+						"to_port":     "-1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"from_port":   "80",
+						"ip_protocol": "tcp",
+						"to_port":     "8080",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", sgResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_update(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+	sgResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(sgResourceName, &group),
+					testAccCheckSecurityGroupRulesCount(sgResourceName, 2),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(sgResourceName, &group),
+					testAccCheckSecurityGroupRulesCount(sgResourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"description": "HTTP",
+						"from_port":   "80",
+						"ip_protocol": "tcp",
+						"to_port":     "8080",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv6":   "::/0",
+						"from_port":   "443",
+						"ip_protocol": "tcp",
+						"to_port":     "443",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "ingress.*.referenced_security_group_id", sgResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_outOfBandRule(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+	sgResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(sgResourceName, &group),
+					testAccCheckSecurityGroupRulesAddIngress(&group),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(sgResourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_expectInvalidSource(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCSecurityGroupRulesConfig_multipleSources(rName),
+				ExpectError: regexp.MustCompile(`ingress: exactly one of cidr_ipv4, cidr_ipv6, prefix_list_id or referenced_security_group_id must be specified, got 2`),
+			},
+			{
+				Config:      testAccVPCSecurityGroupRulesConfig_noSource(rName),
+				ExpectError: regexp.MustCompile(`egress: exactly one of cidr_ipv4, cidr_ipv6, prefix_list_id or referenced_security_group_id must be specified, got 0`),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesCount(n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("VPC Security Group (%s) has %d rules, expected %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesAddIngress(v *ec2.SecurityGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := conn.AuthorizeSecurityGroupIngressWithContext(context.Background(), &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: v.GroupId,
+			IpPermissions: []*ec2.IpPermission{{
+				FromPort:   aws.Int64(22),
+				IpProtocol: aws.String("tcp"),
+				IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("192.168.0.0/16")}},
+				ToPort:     aws.Int64(22),
+			}},
+		})
+
+		return err
+	}
+}
+
+func testAccVPCSecurityGroupRulesConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [egress, ingress]
+  }
+}
+`, rName))
+}
+
+func testAccVPCSecurityGroupRulesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRulesConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  egress {
+    cidr_ipv4   = "0.0.0.0/0"
+    ip_protocol = "-1"
+  }
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 80
+    ip_protocol = "tcp"
+    to_port     = 8080
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRulesConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    description = "HTTP"
+    from_port   = 80
+    ip_protocol = "6"
+    to_port     = 8080
+  }
+
+  ingress {
+    cidr_ipv6   = "::/0"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+
+  ingress {
+    ip_protocol                  = "-1"
+    referenced_security_group_id = aws_security_group.test.id
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesConfig_multipleSources(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRulesConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    cidr_ipv6   = "::/0"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesConfig_noSource(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRulesConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  egress {
+    ip_protocol = "-1"
+  }
+}
+`)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
@@ -26,6 +27,11 @@ func DataSourceSubnets() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"tags": tftags.TagsSchemaComputed(),
 		},
 	}
@@ -51,16 +57,19 @@ func dataSourceSubnetsRead(d *schema.ResourceData, meta interface{}) error {
 		input.Filters = nil
 	}
 
-	output, err := FindSubnets(conn, input)
-
-	if err != nil {
-		return fmt.Errorf("error reading EC2 Subnets: %w", err)
-	}
-
+	// Only the IDs of each page of subnets are retained.
+	input.MaxResults = aws.Int64(1000)
+	limit := d.Get("limit").(int)
 	var subnetIDs []string
 
-	for _, v := range output {
+	err := FindSubnetsFunc(conn, input, func(v *ec2.Subnet) bool {
 		subnetIDs = append(subnetIDs, aws.StringValue(v.SubnetId))
+
+		return limit == 0 || len(subnetIDs) < limit
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Subnets: %w", err)
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
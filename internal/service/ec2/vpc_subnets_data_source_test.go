@@ -52,6 +52,24 @@ func TestAccVPCSubnetsDataSource_filter(t *testing.T) {
 	})
 }
 
+func TestAccVPCSubnetsDataSource_limit(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSubnetsDataSourceConfig_limit(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_subnets.test", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVPCSubnetsDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -197,3 +215,38 @@ data "aws_subnets" "test" {
 }
 `, rName))
 }
+
+func testAccVPCSubnetsDataSourceConfig_limit(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  vpc_id            = aws_vpc.test.id
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_subnets" "test" {
+  filter {
+    name   = "vpc-id"
+    values = [aws_vpc.test.id]
+  }
+
+  limit = 1
+
+  depends_on = [aws_subnet.test]
+}
+`, rName))
+}
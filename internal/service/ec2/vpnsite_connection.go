@@ -193,14 +193,12 @@ func ResourceVPNConnection() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideCIDR(),
 			},
 			"tunnel1_inside_ipv6_cidr": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideIPv6CIDR(),
 				RequiredWith: []string{"transit_gateway_id"},
 			},
@@ -406,14 +404,12 @@ func ResourceVPNConnection() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideCIDR(),
 			},
 			"tunnel2_inside_ipv6_cidr": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideIPv6CIDR(),
 				RequiredWith: []string{"transit_gateway_id"},
 			},
@@ -1184,6 +1180,22 @@ func expandModifyVPNTunnelOptionsSpecification(d *schema.ResourceData, prefix st
 		hasChange = true
 	}
 
+	if key := prefix + "inside_cidr"; d.HasChange(key) {
+		if v, ok := d.GetOk(key); ok {
+			apiObject.TunnelInsideCidr = aws.String(v.(string))
+
+			hasChange = true
+		}
+	}
+
+	if key := prefix + "inside_ipv6_cidr"; d.HasChange(key) {
+		if v, ok := d.GetOk(key); ok {
+			apiObject.TunnelInsideIpv6Cidr = aws.String(v.(string))
+
+			hasChange = true
+		}
+	}
+
 	if key := prefix + "log_options"; d.HasChange(key) {
 		if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			apiObject.LogOptions = expandVPNTunnelLogOptionsSpecification(v.([]interface{})[0].(map[string]interface{}))
@@ -416,7 +416,7 @@ func TestAccSiteVPNConnection_tunnel1InsideCIDR(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	resourceName := "aws_vpn_connection.test"
-	var vpn ec2.VpnConnection
+	var vpn1, vpn2 ec2.VpnConnection
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -427,11 +427,20 @@ func TestAccSiteVPNConnection_tunnel1InsideCIDR(t *testing.T) {
 			{
 				Config: testAccSiteVPNConnectionConfig_tunnel1InsideCIDR(rName, rBgpAsn, "169.254.8.0/30", "169.254.9.0/30"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccVPNConnectionExists(resourceName, &vpn),
+					testAccVPNConnectionExists(resourceName, &vpn1),
 					resource.TestCheckResourceAttr(resourceName, "tunnel1_inside_cidr", "169.254.8.0/30"),
 					resource.TestCheckResourceAttr(resourceName, "tunnel2_inside_cidr", "169.254.9.0/30"),
 				),
 			},
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnel1InsideCIDR(rName, rBgpAsn, "169.254.10.0/30", "169.254.11.0/30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(resourceName, &vpn2),
+					testAccCheckVPNConnectionNotRecreated(&vpn1, &vpn2),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_inside_cidr", "169.254.10.0/30"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_inside_cidr", "169.254.11.0/30"),
+				),
+			},
 			// NOTE: Import does not currently have access to the Terraform configuration,
 			//       so proper tunnel ordering is not guaranteed on import. The import
 			//       identifier could potentially be updated to accept optional tunnel
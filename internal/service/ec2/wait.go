@@ -2882,6 +2882,24 @@ func WaitIPAMPoolUpdated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.
 	return nil, err
 }
 
+func WaitIPAMPoolAllocationReleased(conn *ec2.EC2, poolID, resourceID, cidrBlock string, timeout time.Duration) (*ec2.IpamPoolAllocation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{IPAMPoolAllocationStatusAllocated},
+		Target:  []string{},
+		Refresh: StatusIPAMPoolAllocation(conn, poolID, resourceID, cidrBlock),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.IpamPoolAllocation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitIPAMPoolCIDRCreated(conn *ec2.EC2, cidrBlock, poolID string, timeout time.Duration) (*ec2.IpamPoolCidr, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.IpamPoolCidrStatePendingProvision},
@@ -2979,3 +2997,63 @@ func WaitIPAMScopeUpdated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2
 
 	return nil, err
 }
+
+func WaitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodePending},
+		Target:  []string{ec2.VerifiedAccessEndpointStatusCodeActive},
+		Refresh: StatusVerifiedAccessEndpointStatus(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodeUpdating},
+		Target:  []string{ec2.VerifiedAccessEndpointStatusCodeActive},
+		Refresh: StatusVerifiedAccessEndpointStatus(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodeDeleting, ec2.VerifiedAccessEndpointStatusCodeActive},
+		Target:  []string{},
+		Refresh: StatusVerifiedAccessEndpointStatus(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
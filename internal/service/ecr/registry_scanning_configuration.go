@@ -1,6 +1,7 @@
 package ecr
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -45,7 +46,7 @@ func ResourceRegistryScanningConfiguration() *schema.Resource {
 										Required: true,
 										ValidateFunc: validation.All(
 											validation.StringLenBetween(1, 256),
-											validation.StringMatch(regexp.MustCompile(`^[a-z0-9*](?:[._\-/a-z0-9*]?[a-z0-9*]+)*$`), "must contain only lowercase alphanumeric, dot, underscore, hyphen, wildcard, and colon characters"),
+											validation.StringMatch(regexp.MustCompile(`^[a-z0-9*](?:[._\-/a-z0-9*]?[a-z0-9*]+)*$`), "must contain only lowercase alphanumeric, dot, underscore, hyphen, slash, and wildcard characters"),
 										),
 									},
 									"filter_type": {
@@ -70,6 +71,8 @@ func ResourceRegistryScanningConfiguration() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(ecr.ScanType_Values(), false),
 			},
 		},
+
+		CustomizeDiff: resourceRegistryScanningConfigurationCustomizeDiff,
 	}
 }
 
@@ -101,9 +104,15 @@ func resourceRegistryScanningConfigurationRead(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
 	}
 
+	if out == nil || out.ScanningConfiguration == nil {
+		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): empty output", d.Id())
+	}
+
 	d.Set("registry_id", out.RegistryId)
 	d.Set("scan_type", out.ScanningConfiguration.ScanType)
-	d.Set("rule", flattenScanningConfigurationRules(out.ScanningConfiguration.Rules))
+	if err := d.Set("rule", flattenScanningConfigurationRules(out.ScanningConfiguration.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
 
 	return nil
 }
@@ -124,6 +133,40 @@ func resourceRegistryScanningConfigurationDelete(d *schema.ResourceData, meta in
 	return nil
 }
 
+func resourceRegistryScanningConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	scanType := diff.Get("scan_type").(string)
+	if scanType == "" {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("rule").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// Continuous scanning is only available with Amazon Inspector enhanced scanning.
+		if scanFrequency := tfMap["scan_frequency"].(string); scanFrequency == ecr.ScanFrequencyContinuousScan && scanType != ecr.ScanTypeEnhanced {
+			return fmt.Errorf("rule scan_frequency %q requires scan_type %q", scanFrequency, ecr.ScanTypeEnhanced)
+		}
+
+		filters := make(map[string]struct{})
+		for _, v := range tfMap["repository_filter"].(*schema.Set).List() {
+			filter, ok := v.(map[string]interface{})["filter"].(string)
+			if !ok || filter == "" {
+				continue
+			}
+
+			if _, ok := filters[filter]; ok {
+				return fmt.Errorf("rule contains duplicate repository_filter %q", filter)
+			}
+			filters[filter] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
 // Helper functions
 
 func expandScanningRegistryRules(l []interface{}) []*ecr.RegistryScanningRule {
@@ -2,6 +2,7 @@ package ecr_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -13,8 +14,9 @@ import (
 
 func TestAccECRScanningConfiguration_serial(t *testing.T) {
 	testFuncs := map[string]func(t *testing.T){
-		"basic":  testAccRegistryScanningConfiguration_basic,
-		"update": testAccRegistryScanningConfiguration_update,
+		"basic":                testAccRegistryScanningConfiguration_basic,
+		"update":               testAccRegistryScanningConfiguration_update,
+		"invalidScanFrequency": testAccRegistryScanningConfiguration_invalidScanFrequency,
 	}
 
 	for name, testFunc := range testFuncs {
@@ -111,6 +113,21 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 	})
 }
 
+func testAccRegistryScanningConfiguration_invalidScanFrequency(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccRegistryScanningConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_basicContinuousScan(),
+				ExpectError: regexp.MustCompile(`requires scan_type "ENHANCED"`),
+			},
+		},
+	})
+}
+
 func testAccRegistryScanningConfigurationDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
 
@@ -198,3 +215,18 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
 }
 `
 }
+
+func testAccRegistryScanningConfigurationConfig_basicContinuousScan() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+    repository_filter {
+      filter      = "example"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
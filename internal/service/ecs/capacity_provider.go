@@ -3,15 +3,18 @@ package ecs
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -176,6 +179,10 @@ func resourceCapacityProviderRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.Set("arn", output.CapacityProviderArn)
 
+	if v := output.AutoScalingGroupProvider; v != nil && aws.StringValue(v.ManagedTerminationProtection) == ecs.ManagedTerminationProtectionEnabled {
+		checkCapacityProviderAutoScalingGroup(meta.(*conns.AWSClient).AutoScalingConn, d.Id(), aws.StringValue(v.AutoScalingGroupArn))
+	}
+
 	if err := d.Set("auto_scaling_group_provider", flattenAutoScalingGroupProvider(output.AutoScalingGroupProvider)); err != nil {
 		return fmt.Errorf("error setting auto_scaling_group_provider: %w", err)
 	}
@@ -288,6 +295,38 @@ func resourceCapacityProviderImport(d *schema.ResourceData, meta interface{}) ([
 	return []*schema.ResourceData{d}, nil
 }
 
+// checkCapacityProviderAutoScalingGroup warns when the Auto Scaling group backing a capacity provider
+// with managed termination protection has been deleted or no longer protects new instances from scale in.
+func checkCapacityProviderAutoScalingGroup(conn *autoscaling.AutoScaling, capacityProviderARN, autoScalingGroupARN string) {
+	parsedARN, err := arn.Parse(autoScalingGroupARN)
+
+	if err != nil {
+		return
+	}
+
+	_, name, found := strings.Cut(parsedARN.Resource, "autoScalingGroupName/")
+
+	if !found {
+		return
+	}
+
+	group, err := tfautoscaling.FindGroupByName(conn, name)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] ECS Capacity Provider (%s) Auto Scaling Group (%s) not found", capacityProviderARN, name)
+		return
+	}
+
+	if err != nil {
+		log.Printf("[WARN] reading ECS Capacity Provider (%s) Auto Scaling Group (%s): %s", capacityProviderARN, name, err)
+		return
+	}
+
+	if !aws.BoolValue(group.NewInstancesProtectedFromScaleIn) {
+		log.Printf("[WARN] ECS Capacity Provider (%s) has managed termination protection enabled but Auto Scaling Group (%s) does not protect new instances from scale in", capacityProviderARN, name)
+	}
+}
+
 func expandAutoScalingGroupProviderCreate(configured interface{}) *ecs.AutoScalingGroupProvider {
 	if configured == nil {
 		return nil
@@ -385,6 +385,22 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			"service_connect_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"discovery_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"discovery_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"service_registries": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -744,6 +760,10 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	// 	return fmt.Errorf("error setting service_connect_configuration for (%s): %w", d.Id(), err)
 	// }
 
+	if err := d.Set("service_connect_resources", flattenServiceConnectServiceResources(service.Deployments)); err != nil {
+		return fmt.Errorf("error setting service_connect_resources for (%s): %w", d.Id(), err)
+	}
+
 	if err := d.Set("service_registries", flattenServiceRegistries(service.ServiceRegistries)); err != nil {
 		return fmt.Errorf("error setting service_registries for (%s): %w", d.Id(), err)
 	}
@@ -1096,6 +1116,30 @@ func expandDeploymentController(l []interface{}) *ecs.DeploymentController {
 	return deploymentController
 }
 
+// flattenServiceConnectServiceResources returns the Cloud Map services created for the service's primary deployment.
+func flattenServiceConnectServiceResources(deployments []*ecs.Deployment) []interface{} {
+	tfList := []interface{}{}
+
+	for _, deployment := range deployments {
+		if deployment == nil || aws.StringValue(deployment.Status) != serviceDeploymentStatusPrimary {
+			continue
+		}
+
+		for _, apiObject := range deployment.ServiceConnectResources {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"discovery_arn":  aws.StringValue(apiObject.DiscoveryArn),
+				"discovery_name": aws.StringValue(apiObject.DiscoveryName),
+			})
+		}
+	}
+
+	return tfList
+}
+
 func flattenDeploymentController(deploymentController *ecs.DeploymentController) []interface{} {
 	m := map[string]interface{}{
 		"type": ecs.DeploymentControllerTypeEcs,
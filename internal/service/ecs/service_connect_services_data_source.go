@@ -0,0 +1,57 @@
+package ecs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceServiceConnectServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceServiceConnectServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceServiceConnectServicesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	namespace := d.Get("namespace").(string)
+	input := &ecs.ListServicesByNamespaceInput{
+		Namespace: aws.String(namespace),
+	}
+	var serviceARNs []string
+
+	err := conn.ListServicesByNamespacePages(input, func(page *ecs.ListServicesByNamespaceOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		serviceARNs = append(serviceARNs, aws.StringValueSlice(page.ServiceArns)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing ECS Services in Service Connect namespace (%s): %w", namespace, err)
+	}
+
+	d.SetId(namespace)
+	d.Set("service_arns", serviceARNs)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package ecs_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSServiceConnectServicesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ecs_service_connect_services.test"
+	resourceName := "aws_ecs_service.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConnectServicesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "namespace", "aws_service_discovery_http_namespace.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_arns.0", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceConnectServicesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_serviceConnectBasic(rName), `
+data "aws_ecs_service_connect_services" "test" {
+  namespace = aws_service_discovery_http_namespace.test.arn
+
+  depends_on = [aws_ecs_service.test]
+}
+`)
+}
@@ -1189,6 +1189,7 @@ func TestAccECSService_ServiceConnect_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_resources.#", "0"),
 				),
 			},
 		},
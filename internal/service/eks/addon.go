@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -31,7 +33,10 @@ func ResourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAddonConfigurationValuesCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -118,7 +123,13 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if v, ok := d.GetOk("resolve_conflicts"); ok {
-		input.ResolveConflicts = aws.String(v.(string))
+		// PRESERVE only applies to updates. There are no Amazon EKS add-on
+		// configuration changes to keep when the add-on is first created.
+		if v := v.(string); v == eks.ResolveConflictsPreserve {
+			input.ResolveConflicts = aws.String(eks.ResolveConflictsNone)
+		} else {
+			input.ResolveConflicts = aws.String(v)
+		}
 	}
 
 	if v, ok := d.GetOk("service_account_role_arn"); ok {
@@ -326,3 +337,40 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+// resourceAddonConfigurationValuesCustomizeDiff validates JSON configuration values
+// against the add-on version's configuration schema so that invalid values are
+// reported at plan time rather than as a failed apply.
+func resourceAddonConfigurationValuesCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("addon_version", "configuration_values") || !diff.NewValueKnown("configuration_values") {
+		return nil
+	}
+
+	configurationValues := diff.Get("configuration_values").(string)
+
+	// YAML configuration values are not validated.
+	if !strings.HasPrefix(strings.TrimSpace(configurationValues), "{") {
+		return nil
+	}
+
+	addonName, addonVersion := diff.Get("addon_name").(string), diff.Get("addon_version").(string)
+
+	if addonName == "" || addonVersion == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSConn
+
+	output, err := FindAddonConfigurationByAddonNameAndVersion(ctx, conn, addonName, addonVersion)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read EKS Add-On (%s) version (%s) configuration schema, skipping configuration_values validation: %s", addonName, addonVersion, err)
+		return nil
+	}
+
+	if err := validAddonConfigurationValues(aws.StringValue(output.ConfigurationSchema), configurationValues); err != nil {
+		return fmt.Errorf("configuration_values for EKS Add-On (%s) version (%s): %w", addonName, addonVersion, err)
+	}
+
+	return nil
+}
@@ -38,6 +38,35 @@ func FindAddonByClusterNameAndAddonName(ctx context.Context, conn *eks.EKS, clus
 	return output.Addon, nil
 }
 
+func FindAddonConfigurationByAddonNameAndVersion(ctx context.Context, conn *eks.EKS, addonName, addonVersion string) (*eks.DescribeAddonConfigurationOutput, error) {
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	}
+
+	output, err := conn.DescribeAddonConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindAddonUpdateByClusterNameAddonNameAndID(ctx context.Context, conn *eks.EKS, clusterName, addonName, id string) (*eks.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
@@ -3,6 +3,9 @@ package eks
 import (
 	"fmt"
 	"regexp"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -22,3 +25,27 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+func validAddonConfigurationValues(configurationSchema, configurationValues string) error {
+	if configurationSchema == "" {
+		return nil
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(configurationSchema), gojsonschema.NewStringLoader(configurationValues))
+
+	if err != nil {
+		return err
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, v := range result.Errors() {
+		errs = multierror.Append(errs, fmt.Errorf("%s", v))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -57,3 +57,57 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidAddonConfigurationValues(t *testing.T) {
+	configurationSchema := `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "additionalProperties": false,
+  "properties": {
+    "replicaCount": {
+      "type": "integer"
+    }
+  },
+  "type": "object"
+}`
+
+	cases := []struct {
+		Schema      string
+		Value       string
+		ExpectError bool
+	}{
+		{
+			Schema: configurationSchema,
+			Value:  `{"replicaCount":4}`,
+		},
+		{
+			Schema:      configurationSchema,
+			Value:       `{"replicaCount":"four"}`,
+			ExpectError: true,
+		},
+		{
+			Schema:      configurationSchema,
+			Value:       `{"replicas":4}`,
+			ExpectError: true,
+		},
+		{
+			Schema:      configurationSchema,
+			Value:       `{"replicaCount":4`,
+			ExpectError: true,
+		},
+		{
+			Value: `{"replicas":4}`,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validAddonConfigurationValues(tc.Schema, tc.Value)
+
+		if err == nil && tc.ExpectError {
+			t.Errorf("expected error for %q", tc.Value)
+		}
+
+		if err != nil && !tc.ExpectError {
+			t.Errorf("unexpected error for %q: %s", tc.Value, err)
+		}
+	}
+}
@@ -129,6 +129,10 @@ func waitNodegroupCreated(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 		return output, err
 	}
 
+	if tfresource.TimedOut(err) {
+		setNodegroupHealthIssuesLastError(conn, clusterName, nodeGroupName, err)
+	}
+
 	return nil, err
 }
 
@@ -171,9 +175,25 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterNa
 		return output, err
 	}
 
+	if tfresource.TimedOut(err) {
+		setNodegroupHealthIssuesLastError(conn, clusterName, nodeGroupName, err)
+	}
+
 	return nil, err
 }
 
+// setNodegroupHealthIssuesLastError reports the node group's current health issues
+// (e.g. IAM or Auto Scaling group errors) as the last error of a timed out waiter.
+func setNodegroupHealthIssuesLastError(conn *eks.EKS, clusterName, nodeGroupName string, err error) {
+	output, findErr := FindNodegroupByClusterNameAndNodegroupName(conn, clusterName, nodeGroupName)
+
+	if findErr != nil || output.Health == nil {
+		return
+	}
+
+	tfresource.SetLastError(err, IssuesError(output.Health.Issues))
+}
+
 func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.EKS, clusterName, configName string, timeout time.Duration) (*eks.OidcIdentityProviderConfig, error) {
 	stateConf := resource.StateChangeConf{
 		Pending: []string{eks.ConfigStatusCreating},
@@ -1,10 +1,12 @@
 package elasticbeanstalk
 
 import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,9 +15,11 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -56,7 +60,10 @@ func ResourceEnvironment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffManagedActions,
+			verify.SetTagsDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  EnvironmentMigrateState,
@@ -98,6 +105,58 @@ func ResourceEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"environment_links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"environment_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"managed_actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"instance_refresh_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"preferred_start_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(?i)(mon|tue|wed|thu|fri|sat|sun):([01]\d|2[0-3]):[0-5]\d$`), "must be in the format day:hour:minute, e.g. Sun:10:00"),
+						},
+						"service_role": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"update_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"minor", "patch"}, false),
+						},
+					},
+				},
+			},
 			"tier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -244,6 +303,10 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 		createOpts.Description = aws.String(desc)
 	}
 
+	if v, ok := d.GetOk("managed_actions"); ok {
+		createOpts.OptionSettings = append(createOpts.OptionSettings, expandManagedActionsOptionSettings(v.([]interface{}))...)
+	}
+
 	if cnamePrefix != "" {
 		if tier != "WebServer" {
 			return fmt.Errorf("cannot set cname_prefix for tier: %s", tier)
@@ -307,7 +370,7 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 
 	err = waitForEnvironmentReady(conn, d.Id(), waitForReadyTimeOut, pollInterval, t)
 	if err != nil {
-		return fmt.Errorf("Error waiting for Elastic Beanstalk Environment (%s) to become ready: %w", d.Id(), err)
+		return fmt.Errorf("Error waiting for Elastic Beanstalk Environment (%s) to become ready: %w", d.Id(), appendEnvironmentHealthCauses(conn, d.Id(), err))
 	}
 
 	envErrors, err := getEnvironmentErrors(conn, d.Id(), t)
@@ -315,7 +378,7 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	if envErrors != nil {
-		return envErrors
+		return appendEnvironmentHealthCauses(conn, d.Id(), envErrors)
 	}
 
 	return resourceEnvironmentRead(d, meta)
@@ -410,6 +473,11 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		updateOpts.OptionSettings = add
 	}
 
+	if d.HasChange("managed_actions") {
+		hasChange = true
+		updateOpts.OptionSettings = append(updateOpts.OptionSettings, expandManagedActionsOptionSettings(d.Get("managed_actions").([]interface{}))...)
+	}
+
 	if d.HasChange("platform_arn") {
 		hasChange = true
 		if v, ok := d.GetOk("platform_arn"); ok {
@@ -452,7 +520,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for Elastic Beanstalk Environment (%s) to become ready: %s",
-				d.Id(), err)
+				d.Id(), appendEnvironmentHealthCauses(conn, d.Id(), err))
 		}
 
 		envErrors, err := getEnvironmentErrors(conn, d.Id(), t)
@@ -460,7 +528,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 		if envErrors != nil {
-			return envErrors
+			return appendEnvironmentHealthCauses(conn, d.Id(), envErrors)
 		}
 	}
 
@@ -621,6 +689,9 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("endpoint_url", env.EndpointURL); err != nil {
 		return err
 	}
+	if err := d.Set("environment_links", flattenEnvironmentLinks(env.EnvironmentLinks)); err != nil {
+		return err
+	}
 
 	tags, err := ListTags(conn, arn)
 
@@ -735,6 +806,10 @@ func resourceEnvironmentSettingsRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if err := d.Set("managed_actions", flattenManagedActionsOptionSettings(allSettings)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1015,6 +1090,127 @@ func dropGeneratedSecurityGroup(settingValue string, meta interface{}) string {
 	return strings.Join(legitGroups, ",")
 }
 
+const (
+	managedActionsNamespace               = "aws:elasticbeanstalk:managedactions"
+	managedActionsPlatformUpdateNamespace = "aws:elasticbeanstalk:managedactions:platformupdate"
+)
+
+// customizeDiffManagedActions rejects settings in the managed actions namespaces when managed_actions is configured.
+// Both would otherwise be sent, possibly with conflicting values, in the same request.
+func customizeDiffManagedActions(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v := diff.GetRawConfig().GetAttr("managed_actions"); !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("setting").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if namespace := tfMap["namespace"].(string); strings.HasPrefix(namespace, managedActionsNamespace) {
+			return fmt.Errorf("setting %s:%s conflicts with managed_actions, configure it in managed_actions instead", namespace, tfMap["name"].(string))
+		}
+	}
+
+	return nil
+}
+
+func expandManagedActionsOptionSettings(tfList []interface{}) []*elasticbeanstalk.ConfigurationOptionSetting {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	settings := []*elasticbeanstalk.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String(managedActionsNamespace),
+			OptionName: aws.String("ManagedActionsEnabled"),
+			Value:      aws.String(strconv.FormatBool(tfMap["enabled"].(bool))),
+		},
+		{
+			Namespace:  aws.String(managedActionsPlatformUpdateNamespace),
+			OptionName: aws.String("InstanceRefreshEnabled"),
+			Value:      aws.String(strconv.FormatBool(tfMap["instance_refresh_enabled"].(bool))),
+		},
+	}
+
+	if v, ok := tfMap["preferred_start_time"].(string); ok && v != "" {
+		settings = append(settings, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(managedActionsNamespace),
+			OptionName: aws.String("PreferredStartTime"),
+			Value:      aws.String(v),
+		})
+	}
+
+	if v, ok := tfMap["service_role"].(string); ok && v != "" {
+		settings = append(settings, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(managedActionsNamespace),
+			OptionName: aws.String("ServiceRoleForManagedUpdates"),
+			Value:      aws.String(v),
+		})
+	}
+
+	if v, ok := tfMap["update_level"].(string); ok && v != "" {
+		settings = append(settings, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(managedActionsPlatformUpdateNamespace),
+			OptionName: aws.String("UpdateLevel"),
+			Value:      aws.String(v),
+		})
+	}
+
+	return settings
+}
+
+func flattenManagedActionsOptionSettings(settings *schema.Set) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	for _, v := range settings.List() {
+		setting := v.(map[string]interface{})
+		value, _ := setting["value"].(string)
+
+		switch namespace, name := setting["namespace"].(string), setting["name"].(string); {
+		case namespace == managedActionsNamespace && name == "ManagedActionsEnabled":
+			tfMap["enabled"], _ = strconv.ParseBool(value)
+		case namespace == managedActionsNamespace && name == "PreferredStartTime":
+			tfMap["preferred_start_time"] = value
+		case namespace == managedActionsNamespace && name == "ServiceRoleForManagedUpdates":
+			tfMap["service_role"] = value
+		case namespace == managedActionsPlatformUpdateNamespace && name == "InstanceRefreshEnabled":
+			tfMap["instance_refresh_enabled"], _ = strconv.ParseBool(value)
+		case namespace == managedActionsPlatformUpdateNamespace && name == "UpdateLevel":
+			tfMap["update_level"] = value
+		}
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+// appendEnvironmentHealthCauses adds the causes of the environment's current health status to an error.
+// Causes are only reported for environments with enhanced health reporting.
+func appendEnvironmentHealthCauses(conn *elasticbeanstalk.ElasticBeanstalk, environmentID string, err error) error {
+	output, healthErr := conn.DescribeEnvironmentHealth(&elasticbeanstalk.DescribeEnvironmentHealthInput{
+		AttributeNames: aws.StringSlice([]string{elasticbeanstalk.EnvironmentHealthAttributeCauses, elasticbeanstalk.EnvironmentHealthAttributeHealthStatus}),
+		EnvironmentId:  aws.String(environmentID),
+	})
+
+	if healthErr != nil {
+		log.Printf("[DEBUG] Elastic Beanstalk Environment (%s) health not available: %s", environmentID, healthErr)
+		return err
+	}
+
+	if output == nil || len(output.Causes) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("%w\nEnvironment health (%s) causes:\n  - %s", err, aws.StringValue(output.HealthStatus), strings.Join(aws.StringValueSlice(output.Causes), "\n  - "))
+}
+
 type beanstalkEnvironmentError struct {
 	eventDate     *time.Time
 	environmentID string
@@ -435,6 +435,71 @@ func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_platformARN(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_managedActions(t *testing.T) {
+	var app elasticbeanstalk.EnvironmentDescription
+
+	resourceName := "aws_elastic_beanstalk_environment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_managedActions(rName, "Tue:09:00", "minor"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "environment_links.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.instance_refresh_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.preferred_start_time", "Tue:09:00"),
+					resource.TestCheckResourceAttrPair(resourceName, "managed_actions.0.service_role", "aws_iam_role.service_role", "name"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.update_level", "minor"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"setting",
+					"wait_for_ready_timeout",
+				},
+			},
+			{
+				Config: testAccEnvironmentConfig_managedActions(rName, "Sun:22:30", "patch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.preferred_start_time", "Sun:22:30"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.update_level", "patch"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_managedActionsSettingConflict(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvironmentConfig_managedActionsSettingConflict(rName),
+				ExpectError: regexp.MustCompile(`setting aws:elasticbeanstalk:managedactions:platformupdate:UpdateLevel conflicts with managed_actions`),
+			},
+		},
+	})
+}
+
 func testAccVerifyConfig(env *elasticbeanstalk.EnvironmentDescription, expected []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if env == nil {
@@ -865,6 +930,86 @@ resource "aws_elastic_beanstalk_environment" "test" {
 `, rName)
 }
 
+func testAccEnvironmentConfig_managedActions(rName, preferredStartTime, updateLevel string) string {
+	return testAccEnvironmentConfig_base(rName) + fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  managed_actions {
+    enabled              = true
+    preferred_start_time = %[2]q
+    service_role         = aws_iam_role.service_role.name
+    update_level         = %[3]q
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:healthreporting:system"
+    name      = "SystemType"
+    value     = "enhanced"
+  }
+}
+`, rName, preferredStartTime, updateLevel)
+}
+
+func testAccEnvironmentConfig_managedActionsSettingConflict(rName string) string {
+	return testAccEnvironmentConfig_base(rName) + fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  managed_actions {
+    enabled      = true
+    update_level = "minor"
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:managedactions:platformupdate"
+    name      = "UpdateLevel"
+    value     = "patch"
+  }
+}
+`, rName)
+}
+
 func testAccEnvironmentConfig_platformARN(rName string) string {
 	return testAccEnvironmentConfig_base(rName) + fmt.Sprintf(`
 resource "aws_elastic_beanstalk_environment" "test" {
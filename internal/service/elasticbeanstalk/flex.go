@@ -94,3 +94,18 @@ func flattenResourceLifecycleConfig(rlc *elasticbeanstalk.ApplicationResourceLif
 
 	return result
 }
+
+func flattenEnvironmentLinks(list []*elasticbeanstalk.EnvironmentLink) []interface{} {
+	tfList := make([]interface{}, 0, len(list))
+	for _, r := range list {
+		if r == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"environment_name": aws.StringValue(r.EnvironmentName),
+			"link_name":        aws.StringValue(r.LinkName),
+		})
+	}
+	return tfList
+}
@@ -36,3 +36,29 @@ func FindApplicationByID(conn *emrserverless.EMRServerless, id string) (*emrserv
 
 	return output.Application, nil
 }
+
+func FindJobRunByTwoPartKey(conn *emrserverless.EMRServerless, applicationID, jobRunID string) (*emrserverless.JobRun, error) {
+	input := &emrserverless.GetJobRunInput{
+		ApplicationId: aws.String(applicationID),
+		JobRunId:      aws.String(jobRunID),
+	}
+
+	output, err := conn.GetJobRun(input)
+
+	if tfawserr.ErrCodeEquals(err, emrserverless.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobRun == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JobRun, nil
+}
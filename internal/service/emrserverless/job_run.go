@@ -0,0 +1,750 @@
+package emrserverless
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJobRun() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceJobRunCreate,
+		Read:   resourceJobRunRead,
+		Update: resourceJobRunUpdate,
+		Delete: resourceJobRunDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"classification": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"managed_persistence_monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+													Default:  true,
+												},
+												"encryption_key_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"s3_monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"encryption_key_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"log_uri": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"execution_timeout_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"job_driver": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hive": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"job_driver.0.hive", "job_driver.0.spark_submit"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"init_query_file": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"parameters": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"query": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spark_submit": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"job_driver.0.hive", "job_driver.0.spark_submit"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"entry_point": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"entry_point_arguments": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"spark_submit_parameters": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"job_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"release_label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"total_execution_duration_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_resource_utilization": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"memory_gb_hour": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"storage_gb_hour": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"vcpu_hour": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceJobRunCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EMRServerlessConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	applicationID := d.Get("application_id").(string)
+	input := &emrserverless.StartJobRunInput{
+		ApplicationId:    aws.String(applicationID),
+		ClientToken:      aws.String(resource.UniqueId()),
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("configuration_overrides"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ConfigurationOverrides = expandConfigurationOverrides(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("execution_timeout_minutes"); ok {
+		input.ExecutionTimeoutMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("job_driver"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.JobDriver = expandJobDriver(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Starting EMR Serverless Job Run: %s", input)
+	output, err := conn.StartJobRun(input)
+
+	if err != nil {
+		return fmt.Errorf("starting EMR Serverless Job Run (%s): %w", applicationID, err)
+	}
+
+	jobRunID := aws.StringValue(output.JobRunId)
+	d.SetId(JobRunCreateResourceID(applicationID, jobRunID))
+
+	if _, err := waitJobRunSucceeded(conn, applicationID, jobRunID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for EMR Serverless Job Run (%s) success: %w", d.Id(), err)
+	}
+
+	return resourceJobRunRead(d, meta)
+}
+
+func resourceJobRunRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EMRServerlessConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	applicationID, jobRunID, err := JobRunParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	jobRun, err := FindJobRunByTwoPartKey(conn, applicationID, jobRunID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Serverless Job Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EMR Serverless Job Run (%s): %w", d.Id(), err)
+	}
+
+	d.Set("application_id", jobRun.ApplicationId)
+	d.Set("arn", jobRun.Arn)
+	d.Set("execution_role_arn", jobRun.ExecutionRole)
+	d.Set("job_run_id", jobRun.JobRunId)
+	d.Set("name", jobRun.Name)
+	d.Set("release_label", jobRun.ReleaseLabel)
+	d.Set("state", jobRun.State)
+	d.Set("state_details", jobRun.StateDetails)
+	d.Set("total_execution_duration_seconds", jobRun.TotalExecutionDurationSeconds)
+
+	if jobRun.ConfigurationOverrides != nil {
+		if err := d.Set("configuration_overrides", []interface{}{flattenConfigurationOverrides(jobRun.ConfigurationOverrides)}); err != nil {
+			return fmt.Errorf("setting configuration_overrides: %w", err)
+		}
+	} else {
+		d.Set("configuration_overrides", nil)
+	}
+
+	if jobRun.JobDriver != nil {
+		if err := d.Set("job_driver", []interface{}{flattenJobDriver(jobRun.JobDriver)}); err != nil {
+			return fmt.Errorf("setting job_driver: %w", err)
+		}
+	} else {
+		d.Set("job_driver", nil)
+	}
+
+	if jobRun.TotalResourceUtilization != nil {
+		if err := d.Set("total_resource_utilization", []interface{}{flattenTotalResourceUtilization(jobRun.TotalResourceUtilization)}); err != nil {
+			return fmt.Errorf("setting total_resource_utilization: %w", err)
+		}
+	} else {
+		d.Set("total_resource_utilization", nil)
+	}
+
+	tags := KeyValueTags(jobRun.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceJobRunUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EMRServerlessConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("updating EMR Serverless Job Run (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceJobRunRead(d, meta)
+}
+
+func resourceJobRunDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EMRServerlessConn
+
+	applicationID, jobRunID, err := JobRunParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Job runs cannot be deleted. Cancel any run that is still in progress.
+	switch d.Get("state").(string) {
+	case emrserverless.JobRunStateSuccess, emrserverless.JobRunStateFailed, emrserverless.JobRunStateCancelled:
+		return nil
+	}
+
+	log.Printf("[INFO] Cancelling EMR Serverless Job Run: %s", d.Id())
+	_, err = conn.CancelJobRun(&emrserverless.CancelJobRunInput{
+		ApplicationId: aws.String(applicationID),
+		JobRunId:      aws.String(jobRunID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, emrserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("cancelling EMR Serverless Job Run (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitJobRunCancelled(conn, applicationID, jobRunID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for EMR Serverless Job Run (%s) cancel: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const jobRunResourceIDSeparator = ","
+
+func JobRunCreateResourceID(applicationID, jobRunID string) string {
+	parts := []string{applicationID, jobRunID}
+	id := strings.Join(parts, jobRunResourceIDSeparator)
+
+	return id
+}
+
+func JobRunParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, jobRunResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION-ID%[2]sJOB-RUN-ID", id, jobRunResourceIDSeparator)
+}
+
+func expandJobDriver(tfMap map[string]interface{}) *emrserverless.JobDriver {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.JobDriver{}
+
+	if v, ok := tfMap["hive"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Hive = expandHive(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["spark_submit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SparkSubmit = expandSparkSubmit(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenJobDriver(apiObject *emrserverless.JobDriver) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Hive; v != nil {
+		tfMap["hive"] = []interface{}{flattenHive(v)}
+	}
+
+	if v := apiObject.SparkSubmit; v != nil {
+		tfMap["spark_submit"] = []interface{}{flattenSparkSubmit(v)}
+	}
+
+	return tfMap
+}
+
+func expandHive(tfMap map[string]interface{}) *emrserverless.Hive {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.Hive{}
+
+	if v, ok := tfMap["init_query_file"].(string); ok && v != "" {
+		apiObject.InitQueryFile = aws.String(v)
+	}
+
+	if v, ok := tfMap["parameters"].(string); ok && v != "" {
+		apiObject.Parameters = aws.String(v)
+	}
+
+	if v, ok := tfMap["query"].(string); ok && v != "" {
+		apiObject.Query = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenHive(apiObject *emrserverless.Hive) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InitQueryFile; v != nil {
+		tfMap["init_query_file"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Parameters; v != nil {
+		tfMap["parameters"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Query; v != nil {
+		tfMap["query"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func expandSparkSubmit(tfMap map[string]interface{}) *emrserverless.SparkSubmit {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.SparkSubmit{}
+
+	if v, ok := tfMap["entry_point"].(string); ok && v != "" {
+		apiObject.EntryPoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["entry_point_arguments"].([]interface{}); ok && len(v) > 0 {
+		apiObject.EntryPointArguments = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["spark_submit_parameters"].(string); ok && v != "" {
+		apiObject.SparkSubmitParameters = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSparkSubmit(apiObject *emrserverless.SparkSubmit) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EntryPoint; v != nil {
+		tfMap["entry_point"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EntryPointArguments; v != nil {
+		tfMap["entry_point_arguments"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SparkSubmitParameters; v != nil {
+		tfMap["spark_submit_parameters"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func expandConfigurationOverrides(tfMap map[string]interface{}) *emrserverless.ConfigurationOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.ConfigurationOverrides{}
+
+	if v, ok := tfMap["application_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ApplicationConfiguration = expandConfigurations(v)
+	}
+
+	if v, ok := tfMap["monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MonitoringConfiguration = expandMonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenConfigurationOverrides(apiObject *emrserverless.ConfigurationOverrides) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ApplicationConfiguration; v != nil {
+		tfMap["application_configuration"] = flattenConfigurations(v)
+	}
+
+	if v := apiObject.MonitoringConfiguration; v != nil {
+		tfMap["monitoring_configuration"] = []interface{}{flattenMonitoringConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func expandConfigurations(tfList []interface{}) []*emrserverless.Configuration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*emrserverless.Configuration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &emrserverless.Configuration{}
+
+		if v, ok := tfMap["classification"].(string); ok && v != "" {
+			apiObject.Classification = aws.String(v)
+		}
+
+		if v, ok := tfMap["properties"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Properties = flex.ExpandStringMap(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenConfigurations(apiObjects []*emrserverless.Configuration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Classification; v != nil {
+			tfMap["classification"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Properties; v != nil {
+			tfMap["properties"] = aws.StringValueMap(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandMonitoringConfiguration(tfMap map[string]interface{}) *emrserverless.MonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.MonitoringConfiguration{}
+
+	if v, ok := tfMap["managed_persistence_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		config := &emrserverless.ManagedPersistenceMonitoringConfiguration{}
+
+		if v, ok := tfMap["enabled"].(bool); ok {
+			config.Enabled = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+			config.EncryptionKeyArn = aws.String(v)
+		}
+
+		apiObject.ManagedPersistenceMonitoringConfiguration = config
+	}
+
+	if v, ok := tfMap["s3_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		config := &emrserverless.S3MonitoringConfiguration{}
+
+		if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+			config.EncryptionKeyArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["log_uri"].(string); ok && v != "" {
+			config.LogUri = aws.String(v)
+		}
+
+		apiObject.S3MonitoringConfiguration = config
+	}
+
+	return apiObject
+}
+
+func flattenMonitoringConfiguration(apiObject *emrserverless.MonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ManagedPersistenceMonitoringConfiguration; v != nil {
+		tfMap["managed_persistence_monitoring_configuration"] = []interface{}{map[string]interface{}{
+			"enabled":            aws.BoolValue(v.Enabled),
+			"encryption_key_arn": aws.StringValue(v.EncryptionKeyArn),
+		}}
+	}
+
+	if v := apiObject.S3MonitoringConfiguration; v != nil {
+		tfMap["s3_monitoring_configuration"] = []interface{}{map[string]interface{}{
+			"encryption_key_arn": aws.StringValue(v.EncryptionKeyArn),
+			"log_uri":            aws.StringValue(v.LogUri),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenTotalResourceUtilization(apiObject *emrserverless.TotalResourceUtilization) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MemoryGBHour; v != nil {
+		tfMap["memory_gb_hour"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.StorageGBHour; v != nil {
+		tfMap["storage_gb_hour"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.VCPUHour; v != nil {
+		tfMap["vcpu_hour"] = aws.Float64Value(v)
+	}
+
+	return tfMap
+}
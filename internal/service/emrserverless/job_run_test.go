@@ -0,0 +1,127 @@
+package emrserverless_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemrserverless "github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+)
+
+func TestAccEMRServerlessJobRun_basic(t *testing.T) {
+	var jobRun emrserverless.JobRun
+	resourceName := "aws_emrserverless_job_run.test"
+	applicationResourceName := "aws_emrserverless_application.test"
+	roleResourceName := "aws_iam_role.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emrserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(resourceName, &jobRun),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", applicationResourceName, "id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "emr-serverless", regexp.MustCompile(`/applications/.+/jobruns/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "job_driver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_driver.0.spark_submit.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_driver.0.spark_submit.0.entry_point_arguments.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_run_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "release_label", "emr-6.6.0"),
+					resource.TestCheckResourceAttr(resourceName, "state", emrserverless.JobRunStateSuccess),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "total_resource_utilization.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJobRunExists(resourceName string, jobRun *emrserverless.JobRun) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Serverless Job Run ID is set")
+		}
+
+		applicationID, jobRunID, err := tfemrserverless.JobRunParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRServerlessConn
+
+		output, err := tfemrserverless.FindJobRunByTwoPartKey(conn, applicationID, jobRunID)
+
+		if err != nil {
+			return err
+		}
+
+		*jobRun = *output
+
+		return nil
+	}
+}
+
+func testAccJobRunConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "emr-serverless.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-6.6.0"
+  type          = "spark"
+}
+
+resource "aws_emrserverless_job_run" "test" {
+  application_id     = aws_emrserverless_application.test.id
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  job_driver {
+    spark_submit {
+      entry_point             = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+      entry_point_arguments   = ["10"]
+      spark_submit_parameters = "--conf spark.executor.cores=1 --conf spark.executor.memory=4g --conf spark.driver.cores=1 --conf spark.driver.memory=4g"
+    }
+  }
+}
+`, rName)
+}
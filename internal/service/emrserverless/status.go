@@ -22,3 +22,19 @@ func statusApplication(conn *emrserverless.EMRServerless, id string) resource.St
 		return output, aws.StringValue(output.State), nil
 	}
 }
+
+func statusJobRun(conn *emrserverless.EMRServerless, applicationID, jobRunID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindJobRunByTwoPartKey(conn, applicationID, jobRunID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
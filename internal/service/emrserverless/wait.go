@@ -18,6 +18,9 @@ const (
 	ApplicationDeletedTimeout    = 20 * time.Minute
 	ApplicationDeletedMinTimeout = 10 * time.Second
 	ApplicationDeletedDelay      = 30 * time.Second
+
+	jobRunMinTimeout = 10 * time.Second
+	jobRunDelay      = 30 * time.Second
 )
 
 func waitApplicationCreated(conn *emrserverless.EMRServerless, id string) (*emrserverless.Application, error) {
@@ -65,3 +68,64 @@ func waitApplicationTerminated(conn *emrserverless.EMRServerless, id string) (*e
 
 	return nil, err
 }
+
+func waitJobRunSucceeded(conn *emrserverless.EMRServerless, applicationID, jobRunID string, timeout time.Duration) (*emrserverless.JobRun, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			emrserverless.JobRunStateSubmitted,
+			emrserverless.JobRunStatePending,
+			emrserverless.JobRunStateScheduled,
+			emrserverless.JobRunStateRunning,
+		},
+		Target:     []string{emrserverless.JobRunStateSuccess},
+		Refresh:    statusJobRun(conn, applicationID, jobRunID),
+		Timeout:    timeout,
+		MinTimeout: jobRunMinTimeout,
+		Delay:      jobRunDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*emrserverless.JobRun); ok {
+		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
+			tfresource.SetLastError(err, fmt.Errorf(aws.StringValue(stateChangeReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobRunCancelled(conn *emrserverless.EMRServerless, applicationID, jobRunID string, timeout time.Duration) (*emrserverless.JobRun, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			emrserverless.JobRunStateSubmitted,
+			emrserverless.JobRunStatePending,
+			emrserverless.JobRunStateScheduled,
+			emrserverless.JobRunStateRunning,
+			emrserverless.JobRunStateCancelling,
+		},
+		Target: []string{
+			emrserverless.JobRunStateCancelled,
+			emrserverless.JobRunStateFailed,
+			emrserverless.JobRunStateSuccess,
+		},
+		Refresh:    statusJobRun(conn, applicationID, jobRunID),
+		Timeout:    timeout,
+		MinTimeout: jobRunMinTimeout,
+		Delay:      jobRunDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*emrserverless.JobRun); ok {
+		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
+			tfresource.SetLastError(err, fmt.Errorf(aws.StringValue(stateChangeReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,221 @@
+package gamelift
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCompute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeCreate,
+		Read:   resourceComputeRead,
+		Delete: resourceComputeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"compute_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringDoesNotContainAny("/"),
+				),
+			},
+			"compute_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"dns_name", "ip_address"},
+			},
+			"fleet_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"game_lift_service_sdk_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+				ExactlyOneOf: []string{"dns_name", "ip_address"},
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+
+	fleetID := d.Get("fleet_id").(string)
+	name := d.Get("compute_name").(string)
+	input := &gamelift.RegisterComputeInput{
+		ComputeName: aws.String(name),
+		FleetId:     aws.String(fleetID),
+	}
+
+	if v, ok := d.GetOk("certificate_path"); ok {
+		input.CertificatePath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dns_name"); ok {
+		input.DnsName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ip_address"); ok {
+		input.IpAddress = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("location"); ok {
+		input.Location = aws.String(v.(string))
+	}
+
+	log.Printf("[INFO] Registering GameLift Compute: %s", input)
+	_, err := conn.RegisterCompute(input)
+
+	if err != nil {
+		return fmt.Errorf("error registering GameLift Compute (%s): %w", name, err)
+	}
+
+	d.SetId(ComputeCreateResourceID(fleetID, name))
+
+	return resourceComputeRead(d, meta)
+}
+
+func resourceComputeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+
+	fleetID, name, err := ComputeParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	compute, err := FindComputeByFleetIDAndName(conn, fleetID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Compute (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading GameLift Compute (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", compute.ComputeArn)
+	d.Set("compute_name", compute.ComputeName)
+	d.Set("compute_status", compute.ComputeStatus)
+	if compute.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(compute.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set("creation_time", nil)
+	}
+	d.Set("dns_name", compute.DnsName)
+	d.Set("fleet_arn", compute.FleetArn)
+	d.Set("fleet_id", compute.FleetId)
+	d.Set("game_lift_service_sdk_endpoint", compute.GameLiftServiceSdkEndpoint)
+	d.Set("ip_address", compute.IpAddress)
+	d.Set("location", compute.Location)
+	d.Set("operating_system", compute.OperatingSystem)
+	d.Set("type", compute.Type)
+
+	return nil
+}
+
+func resourceComputeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+
+	fleetID, name, err := ComputeParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deregistering GameLift Compute: %s", d.Id())
+	_, err = conn.DeregisterCompute(&gamelift.DeregisterComputeInput{
+		ComputeName: aws.String(name),
+		FleetId:     aws.String(fleetID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering GameLift Compute (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const computeResourceIDSeparator = "/"
+
+func ComputeCreateResourceID(fleetID, name string) string {
+	parts := []string{fleetID, name}
+	id := strings.Join(parts, computeResourceIDSeparator)
+
+	return id
+}
+
+func ComputeParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, computeResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FLEET-ID%[2]sCOMPUTE-NAME", id, computeResourceIDSeparator)
+}
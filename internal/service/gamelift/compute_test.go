@@ -0,0 +1,146 @@
+package gamelift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGameLiftCompute_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	locationName := fmt.Sprintf("custom-%s", sdkacctest.RandString(10))
+	resourceName := "aws_gamelift_compute.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeConfig_basic(rName, locationName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "compute_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_arn", "aws_gamelift_fleet.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_id", "aws_gamelift_fleet.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "game_lift_service_sdk_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "10.1.1.1"),
+					resource.TestCheckResourceAttrPair(resourceName, "location", "aws_gamelift_location.test", "location_name"),
+					resource.TestCheckResourceAttr(resourceName, "type", gamelift.ComputeTypeAnywhere),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftCompute_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	locationName := fmt.Sprintf("custom-%s", sdkacctest.RandString(10))
+	resourceName := "aws_gamelift_compute.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeConfig_basic(rName, locationName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgamelift.ResourceCompute(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GameLift Compute ID is set")
+		}
+
+		fleetID, name, err := tfgamelift.ComputeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+
+		_, err = tfgamelift.FindComputeByFleetIDAndName(conn, fleetID, name)
+
+		return err
+	}
+}
+
+func testAccCheckComputeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_gamelift_compute" {
+			continue
+		}
+
+		fleetID, name, err := tfgamelift.ComputeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfgamelift.FindComputeByFleetIDAndName(conn, fleetID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("GameLift Compute %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccComputeConfig_basic(rName, locationName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_anywhere(rName, locationName, "0.5"), fmt.Sprintf(`
+resource "aws_gamelift_compute" "test" {
+  compute_name = %[1]q
+  fleet_id     = aws_gamelift_fleet.test.id
+  ip_address   = "10.1.1.1"
+  location     = aws_gamelift_location.test.location_name
+}
+`, rName))
+}
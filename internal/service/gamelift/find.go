@@ -117,3 +117,95 @@ func FindScriptByID(conn *gamelift.GameLift, id string) (*gamelift.Script, error
 
 	return output.Script, nil
 }
+
+func FindFleetLocationsByID(conn *gamelift.GameLift, id string) ([]string, error) {
+	input := &gamelift.DescribeFleetLocationAttributesInput{
+		FleetId: aws.String(id),
+	}
+	var output []string
+
+	err := conn.DescribeFleetLocationAttributesPages(input, func(page *gamelift.DescribeFleetLocationAttributesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LocationAttributes {
+			if v != nil && v.LocationState != nil {
+				output = append(output, aws.StringValue(v.LocationState.Location))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindLocationByName(conn *gamelift.GameLift, name string) (*gamelift.LocationModel, error) {
+	input := &gamelift.ListLocationsInput{
+		Filters: aws.StringSlice([]string{gamelift.LocationFilterCustom}),
+	}
+	var output *gamelift.LocationModel
+
+	err := conn.ListLocationsPages(input, func(page *gamelift.ListLocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Locations {
+			if aws.StringValue(v.LocationName) == name {
+				output = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindComputeByFleetIDAndName(conn *gamelift.GameLift, fleetID, name string) (*gamelift.Compute, error) {
+	input := &gamelift.DescribeComputeInput{
+		ComputeName: aws.String(name),
+		FleetId:     aws.String(fleetID),
+	}
+
+	output, err := conn.DescribeCompute(input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Compute == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Compute, nil
+}
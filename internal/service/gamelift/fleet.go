@@ -40,6 +40,19 @@ func ResourceFleet() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"anywhere_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cost": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -49,10 +62,10 @@ func ResourceFleet() *schema.Resource {
 				Computed: true,
 			},
 			"build_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"build_id", "script_id"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"script_id"},
 			},
 			"certificate_configuration": {
 				Type:     schema.TypeList,
@@ -71,6 +84,13 @@ func ResourceFleet() *schema.Resource {
 					},
 				},
 			},
+			"compute_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.ComputeType_Values(), false),
+			},
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -108,7 +128,7 @@ func ResourceFleet() *schema.Resource {
 			},
 			"ec2_instance_type": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(gamelift.EC2InstanceType_Values(), false),
 			},
@@ -125,6 +145,13 @@ func ResourceFleet() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 				Optional:     true,
 			},
+			"locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"log_paths": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -221,10 +248,10 @@ func ResourceFleet() *schema.Resource {
 				Computed: true,
 			},
 			"script_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"build_id", "script_id"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"build_id"},
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -240,9 +267,24 @@ func resourceFleetCreate(d *schema.ResourceData, meta interface{}) error {
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	input := &gamelift.CreateFleetInput{
-		EC2InstanceType: aws.String(d.Get("ec2_instance_type").(string)),
-		Name:            aws.String(d.Get("name").(string)),
-		Tags:            Tags(tags.IgnoreAWS()),
+		Name: aws.String(d.Get("name").(string)),
+		Tags: Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("anywhere_configuration"); ok {
+		input.AnywhereConfiguration = expandAnywhereConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("compute_type"); ok {
+		input.ComputeType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ec2_instance_type"); ok {
+		input.EC2InstanceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("locations"); ok && v.(*schema.Set).Len() > 0 {
+		input.Locations = expandLocationConfigurations(v.(*schema.Set).List())
 	}
 
 	if v, ok := d.GetOk("build_id"); ok {
@@ -336,8 +378,12 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	arn := aws.StringValue(fleet.FleetArn)
+	if err := d.Set("anywhere_configuration", flattenAnywhereConfiguration(fleet.AnywhereConfiguration)); err != nil {
+		return fmt.Errorf("error setting anywhere_configuration: %w", err)
+	}
 	d.Set("build_arn", fleet.BuildArn)
 	d.Set("build_id", fleet.BuildId)
+	d.Set("compute_type", fleet.ComputeType)
 	d.Set("description", fleet.Description)
 	d.Set("arn", arn)
 	d.Set("log_paths", aws.StringValueSlice(fleet.LogPaths))
@@ -359,6 +405,21 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting resource_creation_limit_policy: %w", err)
 	}
 
+	locations, err := FindFleetLocationsByID(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading GameLift Fleet (%s) locations: %w", d.Id(), err)
+	}
+
+	// The home Region is always returned but is implicit in configuration.
+	var remoteLocations []string
+	for _, location := range locations {
+		if location != meta.(*conns.AWSClient).Region {
+			remoteLocations = append(remoteLocations, location)
+		}
+	}
+	d.Set("locations", remoteLocations)
+
 	portInput := &gamelift.DescribeFleetPortSettingsInput{
 		FleetId: aws.String(d.Id()),
 	}
@@ -401,8 +462,9 @@ func resourceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Updating GameLift Fleet: %s", d.Id())
 
-	if d.HasChanges("description", "metric_groups", "name", "new_game_session_protection_policy", "resource_creation_limit_policy") {
+	if d.HasChanges("anywhere_configuration", "description", "metric_groups", "name", "new_game_session_protection_policy", "resource_creation_limit_policy") {
 		_, err := conn.UpdateFleetAttributes(&gamelift.UpdateFleetAttributesInput{
+			AnywhereConfiguration:          expandAnywhereConfiguration(d.Get("anywhere_configuration").([]interface{})),
 			Description:                    aws.String(d.Get("description").(string)),
 			FleetId:                        aws.String(d.Id()),
 			MetricGroups:                   flex.ExpandStringList(d.Get("metric_groups").([]interface{})),
@@ -488,6 +550,42 @@ func resourceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func expandAnywhereConfiguration(tfList []interface{}) *gamelift.AnywhereConfiguration {
+	if len(tfList) < 1 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &gamelift.AnywhereConfiguration{
+		Cost: aws.String(tfMap["cost"].(string)),
+	}
+}
+
+func flattenAnywhereConfiguration(apiObject *gamelift.AnywhereConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cost": aws.StringValue(apiObject.Cost),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandLocationConfigurations(tfList []interface{}) []*gamelift.LocationConfiguration {
+	var apiObjects []*gamelift.LocationConfiguration
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, &gamelift.LocationConfiguration{
+			Location: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
 func expandIPPermissions(cfgs *schema.Set) []*gamelift.IpPermission {
 	if cfgs.Len() < 1 {
 		return []*gamelift.IpPermission{}
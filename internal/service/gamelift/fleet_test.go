@@ -573,6 +573,54 @@ func TestAccGameLiftFleet_script(t *testing.T) {
 	})
 }
 
+func TestAccGameLiftFleet_anywhere(t *testing.T) {
+	var conf gamelift.FleetAttributes
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	locationName := fmt.Sprintf("custom-%s", sdkacctest.RandString(10))
+	resourceName := "aws_gamelift_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_anywhere(rName, locationName, "0.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.0.cost", "0.5"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "gamelift", regexp.MustCompile(`fleet/fleet-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "compute_type", gamelift.ComputeTypeAnywhere),
+					resource.TestCheckResourceAttr(resourceName, "ec2_instance_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "locations.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "locations.*", "aws_gamelift_location.test", "location_name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetConfig_anywhere(rName, locationName, "1.25"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.0.cost", "1.25"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGameLiftFleet_disappears(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -987,3 +1035,21 @@ resource "aws_gamelift_fleet" "test" {
 }
 `, rName)
 }
+
+func testAccFleetConfig_anywhere(rName, locationName, cost string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_location" "test" {
+  location_name = %[2]q
+}
+
+resource "aws_gamelift_fleet" "test" {
+  name         = %[1]q
+  compute_type = "ANYWHERE"
+  locations    = [aws_gamelift_location.test.location_name]
+
+  anywhere_configuration {
+    cost = %[3]q
+  }
+}
+`, rName, locationName, cost)
+}
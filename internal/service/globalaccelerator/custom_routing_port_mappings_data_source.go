@@ -0,0 +1,165 @@
+package globalaccelerator
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func init() {
+	_sp.registerFrameworkDataSourceFactory(newDataSourceCustomRoutingPortMappings)
+}
+
+// newDataSourceCustomRoutingPortMappings instantiates a new DataSource for the aws_globalaccelerator_custom_routing_port_mappings data source.
+func newDataSourceCustomRoutingPortMappings(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceCustomRoutingPortMappings{}, nil
+}
+
+type dataSourceCustomRoutingPortMappings struct {
+	framework.DataSourceWithConfigure
+}
+
+var portMappingAttributeTypes = map[string]attr.Type{
+	"accelerator_port":          types.Int64Type,
+	"destination_ip_address":    types.StringType,
+	"destination_port":          types.Int64Type,
+	"destination_traffic_state": types.StringType,
+	"endpoint_group_arn":        types.StringType,
+	"endpoint_id":               types.StringType,
+	"protocols":                 types.ListType{ElemType: types.StringType},
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceCustomRoutingPortMappings) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_globalaccelerator_custom_routing_port_mappings"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceCustomRoutingPortMappings) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"accelerator_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"endpoint_group_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"endpoint_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"port_mappings": schema.ListAttribute{
+				ElementType: types.ObjectType{
+					AttrTypes: portMappingAttributeTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceCustomRoutingPortMappings) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceCustomRoutingPortMappingsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().GlobalAcceleratorConn
+
+	acceleratorARN := data.AcceleratorARN.ValueARN().String()
+	input := &globalaccelerator.ListCustomRoutingPortMappingsInput{
+		AcceleratorArn: aws.String(acceleratorARN),
+	}
+
+	if !data.EndpointGroupARN.IsNull() {
+		input.EndpointGroupArn = aws.String(data.EndpointGroupARN.ValueARN().String())
+	}
+
+	var results []*globalaccelerator.PortMapping
+	err := conn.ListCustomRoutingPortMappingsPagesWithContext(ctx, input, func(page *globalaccelerator.ListCustomRoutingPortMappingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, portMapping := range page.PortMappings {
+			if portMapping == nil {
+				continue
+			}
+
+			if !data.EndpointID.IsNull() && data.EndpointID.ValueString() != aws.StringValue(portMapping.EndpointId) {
+				continue
+			}
+
+			results = append(results, portMapping)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Global Accelerator Custom Routing Port Mappings", err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(acceleratorARN)
+	data.PortMappings = d.flattenPortMappingsFramework(ctx, results)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (d *dataSourceCustomRoutingPortMappings) flattenPortMappingFramework(ctx context.Context, apiObject *globalaccelerator.PortMapping) types.Object {
+	attributes := map[string]attr.Value{
+		"accelerator_port":          flex.Int64ToFrameworkLegacy(ctx, apiObject.AcceleratorPort),
+		"destination_ip_address":    types.StringValue(""),
+		"destination_port":          types.Int64Value(0),
+		"destination_traffic_state": flex.StringToFrameworkLegacy(ctx, apiObject.DestinationTrafficState),
+		"endpoint_group_arn":        flex.StringToFrameworkLegacy(ctx, apiObject.EndpointGroupArn),
+		"endpoint_id":               flex.StringToFrameworkLegacy(ctx, apiObject.EndpointId),
+		"protocols":                 flex.FlattenFrameworkStringList(ctx, apiObject.Protocols),
+	}
+
+	if v := apiObject.DestinationSocketAddress; v != nil {
+		attributes["destination_ip_address"] = flex.StringToFrameworkLegacy(ctx, v.IpAddress)
+		attributes["destination_port"] = flex.Int64ToFrameworkLegacy(ctx, v.Port)
+	}
+
+	return types.ObjectValueMust(portMappingAttributeTypes, attributes)
+}
+
+func (d *dataSourceCustomRoutingPortMappings) flattenPortMappingsFramework(ctx context.Context, apiObjects []*globalaccelerator.PortMapping) types.List {
+	elementType := types.ObjectType{AttrTypes: portMappingAttributeTypes}
+	var elements []attr.Value
+
+	for _, apiObject := range apiObjects {
+		elements = append(elements, d.flattenPortMappingFramework(ctx, apiObject))
+	}
+
+	return types.ListValueMust(elementType, elements)
+}
+
+type dataSourceCustomRoutingPortMappingsData struct {
+	AcceleratorARN   fwtypes.ARN  `tfsdk:"accelerator_arn"`
+	EndpointGroupARN fwtypes.ARN  `tfsdk:"endpoint_group_arn"`
+	EndpointID       types.String `tfsdk:"endpoint_id"`
+	ID               types.String `tfsdk:"id"`
+	PortMappings     types.List   `tfsdk:"port_mappings"`
+}
@@ -0,0 +1,49 @@
+package globalaccelerator_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingPortMappingsDataSource_basic(t *testing.T) {
+	// Requires an existing custom routing accelerator with a subnet endpoint.
+	key := "GLOBALACCELERATOR_CUSTOM_ROUTING_ACCELERATOR_ARN"
+	acceleratorARN := os.Getenv(key)
+	if acceleratorARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	dataSourceName := "data.aws_globalaccelerator_custom_routing_port_mappings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingPortMappingsDataSourceConfig_basic(acceleratorARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accelerator_arn", acceleratorARN),
+					resource.TestCheckResourceAttr(dataSourceName, "id", acceleratorARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "port_mappings.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "port_mappings.0.accelerator_port"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "port_mappings.0.destination_ip_address"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "port_mappings.0.destination_port"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "port_mappings.0.endpoint_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomRoutingPortMappingsDataSourceConfig_basic(acceleratorARN string) string {
+	return fmt.Sprintf(`
+data "aws_globalaccelerator_custom_routing_port_mappings" "test" {
+  accelerator_arn = %[1]q
+}
+`, acceleratorARN)
+}
@@ -0,0 +1,398 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidAccountID},
+				RequiredWith: []string{"organization"},
+			},
+			"event_status_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(health.EventStatusCode_Values(), false),
+				},
+			},
+			"event_type_categories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(health.EventTypeCategory_Values(), false),
+				},
+			},
+			"event_type_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"affected_account_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"affected_entities": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"entity_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"entity_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_updated_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_scope_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"include_affected_entities": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"organization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthConn
+
+	var events []interface{}
+	var err error
+
+	if d.Get("organization").(bool) {
+		events, err = readEventsForOrganization(ctx, conn, d)
+	} else {
+		events, err = readEvents(ctx, conn, d)
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Health Events: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	if err := d.Set("events", events); err != nil {
+		return diag.Errorf("setting events: %s", err)
+	}
+
+	return nil
+}
+
+func readEvents(ctx context.Context, conn *health.Health, d *schema.ResourceData) ([]interface{}, error) {
+	filter := &health.EventFilter{}
+
+	if v, ok := d.GetOk("event_status_codes"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventStatusCodes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_type_categories"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventTypeCategories = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_type_codes"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventTypeCodes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("regions"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Regions = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("services"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Services = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	events, err := FindEvents(ctx, conn, &health.DescribeEventsInput{Filter: filter})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var affectedEntities map[string][]*health.AffectedEntity
+
+	if d.Get("include_affected_entities").(bool) && len(events) > 0 {
+		var eventARNs []string
+		for _, v := range events {
+			eventARNs = append(eventARNs, aws.StringValue(v.Arn))
+		}
+
+		affectedEntities, err = findAffectedEntitiesByEventARNs(ctx, conn, eventARNs)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var tfList []interface{}
+
+	for _, v := range events {
+		tfMap := flattenEvent(v)
+		tfMap["affected_entities"] = flattenAffectedEntities(affectedEntities[aws.StringValue(v.Arn)])
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
+
+func readEventsForOrganization(ctx context.Context, conn *health.Health, d *schema.ResourceData) ([]interface{}, error) {
+	filter := &health.OrganizationEventFilter{}
+
+	if v, ok := d.GetOk("account_ids"); ok && v.(*schema.Set).Len() > 0 {
+		filter.AwsAccountIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_status_codes"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventStatusCodes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_type_categories"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventTypeCategories = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_type_codes"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventTypeCodes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("regions"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Regions = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("services"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Services = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	events, err := FindEventsForOrganization(ctx, conn, &health.DescribeEventsForOrganizationInput{Filter: filter})
+
+	if err != nil {
+		return nil, err
+	}
+
+	includeAffectedEntities := d.Get("include_affected_entities").(bool)
+	var entityFilters []*health.EventAccountFilter
+	var tfList []interface{}
+
+	for _, v := range events {
+		eventARN := aws.StringValue(v.Arn)
+		accountIDs, err := findAffectedAccountsForOrganizationByEventARN(ctx, conn, eventARN)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if includeAffectedEntities {
+			for _, accountID := range accountIDs {
+				entityFilters = append(entityFilters, &health.EventAccountFilter{
+					AwsAccountId: aws.String(accountID),
+					EventArn:     aws.String(eventARN),
+				})
+			}
+		}
+
+		tfMap := flattenOrganizationEvent(v)
+		tfMap["affected_account_ids"] = accountIDs
+
+		tfList = append(tfList, tfMap)
+	}
+
+	if len(entityFilters) > 0 {
+		affectedEntities, err := findAffectedEntitiesForOrganization(ctx, conn, entityFilters)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tfMapRaw := range tfList {
+			tfMap := tfMapRaw.(map[string]interface{})
+			tfMap["affected_entities"] = flattenAffectedEntities(affectedEntities[tfMap["arn"].(string)])
+		}
+	}
+
+	return tfList, nil
+}
+
+func flattenEvent(apiObject *health.Event) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                 aws.StringValue(apiObject.Arn),
+		"availability_zone":   aws.StringValue(apiObject.AvailabilityZone),
+		"event_scope_code":    aws.StringValue(apiObject.EventScopeCode),
+		"event_type_category": aws.StringValue(apiObject.EventTypeCategory),
+		"event_type_code":     aws.StringValue(apiObject.EventTypeCode),
+		"region":              aws.StringValue(apiObject.Region),
+		"service":             aws.StringValue(apiObject.Service),
+		"status_code":         aws.StringValue(apiObject.StatusCode),
+	}
+
+	if v := apiObject.EndTime; v != nil {
+		tfMap["end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.LastUpdatedTime; v != nil {
+		tfMap["last_updated_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.StartTime; v != nil {
+		tfMap["start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenOrganizationEvent(apiObject *health.OrganizationEvent) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                 aws.StringValue(apiObject.Arn),
+		"event_scope_code":    aws.StringValue(apiObject.EventScopeCode),
+		"event_type_category": aws.StringValue(apiObject.EventTypeCategory),
+		"event_type_code":     aws.StringValue(apiObject.EventTypeCode),
+		"region":              aws.StringValue(apiObject.Region),
+		"service":             aws.StringValue(apiObject.Service),
+		"status_code":         aws.StringValue(apiObject.StatusCode),
+	}
+
+	if v := apiObject.EndTime; v != nil {
+		tfMap["end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.LastUpdatedTime; v != nil {
+		tfMap["last_updated_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.StartTime; v != nil {
+		tfMap["start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenAffectedEntities(apiObjects []*health.AffectedEntity) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"account_id":   aws.StringValue(apiObject.AwsAccountId),
+			"entity_arn":   aws.StringValue(apiObject.EntityArn),
+			"entity_value": aws.StringValue(apiObject.EntityValue),
+			"status_code":  aws.StringValue(apiObject.StatusCode),
+		}
+
+		if v := apiObject.LastUpdatedTime; v != nil {
+			tfMap["last_updated_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,109 @@
+package health_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfhealth "github.com/hashicorp/terraform-provider-aws/internal/service/health"
+)
+
+func TestAccHealthEventsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_health_events.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, health.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHealthEventsDataSource_affectedEntities(t *testing.T) {
+	dataSourceName := "data.aws_health_events.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, health.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventsDataSourceConfig_affectedEntities,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHealthEventsDataSource_organization(t *testing.T) {
+	dataSourceName := "data.aws_health_events.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, health.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventsDataSourceConfig_organization,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.#"),
+				),
+			},
+		},
+	})
+}
+
+// The AWS Health API requires a Business, Enterprise On-Ramp or Enterprise Support plan.
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).HealthConn
+
+	_, err := tfhealth.FindEvents(context.Background(), conn, &health.DescribeEventsInput{})
+
+	if acctest.PreCheckSkipError(err) || tfawserr.ErrCodeEquals(err, "SubscriptionRequiredException") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+const testAccEventsDataSourceConfig_basic = `
+data "aws_health_events" "test" {
+  event_type_categories = ["scheduledChange", "issue"]
+  services              = ["EC2", "RDS"]
+}
+`
+
+const testAccEventsDataSourceConfig_affectedEntities = `
+data "aws_health_events" "test" {
+  event_status_codes        = ["open", "upcoming"]
+  event_type_categories     = ["scheduledChange"]
+  include_affected_entities = true
+}
+`
+
+const testAccEventsDataSourceConfig_organization = `
+data "aws_health_events" "test" {
+  organization              = true
+  event_type_categories     = ["scheduledChange"]
+  include_affected_entities = true
+}
+`
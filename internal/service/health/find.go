@@ -0,0 +1,159 @@
+package health
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/health"
+)
+
+// affectedEntitiesFilterMaxItems is the maximum number of event ARNs, or event ARN and account ID pairs,
+// in a single affected entities request.
+const affectedEntitiesFilterMaxItems = 10
+
+func FindEvents(ctx context.Context, conn *health.Health, input *health.DescribeEventsInput) ([]*health.Event, error) {
+	var output []*health.Event
+
+	err := conn.DescribeEventsPagesWithContext(ctx, input, func(page *health.DescribeEventsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Events {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindEventsForOrganization(ctx context.Context, conn *health.Health, input *health.DescribeEventsForOrganizationInput) ([]*health.OrganizationEvent, error) {
+	var output []*health.OrganizationEvent
+
+	err := conn.DescribeEventsForOrganizationPagesWithContext(ctx, input, func(page *health.DescribeEventsForOrganizationOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Events {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAffectedAccountsForOrganizationByEventARN(ctx context.Context, conn *health.Health, eventARN string) ([]string, error) {
+	input := &health.DescribeAffectedAccountsForOrganizationInput{
+		EventArn: aws.String(eventARN),
+	}
+	var output []string
+
+	err := conn.DescribeAffectedAccountsForOrganizationPagesWithContext(ctx, input, func(page *health.DescribeAffectedAccountsForOrganizationOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.AffectedAccounts)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findAffectedEntitiesByEventARNs returns the entities affected by the specified events, keyed by event ARN.
+func findAffectedEntitiesByEventARNs(ctx context.Context, conn *health.Health, eventARNs []string) (map[string][]*health.AffectedEntity, error) {
+	output := make(map[string][]*health.AffectedEntity)
+
+	for i := 0; i < len(eventARNs); i += affectedEntitiesFilterMaxItems {
+		j := i + affectedEntitiesFilterMaxItems
+		if j > len(eventARNs) {
+			j = len(eventARNs)
+		}
+
+		input := &health.DescribeAffectedEntitiesInput{
+			Filter: &health.EntityFilter{
+				EventArns: aws.StringSlice(eventARNs[i:j]),
+			},
+		}
+
+		err := conn.DescribeAffectedEntitiesPagesWithContext(ctx, input, func(page *health.DescribeAffectedEntitiesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Entities {
+				if v != nil {
+					eventARN := aws.StringValue(v.EventArn)
+					output[eventARN] = append(output[eventARN], v)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// findAffectedEntitiesForOrganization returns the entities affected by the specified event and account pairs, keyed by event ARN.
+func findAffectedEntitiesForOrganization(ctx context.Context, conn *health.Health, filters []*health.EventAccountFilter) (map[string][]*health.AffectedEntity, error) {
+	output := make(map[string][]*health.AffectedEntity)
+
+	for i := 0; i < len(filters); i += affectedEntitiesFilterMaxItems {
+		j := i + affectedEntitiesFilterMaxItems
+		if j > len(filters) {
+			j = len(filters)
+		}
+
+		input := &health.DescribeAffectedEntitiesForOrganizationInput{
+			OrganizationEntityFilters: filters[i:j],
+		}
+
+		err := conn.DescribeAffectedEntitiesForOrganizationPagesWithContext(ctx, input, func(page *health.DescribeAffectedEntitiesForOrganizationOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Entities {
+				if v != nil {
+					eventARN := aws.StringValue(v.EventArn)
+					output[eventARN] = append(output[eventARN], v)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,336 @@
+package healthlake
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFHIRDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFHIRDatastoreCreate,
+		ReadWithoutTimeout:   resourceFHIRDatastoreRead,
+		UpdateWithoutTimeout: resourceFHIRDatastoreUpdate,
+		DeleteWithoutTimeout: resourceFHIRDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`), "must contain only letters, numbers, spaces and the characters _.:/=+-@"),
+				),
+			},
+			"datastore_type_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(healthlake.FHIRVersion_Values(), false),
+			},
+			"preload_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preload_data_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(healthlake.PreloadDataType_Values(), false),
+						},
+					},
+				},
+			},
+			"sse_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_encryption_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cmk_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(healthlake.CmkType_Values(), false),
+									},
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFHIRDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &healthlake.CreateFHIRDatastoreInput{
+		ClientToken:          aws.String(resource.UniqueId()),
+		DatastoreTypeVersion: aws.String(d.Get("datastore_type_version").(string)),
+	}
+
+	if v, ok := d.GetOk("datastore_name"); ok {
+		input.DatastoreName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preload_data_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PreloadDataConfig = expandPreloadDataConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sse_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SseConfiguration = expandSSEConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateFHIRDatastoreWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating HealthLake FHIR Datastore: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DatastoreId))
+
+	if _, err := waitFHIRDatastoreCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for HealthLake FHIR Datastore (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceFHIRDatastoreRead(ctx, d, meta)
+}
+
+func resourceFHIRDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindFHIRDatastoreByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] HealthLake FHIR Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading HealthLake FHIR Datastore (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.DatastoreArn)
+	d.Set("arn", arn)
+	if output.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("datastore_endpoint", output.DatastoreEndpoint)
+	d.Set("datastore_name", output.DatastoreName)
+	d.Set("datastore_type_version", output.DatastoreTypeVersion)
+	if output.PreloadDataConfig != nil {
+		if err := d.Set("preload_data_config", []interface{}{flattenPreloadDataConfig(output.PreloadDataConfig)}); err != nil {
+			return diag.Errorf("setting preload_data_config: %s", err)
+		}
+	} else {
+		d.Set("preload_data_config", nil)
+	}
+	if output.SseConfiguration != nil {
+		if err := d.Set("sse_configuration", []interface{}{flattenSSEConfiguration(output.SseConfiguration)}); err != nil {
+			return diag.Errorf("setting sse_configuration: %s", err)
+		}
+	} else {
+		d.Set("sse_configuration", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for HealthLake FHIR Datastore (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFHIRDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating HealthLake FHIR Datastore (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFHIRDatastoreRead(ctx, d, meta)
+}
+
+func resourceFHIRDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+
+	log.Printf("[DEBUG] Deleting HealthLake FHIR Datastore: %s", d.Id())
+	_, err := conn.DeleteFHIRDatastoreWithContext(ctx, &healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, healthlake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting HealthLake FHIR Datastore (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for HealthLake FHIR Datastore (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPreloadDataConfig(tfMap map[string]interface{}) *healthlake.PreloadDataConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &healthlake.PreloadDataConfig{
+		PreloadDataType: aws.String(tfMap["preload_data_type"].(string)),
+	}
+}
+
+func flattenPreloadDataConfig(apiObject *healthlake.PreloadDataConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"preload_data_type": aws.StringValue(apiObject.PreloadDataType),
+	}
+}
+
+func expandSSEConfiguration(tfMap map[string]interface{}) *healthlake.SseConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.SseConfiguration{}
+
+	if v, ok := tfMap["kms_encryption_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KmsEncryptionConfig = expandKMSEncryptionConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandKMSEncryptionConfig(tfMap map[string]interface{}) *healthlake.KmsEncryptionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.KmsEncryptionConfig{
+		CmkType: aws.String(tfMap["cmk_type"].(string)),
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSSEConfiguration(apiObject *healthlake.SseConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsEncryptionConfig; v != nil {
+		tfMap["kms_encryption_config"] = []interface{}{flattenKMSEncryptionConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenKMSEncryptionConfig(apiObject *healthlake.KmsEncryptionConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"cmk_type":   aws.StringValue(apiObject.CmkType),
+		"kms_key_id": aws.StringValue(apiObject.KmsKeyId),
+	}
+}
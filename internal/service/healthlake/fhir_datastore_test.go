@@ -0,0 +1,197 @@
+package healthlake_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfhealthlake "github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccHealthLakeFHIRDatastore_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, healthlake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "healthlake", regexp.MustCompile(`datastore/fhir/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "datastore_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "datastore_type_version", "R4"),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.0.kms_encryption_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.0.kms_encryption_config.0.cmk_type", "AWS_OWNED_KMS_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, healthlake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfhealthlake.ResourceFHIRDatastore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, healthlake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFHIRDatastoreConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFHIRDatastoreConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRDatastoreExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No HealthLake FHIR Datastore ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeConn
+
+		_, err := tfhealthlake.FindFHIRDatastoreByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckFHIRDatastoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_healthlake_fhir_datastore" {
+			continue
+		}
+
+		_, err := tfhealthlake.FindFHIRDatastoreByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("HealthLake FHIR Datastore %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFHIRDatastoreConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  datastore_name         = %[1]q
+  datastore_type_version = "R4"
+}
+`, rName)
+}
+
+func testAccFHIRDatastoreConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  datastore_name         = %[1]q
+  datastore_type_version = "R4"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFHIRDatastoreConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  datastore_name         = %[1]q
+  datastore_type_version = "R4"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,259 @@
+package healthlake
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFHIRImportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFHIRImportJobCreate,
+		ReadWithoutTimeout:   resourceFHIRImportJobRead,
+		// Import jobs cannot be deleted; removing the resource only removes it from state.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"datastore_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input_s3_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI"),
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"job_output_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"s3_uri": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"submit_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFHIRImportJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+
+	datastoreID := d.Get("datastore_id").(string)
+	input := &healthlake.StartFHIRImportJobInput{
+		ClientToken:       aws.String(resource.UniqueId()),
+		DataAccessRoleArn: aws.String(d.Get("data_access_role_arn").(string)),
+		DatastoreId:       aws.String(datastoreID),
+		InputDataConfig: &healthlake.InputDataConfig{
+			S3Uri: aws.String(d.Get("input_s3_uri").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("job_name"); ok {
+		input.JobName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("job_output_data_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.JobOutputDataConfig = expandOutputDataConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.StartFHIRImportJobWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("starting HealthLake FHIR Import Job (%s): %s", datastoreID, err)
+	}
+
+	d.SetId(FHIRImportJobCreateResourceID(aws.StringValue(output.DatastoreId), aws.StringValue(output.JobId)))
+
+	if _, err := waitFHIRImportJobCompleted(ctx, conn, aws.StringValue(output.DatastoreId), aws.StringValue(output.JobId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for HealthLake FHIR Import Job (%s) complete: %s", d.Id(), err)
+	}
+
+	return resourceFHIRImportJobRead(ctx, d, meta)
+}
+
+func resourceFHIRImportJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+
+	datastoreID, jobID, err := FHIRImportJobParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindFHIRImportJobByTwoPartKey(ctx, conn, datastoreID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] HealthLake FHIR Import Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading HealthLake FHIR Import Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("data_access_role_arn", output.DataAccessRoleArn)
+	d.Set("datastore_id", output.DatastoreId)
+	if output.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(output.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	if output.InputDataConfig != nil {
+		d.Set("input_s3_uri", output.InputDataConfig.S3Uri)
+	} else {
+		d.Set("input_s3_uri", nil)
+	}
+	d.Set("job_id", output.JobId)
+	d.Set("job_name", output.JobName)
+	if output.JobOutputDataConfig != nil {
+		if err := d.Set("job_output_data_config", []interface{}{flattenOutputDataConfig(output.JobOutputDataConfig)}); err != nil {
+			return diag.Errorf("setting job_output_data_config: %s", err)
+		}
+	} else {
+		d.Set("job_output_data_config", nil)
+	}
+	d.Set("job_status", output.JobStatus)
+	d.Set("message", output.Message)
+	if output.SubmitTime != nil {
+		d.Set("submit_time", aws.TimeValue(output.SubmitTime).Format(time.RFC3339))
+	} else {
+		d.Set("submit_time", nil)
+	}
+
+	return nil
+}
+
+const fhirImportJobResourceIDSeparator = "/"
+
+func FHIRImportJobCreateResourceID(datastoreID, jobID string) string {
+	parts := []string{datastoreID, jobID}
+	id := strings.Join(parts, fhirImportJobResourceIDSeparator)
+
+	return id
+}
+
+func FHIRImportJobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, fhirImportJobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected datastore-id%[2]sjob-id", id, fhirImportJobResourceIDSeparator)
+}
+
+func expandOutputDataConfig(tfMap map[string]interface{}) *healthlake.OutputDataConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.OutputDataConfig{}
+
+	if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3Configuration = &healthlake.S3Configuration{
+			KmsKeyId: aws.String(tfMap["kms_key_id"].(string)),
+			S3Uri:    aws.String(tfMap["s3_uri"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenOutputDataConfig(apiObject *healthlake.OutputDataConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Configuration; v != nil {
+		tfMap["s3_configuration"] = []interface{}{map[string]interface{}{
+			"kms_key_id": aws.StringValue(v.KmsKeyId),
+			"s3_uri":     aws.StringValue(v.S3Uri),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,150 @@
+package healthlake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfhealthlake "github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
+)
+
+func TestAccHealthLakeFHIRImportJob_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_import_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, healthlake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRImportJobConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRImportJobExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "data_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "datastore_id", "aws_healthlake_fhir_datastore.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "job_output_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_output_data_config.0.s3_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "job_output_data_config.0.s3_configuration.0.kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "job_status", "COMPLETED"),
+					resource.TestCheckResourceAttrSet(resourceName, "submit_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRImportJobExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No HealthLake FHIR Import Job ID is set")
+		}
+
+		datastoreID, jobID, err := tfhealthlake.FHIRImportJobParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeConn
+
+		_, err = tfhealthlake.FindFHIRImportJobByTwoPartKey(context.Background(), conn, datastoreID, jobID)
+
+		return err
+	}
+}
+
+func testAccFHIRImportJobConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  datastore_name         = %[1]q
+  datastore_type_version = "R4"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "input/patient.ndjson"
+  content = jsonencode({ resourceType = "Patient", id = "example", active = true })
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "healthlake.amazonaws.com" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["s3:GetObject", "s3:ListBucket", "s3:PutObject", "s3:GetBucketPublicAccessBlock", "s3:GetEncryptionConfiguration"]
+        Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["kms:DescribeKey", "kms:GenerateDataKey"]
+        Resource = aws_kms_key.test.arn
+      },
+    ]
+  })
+}
+
+resource "aws_healthlake_fhir_import_job" "test" {
+  datastore_id         = aws_healthlake_fhir_datastore.test.id
+  data_access_role_arn = aws_iam_role.test.arn
+  input_s3_uri         = "s3://${aws_s3_bucket.test.id}/input/"
+  job_name             = %[1]q
+
+  job_output_data_config {
+    s3_configuration {
+      kms_key_id = aws_kms_key.test.arn
+      s3_uri     = "s3://${aws_s3_bucket.test.id}/output/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+`, rName)
+}
@@ -0,0 +1,69 @@
+package healthlake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindFHIRDatastoreByID(ctx context.Context, conn *healthlake.HealthLake, id string) (*healthlake.DatastoreProperties, error) {
+	input := &healthlake.DescribeFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+
+	output, err := conn.DescribeFHIRDatastoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, healthlake.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DatastoreProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.DatastoreProperties.DatastoreStatus); status == healthlake.DatastoreStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.DatastoreProperties, nil
+}
+
+func FindFHIRImportJobByTwoPartKey(ctx context.Context, conn *healthlake.HealthLake, datastoreID, jobID string) (*healthlake.ImportJobProperties, error) {
+	input := &healthlake.DescribeFHIRImportJobInput{
+		DatastoreId: aws.String(datastoreID),
+		JobId:       aws.String(jobID),
+	}
+
+	output, err := conn.DescribeFHIRImportJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, healthlake.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImportJobProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ImportJobProperties, nil
+}
@@ -0,0 +1,42 @@
+package healthlake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusFHIRDatastore(ctx context.Context, conn *healthlake.HealthLake, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFHIRDatastoreByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DatastoreStatus), nil
+	}
+}
+
+func statusFHIRImportJob(ctx context.Context, conn *healthlake.HealthLake, datastoreID, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFHIRImportJobByTwoPartKey(ctx, conn, datastoreID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.JobStatus), nil
+	}
+}
@@ -0,0 +1,101 @@
+// Code generated by internal/generate/tags/main.go; DO NOT EDIT.
+package healthlake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/aws/aws-sdk-go/service/healthlake/healthlakeiface"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ListTags lists healthlake service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ListTags(conn healthlakeiface.HealthLakeAPI, identifier string) (tftags.KeyValueTags, error) {
+	return ListTagsWithContext(context.Background(), conn, identifier)
+}
+
+func ListTagsWithContext(ctx context.Context, conn healthlakeiface.HealthLakeAPI, identifier string) (tftags.KeyValueTags, error) {
+	input := &healthlake.ListTagsForResourceInput{
+		ResourceARN: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResourceWithContext(ctx, input)
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(output.Tags), nil
+}
+
+// []*SERVICE.Tag handling
+
+// Tags returns healthlake service tags.
+func Tags(tags tftags.KeyValueTags) []*healthlake.Tag {
+	result := make([]*healthlake.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &healthlake.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// KeyValueTags creates tftags.KeyValueTags from healthlake service tags.
+func KeyValueTags(tags []*healthlake.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(m)
+}
+
+// UpdateTags updates healthlake service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func UpdateTags(conn healthlakeiface.HealthLakeAPI, identifier string, oldTags interface{}, newTags interface{}) error {
+	return UpdateTagsWithContext(context.Background(), conn, identifier, oldTags, newTags)
+}
+func UpdateTagsWithContext(ctx context.Context, conn healthlakeiface.HealthLakeAPI, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &healthlake.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.IgnoreAWS().Keys()),
+		}
+
+		_, err := conn.UntagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &healthlake.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        Tags(updatedTags.IgnoreAWS()),
+		}
+
+		_, err := conn.TagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitFHIRDatastoreCreated(ctx context.Context, conn *healthlake.HealthLake, id string, timeout time.Duration) (*healthlake.DatastoreProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.DatastoreStatusCreating},
+		Target:  []string{healthlake.DatastoreStatusActive},
+		Refresh: statusFHIRDatastore(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*healthlake.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRDatastoreDeleted(ctx context.Context, conn *healthlake.HealthLake, id string, timeout time.Duration) (*healthlake.DatastoreProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.DatastoreStatusActive, healthlake.DatastoreStatusDeleting},
+		Target:  []string{},
+		Refresh: statusFHIRDatastore(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*healthlake.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRImportJobCompleted(ctx context.Context, conn *healthlake.HealthLake, datastoreID, jobID string, timeout time.Duration) (*healthlake.ImportJobProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.JobStatusSubmitted, healthlake.JobStatusInProgress},
+		Target:  []string{healthlake.JobStatusCompleted, healthlake.JobStatusCompletedWithErrors},
+		Refresh: statusFHIRImportJob(ctx, conn, datastoreID, jobID),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*healthlake.ImportJobProperties); ok {
+		if status := aws.StringValue(output.JobStatus); status == healthlake.JobStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -25,13 +27,19 @@ func DataSourcePolicyDocument() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Read: dataSourcePolicyDocumentRead,
+		ReadWithoutTimeout: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      managedPolicyMaxSize,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"override_json": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -50,6 +58,10 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"source_json": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -64,6 +76,11 @@ func DataSourcePolicyDocument() *schema.Resource {
 					ValidateFunc: validation.StringIsJSON,
 				},
 			},
+			"split_json": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"statement": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -111,6 +128,11 @@ func DataSourcePolicyDocument() *schema.Resource {
 					},
 				},
 			},
+			"strict_merge": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -124,12 +146,15 @@ func DataSourcePolicyDocument() *schema.Resource {
 	}
 }
 
-func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	strictMerge := d.Get("strict_merge").(bool)
+
 	mergedDoc := &IAMPolicyDoc{}
 
 	if v, ok := d.GetOk("source_json"); ok {
 		if err := json.Unmarshal([]byte(v.(string)), mergedDoc); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -150,14 +175,14 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 
 			sourceDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			// assure all statements in sourceDoc are unique before merging
 			for stmtIndex, stmt := range sourceDoc.Statements {
 				if stmt.Sid != "" {
 					if _, sidExists := sidMap[stmt.Sid]; sidExists {
-						return fmt.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
+						return diag.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
 					}
 					sidMap[stmt.Sid] = struct{}{}
 				}
@@ -189,7 +214,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 
 			if sid, ok := cfgStmt["sid"]; ok {
 				if _, ok := sidMap[sid.(string)]; ok {
-					return fmt.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
+					return diag.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
 				}
 				stmt.Sid = sid.(string)
 				if len(stmt.Sid) > 0 {
@@ -210,7 +235,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 					policyDecodeConfigStringList(resources), doc.Version,
 				)
 				if err != nil {
-					return fmt.Errorf("error reading resources: %w", err)
+					return diag.Errorf("error reading resources: %s", err)
 				}
 			}
 			if notResources := cfgStmt["not_resources"].(*schema.Set).List(); len(notResources) > 0 {
@@ -219,7 +244,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 					policyDecodeConfigStringList(notResources), doc.Version,
 				)
 				if err != nil {
-					return fmt.Errorf("error reading not_resources: %w", err)
+					return diag.Errorf("error reading not_resources: %s", err)
 				}
 			}
 
@@ -227,7 +252,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				var err error
 				stmt.Principals, err = dataSourcePolicyDocumentMakePrincipals(principals, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading principals: %w", err)
+					return diag.Errorf("error reading principals: %s", err)
 				}
 			}
 
@@ -235,7 +260,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				var err error
 				stmt.NotPrincipals, err = dataSourcePolicyDocumentMakePrincipals(notPrincipals, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading not_principals: %w", err)
+					return diag.Errorf("error reading not_principals: %s", err)
 				}
 			}
 
@@ -243,7 +268,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				var err error
 				stmt.Conditions, err = dataSourcePolicyDocumentMakeConditions(conditions, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading condition: %w", err)
+					return diag.Errorf("error reading condition: %s", err)
 				}
 			}
 
@@ -254,6 +279,13 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// merge our current document into mergedDoc
+	// override_json and override_policy_documents still replace statements by Sid
+	if strictMerge {
+		if sid, ok := mergedDoc.conflictingSid(doc); ok {
+			return diag.Errorf("duplicate Sid (%s) in statement and source_policy_documents. Remove the Sid or ensure Sids are unique, or disable strict_merge.", sid)
+		}
+	}
+
 	mergedDoc.Merge(doc)
 
 	// merge override_policy_documents policies into mergedDoc in order specified
@@ -264,7 +296,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 			}
 			overrideDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			mergedDoc.Merge(overrideDoc)
@@ -275,7 +307,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	if v, ok := d.GetOk("override_json"); ok {
 		overrideDoc := &IAMPolicyDoc{}
 		if err := json.Unmarshal([]byte(v.(string)), overrideDoc); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		mergedDoc.Merge(overrideDoc)
@@ -284,14 +316,49 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
-		return err
+		return diag.FromErr(err)
 	}
 	jsonString := string(jsonDoc)
 
 	d.Set("json", jsonString)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
-	return nil
+	size, err := mergedDoc.size()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("size", size)
+
+	maxSize := d.Get("max_size").(int)
+
+	if size > maxSize {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "IAM policy document exceeds size limit",
+			Detail:   fmt.Sprintf("The rendered policy document is %d characters (excluding whitespace), which exceeds the maximum size of %d characters. Use the split_json attribute to obtain multiple policy documents within the limit.", size, maxSize),
+		})
+	}
+
+	splitDocs, err := mergedDoc.split(maxSize)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var splitJSON []string
+
+	for _, splitDoc := range splitDocs {
+		jsonDoc, err := json.MarshalIndent(splitDoc, "", "  ")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		splitJSON = append(splitJSON, string(jsonDoc))
+	}
+
+	d.Set("split_json", splitJSON)
+
+	return diags
 }
 
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
@@ -421,6 +421,55 @@ func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_strictMerge(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_strictMergeSource,
+				ExpectError: regexp.MustCompile(`duplicate Sid \(validSid\) in statement and source_policy_documents`),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_strictMergeOverride,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON("data.aws_iam_policy_document.test", "json", testAccPolicyDocumentStrictMergeOverrideExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_split(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_split(6144),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "size", "198"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "split_json.0", dataSourceName, "json"),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_split(120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "size", "198"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "2"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "split_json.0", testAccPolicyDocumentSplitExpectedJSON("statementOne", "foo:ActionOne")),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "split_json.1", testAccPolicyDocumentSplitExpectedJSON("statementTwo", "foo:ActionTwo")),
+				),
+			},
+		},
+	})
+}
+
 var testAccPolicyDocumentDataSourceConfig_basic = `
 data "aws_partition" "current" {}
 
@@ -1543,3 +1592,109 @@ data "aws_iam_policy_document" "test" {
   source_json = "{"
 }
 `
+
+var testAccPolicyDocumentDataSourceConfig_strictMergeSource = `
+data "aws_iam_policy_document" "source" {
+  statement {
+    sid     = "validSid"
+    effect  = "Allow"
+    actions = ["foo:ActionOne"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  strict_merge            = true
+  source_policy_documents = [data.aws_iam_policy_document.source.json]
+
+  statement {
+    sid     = "validSid"
+    effect  = "Deny"
+    actions = ["foo:ActionOne"]
+  }
+}
+`
+
+var testAccPolicyDocumentDataSourceConfig_strictMergeOverride = `
+data "aws_iam_policy_document" "policy_a" {
+  statement {
+    sid     = "validSid"
+    effect  = "Allow"
+    actions = ["foo:ActionOne"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_b" {
+  statement {
+    sid     = "overrideSid"
+    effect  = "Deny"
+    actions = ["bar:ActionOne"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  strict_merge = true
+
+  statement {
+    sid     = "overrideSid"
+    effect  = "Allow"
+    actions = ["bar:ActionOne"]
+  }
+
+  override_policy_documents = [
+    data.aws_iam_policy_document.policy_a.json,
+    data.aws_iam_policy_document.policy_b.json,
+  ]
+}
+`
+
+var testAccPolicyDocumentStrictMergeOverrideExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "overrideSid",
+      "Effect": "Deny",
+      "Action": "bar:ActionOne"
+    },
+    {
+      "Sid": "validSid",
+      "Effect": "Allow",
+      "Action": "foo:ActionOne"
+    }
+  ]
+}`
+
+func testAccPolicyDocumentDataSourceConfig_split(maxSize int) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  max_size = %[1]d
+
+  statement {
+    sid       = "statementOne"
+    effect    = "Allow"
+    actions   = ["foo:ActionOne"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "statementTwo"
+    effect    = "Allow"
+    actions   = ["foo:ActionTwo"]
+    resources = ["*"]
+  }
+}
+`, maxSize)
+}
+
+func testAccPolicyDocumentSplitExpectedJSON(sid, action string) string {
+	return fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": %[1]q,
+      "Effect": "Allow",
+      "Action": %[2]q,
+      "Resource": "*"
+    }
+  ]
+}`, sid, action)
+}
@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -9,6 +10,9 @@ import (
 
 const (
 	policyModelMarshallJSONStartSliceSize = 2
+
+	// managedPolicyMaxSize is the maximum size, in characters excluding whitespace, of a managed policy document.
+	managedPolicyMaxSize = 6144
 )
 
 type IAMPolicyDoc struct {
@@ -75,6 +79,75 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// conflictingSid returns the first Sid of newDoc's statements that is also the Sid of one of our statements.
+func (s *IAMPolicyDoc) conflictingSid(newDoc *IAMPolicyDoc) (string, bool) {
+	sids := make(map[string]struct{})
+	for _, statement := range s.Statements {
+		if len(statement.Sid) > 0 {
+			sids[statement.Sid] = struct{}{}
+		}
+	}
+
+	for _, newStatement := range newDoc.Statements {
+		if _, ok := sids[newStatement.Sid]; ok {
+			return newStatement.Sid, true
+		}
+	}
+
+	return "", false
+}
+
+// size returns the size of the policy document in characters, excluding whitespace.
+func (s *IAMPolicyDoc) size() (int, error) {
+	b, err := marshalJSONNoEscapeHTML(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// marshalJSONNoEscapeHTML returns the JSON encoding of v without escaping the HTML characters <, > and &.
+// IAM counts each of these as one character, not as a 6-character escape sequence.
+func marshalJSONNoEscapeHTML(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// split splits the policy document's statements, in order, into as few policy documents as possible
+// with each document no larger than maxSize.
+// A statement that is too large to fit in a document on its own is placed in its own document.
+func (s *IAMPolicyDoc) split(maxSize int) ([]*IAMPolicyDoc, error) {
+	var docs []*IAMPolicyDoc
+	current := &IAMPolicyDoc{Version: s.Version, Id: s.Id}
+
+	for _, statement := range s.Statements {
+		current.Statements = append(current.Statements, statement)
+
+		size, err := current.size()
+		if err != nil {
+			return nil, err
+		}
+
+		if size > maxSize && len(current.Statements) > 1 {
+			current.Statements = current.Statements[:len(current.Statements)-1]
+			docs = append(docs, current)
+			current = &IAMPolicyDoc{Version: s.Version, Id: s.Id, Statements: []*IAMPolicyStatement{statement}}
+		}
+	}
+
+	docs = append(docs, current)
+
+	return docs, nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -126,7 +199,7 @@ func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 		}
 	}
 
-	return json.Marshal(&raw)
+	return marshalJSONNoEscapeHTML(&raw)
 }
 
 func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
@@ -184,7 +257,7 @@ func (cs IAMPolicyStatementConditionSet) MarshalJSON() ([]byte, error) {
 		}
 	}
 
-	return json.Marshal(&raw)
+	return marshalJSONNoEscapeHTML(&raw)
 }
 
 func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
@@ -0,0 +1,37 @@
+package iam
+
+import (
+	"testing"
+)
+
+func TestIAMPolicyDocSize(t *testing.T) {
+	doc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{
+				Effect:    "Allow",
+				Actions:   "s3:GetObject",
+				Resources: "*",
+				Conditions: IAMPolicyStatementConditionSet{
+					{
+						Test:     "StringLike",
+						Variable: "s3:prefix",
+						Values:   "a&b<c>",
+					},
+				},
+			},
+		},
+	}
+
+	expected := `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringLike":{"s3:prefix":"a&b<c>"}}}]}`
+
+	got, err := doc.size()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != len(expected) {
+		t.Errorf("got %d, expected %d", got, len(expected))
+	}
+}
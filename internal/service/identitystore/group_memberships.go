@@ -0,0 +1,307 @@
+package identitystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameGroupMemberships = "GroupMemberships"
+)
+
+func ResourceGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMembershipsCreate,
+		ReadWithoutTimeout:   resourceGroupMembershipsRead,
+		UpdateWithoutTimeout: resourceGroupMembershipsUpdate,
+		DeleteWithoutTimeout: resourceGroupMembershipsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+
+			"member_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 47),
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreClient
+
+	identityStoreId := d.Get("identity_store_id").(string)
+	groupId := d.Get("group_id").(string)
+	id := fmt.Sprintf("%s/%s", identityStoreId, groupId)
+
+	memberships, err := FindGroupMembershipsByGroupID(ctx, conn, identityStoreId, groupId)
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionCreating, ResNameGroupMemberships, id, err)
+	}
+
+	// Membership is managed exclusively, so existing members that are not in
+	// configuration are removed.
+	var add, remove []string
+
+	want := d.Get("member_ids").(*schema.Set)
+
+	for _, v := range want.List() {
+		if _, ok := memberships[v.(string)]; !ok {
+			add = append(add, v.(string))
+		}
+	}
+
+	for memberId, membershipId := range memberships {
+		if !want.Contains(memberId) {
+			remove = append(remove, membershipId)
+		}
+	}
+
+	if err := addGroupMembers(ctx, conn, identityStoreId, groupId, add); err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionCreating, ResNameGroupMemberships, id, err)
+	}
+
+	if err := removeGroupMemberships(ctx, conn, identityStoreId, remove); err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionCreating, ResNameGroupMemberships, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceGroupMembershipsRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreClient
+
+	identityStoreId, groupId, err := resourceGroupMembershipsParseID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	memberships, err := FindGroupMembershipsByGroupID(ctx, conn, identityStoreId, groupId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IdentityStore GroupMemberships (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	memberIds := make([]string, 0, len(memberships))
+	for memberId := range memberships {
+		memberIds = append(memberIds, memberId)
+	}
+
+	d.Set("group_id", groupId)
+	d.Set("identity_store_id", identityStoreId)
+	d.Set("member_ids", memberIds)
+
+	return nil
+}
+
+func resourceGroupMembershipsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreClient
+
+	identityStoreId := d.Get("identity_store_id").(string)
+	groupId := d.Get("group_id").(string)
+
+	if d.HasChange("member_ids") {
+		memberships, err := FindGroupMembershipsByGroupID(ctx, conn, identityStoreId, groupId)
+
+		if err != nil {
+			return create.DiagError(names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, d.Id(), err)
+		}
+
+		o, n := d.GetChange("member_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		var add, remove []string
+
+		for _, v := range ns.Difference(os).List() {
+			if _, ok := memberships[v.(string)]; !ok {
+				add = append(add, v.(string))
+			}
+		}
+
+		for _, v := range os.Difference(ns).List() {
+			if membershipId, ok := memberships[v.(string)]; ok {
+				remove = append(remove, membershipId)
+			}
+		}
+
+		if err := addGroupMembers(ctx, conn, identityStoreId, groupId, add); err != nil {
+			return create.DiagError(names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, d.Id(), err)
+		}
+
+		if err := removeGroupMemberships(ctx, conn, identityStoreId, remove); err != nil {
+			return create.DiagError(names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, d.Id(), err)
+		}
+	}
+
+	return resourceGroupMembershipsRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreClient
+
+	identityStoreId := d.Get("identity_store_id").(string)
+	groupId := d.Get("group_id").(string)
+
+	memberships, err := FindGroupMembershipsByGroupID(ctx, conn, identityStoreId, groupId)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	var remove []string
+
+	for _, v := range d.Get("member_ids").(*schema.Set).List() {
+		if membershipId, ok := memberships[v.(string)]; ok {
+			remove = append(remove, membershipId)
+		}
+	}
+
+	log.Printf("[INFO] Deleting IdentityStore GroupMemberships %s", d.Id())
+
+	if err := removeGroupMemberships(ctx, conn, identityStoreId, remove); err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	return nil
+}
+
+func addGroupMembers(ctx context.Context, conn *identitystore.Client, identityStoreId, groupId string, memberIds []string) error {
+	for _, memberId := range memberIds {
+		_, err := conn.CreateGroupMembership(ctx, &identitystore.CreateGroupMembershipInput{
+			GroupId:         aws.String(groupId),
+			IdentityStoreId: aws.String(identityStoreId),
+			MemberId:        &types.MemberIdMemberUserId{Value: memberId},
+		})
+
+		if err != nil {
+			var ce *types.ConflictException
+			if errors.As(err, &ce) {
+				continue
+			}
+
+			return fmt.Errorf("adding member (%s): %w", memberId, err)
+		}
+	}
+
+	return nil
+}
+
+func removeGroupMemberships(ctx context.Context, conn *identitystore.Client, identityStoreId string, membershipIds []string) error {
+	for _, membershipId := range membershipIds {
+		_, err := conn.DeleteGroupMembership(ctx, &identitystore.DeleteGroupMembershipInput{
+			IdentityStoreId: aws.String(identityStoreId),
+			MembershipId:    aws.String(membershipId),
+		})
+
+		if err != nil {
+			var nfe *types.ResourceNotFoundException
+			if errors.As(err, &nfe) {
+				continue
+			}
+
+			return fmt.Errorf("removing membership (%s): %w", membershipId, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceGroupMembershipsParseID(id string) (identityStoreId, groupId string, err error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err = errors.New("expected a resource id in the form: identity-store-id/group-id")
+		return
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// FindGroupMembershipsByGroupID returns the group's user members as a map of
+// member ID to membership ID.
+func FindGroupMembershipsByGroupID(ctx context.Context, conn *identitystore.Client, identityStoreId, groupId string) (map[string]string, error) {
+	in := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupId),
+		IdentityStoreId: aws.String(identityStoreId),
+	}
+
+	memberships := make(map[string]string)
+	paginator := identitystore.NewListGroupMembershipsPaginator(conn, in)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			var e *types.ResourceNotFoundException
+			if errors.As(err, &e) {
+				return nil, &resource.NotFoundError{
+					LastError:   err,
+					LastRequest: in,
+				}
+			}
+
+			return nil, err
+		}
+
+		for _, v := range page.GroupMemberships {
+			memberId, ok := v.MemberId.(*types.MemberIdMemberUserId)
+
+			if !ok {
+				continue
+			}
+
+			memberships[memberId.Value] = aws.ToString(v.MembershipId)
+		}
+	}
+
+	return memberships, nil
+}
@@ -0,0 +1,154 @@
+package identitystore_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIdentityStoreGroupMemberships_basic(t *testing.T) {
+	groupResourceName := "aws_identitystore_group.test"
+	resourceName := "aws_identitystore_group_memberships.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.IdentityStoreEndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, "[aws_identitystore_user.test[0].user_id, aws_identitystore_user.test[1].user_id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", groupResourceName, "group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_store_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.0", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.1", "user_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, "[aws_identitystore_user.test[1].user_id, aws_identitystore_user.test[2].user_id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.1", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.2", "user_id"),
+				),
+			},
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, "[]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembershipsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_identitystore_group_memberships" {
+			continue
+		}
+
+		memberships, err := tfidentitystore.FindGroupMembershipsByGroupID(ctx, conn, rs.Primary.Attributes["identity_store_id"], rs.Primary.Attributes["group_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(memberships) > 0 {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingDestroyed, tfidentitystore.ResNameGroupMemberships, rs.Primary.ID, errors.New("not destroyed"))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckGroupMembershipsExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient
+		ctx := context.Background()
+
+		_, err := tfidentitystore.FindGroupMembershipsByGroupID(ctx, conn, rs.Primary.Attributes["identity_store_id"], rs.Primary.Attributes["group_id"])
+
+		if err != nil {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccGroupMembershipsConfig_basic(rName, memberIds string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  count = 3
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "%[1]s-${count.index}"
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+
+resource "aws_identitystore_group_memberships" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id          = aws_identitystore_group.test.group_id
+
+  member_ids = %[2]s
+}
+`, rName, memberIds)
+}
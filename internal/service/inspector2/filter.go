@@ -0,0 +1,988 @@
+package inspector2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFilterCreate,
+		ReadWithoutTimeout:   resourceFilterRead,
+		UpdateWithoutTimeout: resourceFilterUpdate,
+		DeleteWithoutTimeout: resourceFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.FilterAction](),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id":                     stringFilterSchema(),
+						"component_id":                       stringFilterSchema(),
+						"component_type":                     stringFilterSchema(),
+						"ec2_instance_image_id":              stringFilterSchema(),
+						"ec2_instance_subnet_id":             stringFilterSchema(),
+						"ec2_instance_vpc_id":                stringFilterSchema(),
+						"ecr_image_architecture":             stringFilterSchema(),
+						"ecr_image_hash":                     stringFilterSchema(),
+						"ecr_image_pushed_at":                dateFilterSchema(),
+						"ecr_image_registry":                 stringFilterSchema(),
+						"ecr_image_repository_name":          stringFilterSchema(),
+						"ecr_image_tags":                     stringFilterSchema(),
+						"exploit_available":                  stringFilterSchema(),
+						"finding_arn":                        stringFilterSchema(),
+						"finding_status":                     stringFilterSchema(),
+						"finding_type":                       stringFilterSchema(),
+						"first_observed_at":                  dateFilterSchema(),
+						"fix_available":                      stringFilterSchema(),
+						"inspector_score":                    numberFilterSchema(),
+						"lambda_function_execution_role_arn": stringFilterSchema(),
+						"lambda_function_last_modified_at":   dateFilterSchema(),
+						"lambda_function_layers":             stringFilterSchema(),
+						"lambda_function_name":               stringFilterSchema(),
+						"lambda_function_runtime":            stringFilterSchema(),
+						"last_observed_at":                   dateFilterSchema(),
+						"network_protocol":                   stringFilterSchema(),
+						"port_range":                         portRangeFilterSchema(),
+						"related_vulnerabilities":            stringFilterSchema(),
+						"resource_id":                        stringFilterSchema(),
+						"resource_tags":                      mapFilterSchema(),
+						"resource_type":                      stringFilterSchema(),
+						"severity":                           stringFilterSchema(),
+						"title":                              stringFilterSchema(),
+						"updated_at":                         dateFilterSchema(),
+						"vendor_severity":                    stringFilterSchema(),
+						"vulnerability_id":                   stringFilterSchema(),
+						"vulnerability_source":               stringFilterSchema(),
+						"vulnerable_packages":                packageFilterSchema(),
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameFilter = "Filter"
+)
+
+func stringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.StringComparison](),
+				},
+				"value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+		},
+	}
+}
+
+func dateFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+				"start_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+			},
+		},
+	}
+}
+
+func numberFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 10,
+		Elem:     numberFilterResource(),
+	}
+}
+
+func numberFilterResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"lower_inclusive": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"upper_inclusive": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func mapFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.MapComparison](),
+				},
+				"key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"value": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 256),
+				},
+			},
+		},
+	}
+}
+
+func portRangeFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"begin_inclusive": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+				"end_inclusive": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+			},
+		},
+	}
+}
+
+func packageFilterSchema() *schema.Schema {
+	nestedStringFilterSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     stringFilterSchema().Elem,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"architecture": nestedStringFilterSchema(),
+				"epoch": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem:     numberFilterResource(),
+				},
+				"name":                    nestedStringFilterSchema(),
+				"release":                 nestedStringFilterSchema(),
+				"source_lambda_layer_arn": nestedStringFilterSchema(),
+				"source_layer_hash":       nestedStringFilterSchema(),
+				"version":                 nestedStringFilterSchema(),
+			},
+		},
+	}
+}
+
+func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Client
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	in := &inspector2.CreateFilterInput{
+		Action:         types.FilterAction(d.Get("action").(string)),
+		FilterCriteria: expandFilterCriteria(d.Get("filter_criteria").([]interface{})),
+		Name:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("reason"); ok {
+		in.Reason = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateFilter(ctx, in)
+
+	if err != nil {
+		return create.DiagError(names.Inspector2, create.ErrActionCreating, ResNameFilter, name, err)
+	}
+
+	d.SetId(aws.ToString(out.Arn))
+
+	return resourceFilterRead(ctx, d, meta)
+}
+
+func resourceFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Client
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	filter, err := FindFilterByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector2 Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Inspector2, create.ErrActionReading, ResNameFilter, d.Id(), err)
+	}
+
+	d.Set("action", filter.Action)
+	d.Set("arn", filter.Arn)
+	d.Set("description", filter.Description)
+	if err := d.Set("filter_criteria", flattenFilterCriteria(filter.Criteria)); err != nil {
+		return create.DiagSettingError(names.Inspector2, ResNameFilter, d.Id(), "filter_criteria", err)
+	}
+	d.Set("name", filter.Name)
+	d.Set("reason", filter.Reason)
+
+	tags := KeyValueTags(filter.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagSettingError(names.Inspector2, ResNameFilter, d.Id(), "tags", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagSettingError(names.Inspector2, ResNameFilter, d.Id(), "tags_all", err)
+	}
+
+	return nil
+}
+
+func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Client
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &inspector2.UpdateFilterInput{
+			FilterArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("action") {
+			in.Action = types.FilterAction(d.Get("action").(string))
+		}
+
+		if d.HasChange("description") {
+			in.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("filter_criteria") {
+			in.FilterCriteria = expandFilterCriteria(d.Get("filter_criteria").([]interface{}))
+		}
+
+		if d.HasChange("name") {
+			in.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("reason") {
+			in.Reason = aws.String(d.Get("reason").(string))
+		}
+
+		_, err := conn.UpdateFilter(ctx, in)
+
+		if err != nil {
+			return create.DiagError(names.Inspector2, create.ErrActionUpdating, ResNameFilter, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.Inspector2, create.ErrActionUpdating, ResNameFilter, d.Id(), err)
+		}
+	}
+
+	return resourceFilterRead(ctx, d, meta)
+}
+
+func resourceFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Client
+
+	log.Printf("[INFO] Deleting Inspector2 Filter: %s", d.Id())
+	_, err := conn.DeleteFilter(ctx, &inspector2.DeleteFilterInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Inspector2, create.ErrActionDeleting, ResNameFilter, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*types.Filter, error) {
+	in := &inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	pages := inspector2.NewListFiltersPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Filters {
+			if aws.ToString(v.Arn) == arn {
+				v := v
+
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+func expandFilterCriteria(tfList []interface{}) *types.FilterCriteria {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &types.FilterCriteria{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.FilterCriteria{}
+
+	if v, ok := tfMap["aws_account_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AwsAccountId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["component_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["component_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_image_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceImageId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_subnet_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceSubnetId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_vpc_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceVpcId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_architecture"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageArchitecture = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_hash"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageHash = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_pushed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImagePushedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_registry"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageRegistry = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_repository_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageRepositoryName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageTags = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["exploit_available"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExploitAvailable = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_status"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingStatus = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["first_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FirstObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["fix_available"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FixAvailable = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["inspector_score"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InspectorScore = expandNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_execution_role_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionExecutionRoleArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_last_modified_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionLastModifiedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_layers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionLayers = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_runtime"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionRuntime = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["last_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LastObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_protocol"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkProtocol = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PortRange = expandPortRangeFilters(v.List())
+	}
+
+	if v, ok := tfMap["related_vulnerabilities"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RelatedVulnerabilities = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceTags = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["severity"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Severity = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["title"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Title = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["updated_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UpdatedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["vendor_severity"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VendorSeverity = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerability_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerabilityId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerability_source"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerabilitySource = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerable_packages"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerablePackages = expandPackageFilters(v.List())
+	}
+
+	return apiObject
+}
+
+func expandStringFilter(tfMap map[string]interface{}) *types.StringFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.StringFilter{}
+
+	if v, ok := tfMap["comparison"].(string); ok && v != "" {
+		apiObject.Comparison = types.StringComparison(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStringFilters(tfList []interface{}) []types.StringFilter {
+	var apiObjects []types.StringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *expandStringFilter(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandDateFilters(tfList []interface{}) []types.DateFilter {
+	var apiObjects []types.DateFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.DateFilter{}
+
+		if v, ok := tfMap["end_inclusive"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.EndInclusive = aws.Time(v)
+		}
+
+		if v, ok := tfMap["start_inclusive"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.StartInclusive = aws.Time(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandNumberFilter(tfMap map[string]interface{}) *types.NumberFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.NumberFilter{}
+
+	if v, ok := tfMap["lower_inclusive"].(float64); ok && v != 0 {
+		apiObject.LowerInclusive = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["upper_inclusive"].(float64); ok && v != 0 {
+		apiObject.UpperInclusive = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandNumberFilters(tfList []interface{}) []types.NumberFilter {
+	var apiObjects []types.NumberFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *expandNumberFilter(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandMapFilters(tfList []interface{}) []types.MapFilter {
+	var apiObjects []types.MapFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.MapFilter{}
+
+		if v, ok := tfMap["comparison"].(string); ok && v != "" {
+			apiObject.Comparison = types.MapComparison(v)
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPortRangeFilters(tfList []interface{}) []types.PortRangeFilter {
+	var apiObjects []types.PortRangeFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PortRangeFilter{}
+
+		if v, ok := tfMap["begin_inclusive"].(int); ok {
+			apiObject.BeginInclusive = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["end_inclusive"].(int); ok {
+			apiObject.EndInclusive = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPackageFilters(tfList []interface{}) []types.PackageFilter {
+	var apiObjects []types.PackageFilter
+
+	nestedStringFilter := func(v interface{}) *types.StringFilter {
+		if v, ok := v.([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			return expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		return nil
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PackageFilter{
+			Architecture:         nestedStringFilter(tfMap["architecture"]),
+			Name:                 nestedStringFilter(tfMap["name"]),
+			Release:              nestedStringFilter(tfMap["release"]),
+			SourceLambdaLayerArn: nestedStringFilter(tfMap["source_lambda_layer_arn"]),
+			SourceLayerHash:      nestedStringFilter(tfMap["source_layer_hash"]),
+			Version:              nestedStringFilter(tfMap["version"]),
+		}
+
+		if v, ok := tfMap["epoch"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Epoch = expandNumberFilter(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFilterCriteria(apiObject *types.FilterCriteria) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"aws_account_id":                     flattenStringFilters(apiObject.AwsAccountId),
+		"component_id":                       flattenStringFilters(apiObject.ComponentId),
+		"component_type":                     flattenStringFilters(apiObject.ComponentType),
+		"ec2_instance_image_id":              flattenStringFilters(apiObject.Ec2InstanceImageId),
+		"ec2_instance_subnet_id":             flattenStringFilters(apiObject.Ec2InstanceSubnetId),
+		"ec2_instance_vpc_id":                flattenStringFilters(apiObject.Ec2InstanceVpcId),
+		"ecr_image_architecture":             flattenStringFilters(apiObject.EcrImageArchitecture),
+		"ecr_image_hash":                     flattenStringFilters(apiObject.EcrImageHash),
+		"ecr_image_pushed_at":                flattenDateFilters(apiObject.EcrImagePushedAt),
+		"ecr_image_registry":                 flattenStringFilters(apiObject.EcrImageRegistry),
+		"ecr_image_repository_name":          flattenStringFilters(apiObject.EcrImageRepositoryName),
+		"ecr_image_tags":                     flattenStringFilters(apiObject.EcrImageTags),
+		"exploit_available":                  flattenStringFilters(apiObject.ExploitAvailable),
+		"finding_arn":                        flattenStringFilters(apiObject.FindingArn),
+		"finding_status":                     flattenStringFilters(apiObject.FindingStatus),
+		"finding_type":                       flattenStringFilters(apiObject.FindingType),
+		"first_observed_at":                  flattenDateFilters(apiObject.FirstObservedAt),
+		"fix_available":                      flattenStringFilters(apiObject.FixAvailable),
+		"inspector_score":                    flattenNumberFilters(apiObject.InspectorScore),
+		"lambda_function_execution_role_arn": flattenStringFilters(apiObject.LambdaFunctionExecutionRoleArn),
+		"lambda_function_last_modified_at":   flattenDateFilters(apiObject.LambdaFunctionLastModifiedAt),
+		"lambda_function_layers":             flattenStringFilters(apiObject.LambdaFunctionLayers),
+		"lambda_function_name":               flattenStringFilters(apiObject.LambdaFunctionName),
+		"lambda_function_runtime":            flattenStringFilters(apiObject.LambdaFunctionRuntime),
+		"last_observed_at":                   flattenDateFilters(apiObject.LastObservedAt),
+		"network_protocol":                   flattenStringFilters(apiObject.NetworkProtocol),
+		"port_range":                         flattenPortRangeFilters(apiObject.PortRange),
+		"related_vulnerabilities":            flattenStringFilters(apiObject.RelatedVulnerabilities),
+		"resource_id":                        flattenStringFilters(apiObject.ResourceId),
+		"resource_tags":                      flattenMapFilters(apiObject.ResourceTags),
+		"resource_type":                      flattenStringFilters(apiObject.ResourceType),
+		"severity":                           flattenStringFilters(apiObject.Severity),
+		"title":                              flattenStringFilters(apiObject.Title),
+		"updated_at":                         flattenDateFilters(apiObject.UpdatedAt),
+		"vendor_severity":                    flattenStringFilters(apiObject.VendorSeverity),
+		"vulnerability_id":                   flattenStringFilters(apiObject.VulnerabilityId),
+		"vulnerability_source":               flattenStringFilters(apiObject.VulnerabilitySource),
+		"vulnerable_packages":                flattenPackageFilters(apiObject.VulnerablePackages),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStringFilter(apiObject *types.StringFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"comparison": string(apiObject.Comparison),
+		"value":      aws.ToString(apiObject.Value),
+	}
+}
+
+func flattenStringFilters(apiObjects []types.StringFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		apiObject := apiObject
+		tfList = append(tfList, flattenStringFilter(&apiObject))
+	}
+
+	return tfList
+}
+
+func flattenDateFilters(apiObjects []types.DateFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.EndInclusive; v != nil {
+			tfMap["end_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartInclusive; v != nil {
+			tfMap["start_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenNumberFilter(apiObject *types.NumberFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"lower_inclusive": aws.ToFloat64(apiObject.LowerInclusive),
+		"upper_inclusive": aws.ToFloat64(apiObject.UpperInclusive),
+	}
+}
+
+func flattenNumberFilters(apiObjects []types.NumberFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		apiObject := apiObject
+		tfList = append(tfList, flattenNumberFilter(&apiObject))
+	}
+
+	return tfList
+}
+
+func flattenMapFilters(apiObjects []types.MapFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"comparison": string(apiObject.Comparison),
+			"key":        aws.ToString(apiObject.Key),
+			"value":      aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPortRangeFilters(apiObjects []types.PortRangeFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"begin_inclusive": int(aws.ToInt32(apiObject.BeginInclusive)),
+			"end_inclusive":   int(aws.ToInt32(apiObject.EndInclusive)),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPackageFilters(apiObjects []types.PackageFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	nestedStringFilter := func(apiObject *types.StringFilter) []interface{} {
+		if apiObject == nil {
+			return nil
+		}
+
+		return []interface{}{flattenStringFilter(apiObject)}
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"architecture":            nestedStringFilter(apiObject.Architecture),
+			"name":                    nestedStringFilter(apiObject.Name),
+			"release":                 nestedStringFilter(apiObject.Release),
+			"source_lambda_layer_arn": nestedStringFilter(apiObject.SourceLambdaLayerArn),
+			"source_layer_hash":       nestedStringFilter(apiObject.SourceLayerHash),
+			"version":                 nestedStringFilter(apiObject.Version),
+		}
+
+		if v := apiObject.Epoch; v != nil {
+			tfMap["epoch"] = []interface{}{flattenNumberFilter(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,323 @@
+package inspector2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccInspector2Filter_basic(t *testing.T) {
+	resourceName := "aws_inspector2_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Inspector2EndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "SUPPRESS"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "inspector2", regexp.MustCompile(`owner/\d{12}/filter/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.severity.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.severity.*", map[string]string{
+						"comparison": "EQUALS",
+						"value":      "LOW",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "reason", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccInspector2Filter_disappears(t *testing.T) {
+	resourceName := "aws_inspector2_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Inspector2EndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfinspector2.ResourceFilter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccInspector2Filter_update(t *testing.T) {
+	resourceName := "aws_inspector2_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Inspector2EndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "SUPPRESS"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.severity.#", "1"),
+				),
+			},
+			{
+				Config: testAccFilterConfig_criteria(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.severity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.inspector_score.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.inspector_score.*", map[string]string{
+						"lower_inclusive": "7",
+						"upper_inclusive": "10",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.resource_tags.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.resource_tags.*", map[string]string{
+						"comparison": "EQUALS",
+						"key":        "Environment",
+						"value":      "test",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerable_packages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "reason", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccInspector2Filter_tags(t *testing.T) {
+	resourceName := "aws_inspector2_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Inspector2EndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFilterConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFilterConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFilterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_filter" {
+			continue
+		}
+
+		_, err := tfinspector2.FindFilterByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.Inspector2, create.ErrActionCheckingDestroyed, tfinspector2.ResNameFilter, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckFilterExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Inspector2, create.ErrActionCheckingExistence, tfinspector2.ResNameFilter, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Inspector2, create.ErrActionCheckingExistence, tfinspector2.ResNameFilter, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client
+
+		_, err := tfinspector2.FindFilterByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Inspector2, create.ErrActionCheckingExistence, tfinspector2.ResNameFilter, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccFilterConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFilterConfig_criteria(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name        = %[1]q
+  action      = "NONE"
+  description = "test"
+  reason      = "test"
+
+  filter_criteria {
+    inspector_score {
+      lower_inclusive = 7
+      upper_inclusive = 10
+    }
+
+    resource_tags {
+      comparison = "EQUALS"
+      key        = "Environment"
+      value      = "test"
+    }
+
+    vulnerable_packages {
+      name {
+        comparison = "EQUALS"
+        value      = "openssl"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFilterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFilterConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
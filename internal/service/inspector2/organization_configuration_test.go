@@ -24,6 +24,7 @@ func TestAccInspector2OrganizationConfiguration_serial(t *testing.T) {
 		"basic":      testAccOrganizationConfiguration_basic,
 		"disappears": testAccOrganizationConfiguration_disappears,
 		"ec2ECR":     testAccOrganizationConfiguration_ec2ECR,
+		"lambda":     testAccOrganizationConfiguration_lambda,
 	}
 
 	for name, tc := range testCases {
@@ -49,11 +50,12 @@ func testAccOrganizationConfiguration_basic(t *testing.T) {
 		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccOrganizationConfigurationConfig_basic(true, false),
+				Config: testAccOrganizationConfigurationConfig_basic(true, false, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckOrganizationConfigurationExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "true"),
 					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda", "false"),
 				),
 			},
 		},
@@ -75,7 +77,7 @@ func testAccOrganizationConfiguration_disappears(t *testing.T) {
 		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccOrganizationConfigurationConfig_basic(true, false),
+				Config: testAccOrganizationConfigurationConfig_basic(true, false, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckOrganizationConfigurationExists(resourceName),
 					acctest.CheckResourceDisappears(acctest.Provider, tfinspector2.ResourceOrganizationConfiguration(), resourceName),
@@ -101,7 +103,7 @@ func testAccOrganizationConfiguration_ec2ECR(t *testing.T) {
 		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccOrganizationConfigurationConfig_basic(true, true),
+				Config: testAccOrganizationConfigurationConfig_basic(true, true, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckOrganizationConfigurationExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "true"),
@@ -112,6 +114,33 @@ func testAccOrganizationConfiguration_ec2ECR(t *testing.T) {
 	})
 }
 
+func testAccOrganizationConfiguration_lambda(t *testing.T) {
+	resourceName := "aws_inspector2_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Inspector2EndpointID, t)
+			testAccPreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_basic(false, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOrganizationConfigurationDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client
 	ctx := context.Background()
@@ -145,7 +174,7 @@ func testAccCheckOrganizationConfigurationDestroy(s *terraform.State) error {
 			return create.Error(names.Inspector2, create.ErrActionCheckingDestroyed, tfinspector2.ResNameOrganizationConfiguration, rs.Primary.ID, err)
 		}
 
-		if out != nil && out.AutoEnable != nil && !aws.ToBool(out.AutoEnable.Ec2) && !aws.ToBool(out.AutoEnable.Ecr) {
+		if out != nil && out.AutoEnable != nil && !aws.ToBool(out.AutoEnable.Ec2) && !aws.ToBool(out.AutoEnable.Ecr) && !aws.ToBool(out.AutoEnable.Lambda) {
 			if enabledDelAdAcct {
 				if err := testDisableDelegatedAdminAccount(ctx, conn, acctest.AccountID()); err != nil {
 					return err
@@ -220,7 +249,7 @@ func testAccCheckOrganizationConfigurationExists(name string) resource.TestCheck
 	}
 }
 
-func testAccOrganizationConfigurationConfig_basic(ec2, ecr bool) string {
+func testAccOrganizationConfigurationConfig_basic(ec2, ecr, lambda bool) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
 
@@ -230,11 +259,12 @@ resource "aws_inspector2_delegated_admin_account" "test" {
 
 resource "aws_inspector2_organization_configuration" "test" {
   auto_enable {
-    ec2 = %[1]t
-    ecr = %[2]t
+    ec2    = %[1]t
+    ecr    = %[2]t
+    lambda = %[3]t
   }
 
   depends_on = [aws_inspector2_delegated_admin_account.test]
 }
-`, ec2, ecr)
+`, ec2, ecr, lambda)
 }
@@ -0,0 +1,181 @@
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceDomainConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDomainConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorizer_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_authorizer_override": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"default_authorizer_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"cname_target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"server_certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_certificate_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_certificate_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_certificate_status_detail": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceDomainConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	output, err := FindDomainConfigurationByName(ctx, conn, name)
+
+	if err != nil {
+		return diag.Errorf("reading IoT Domain Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DomainConfigurationName))
+
+	arn := aws.StringValue(output.DomainConfigurationArn)
+
+	d.Set("arn", arn)
+	if output.AuthorizerConfig != nil {
+		if err := d.Set("authorizer_config", []interface{}{flattenAuthorizerConfig(output.AuthorizerConfig)}); err != nil {
+			return diag.Errorf("setting authorizer_config: %s", err)
+		}
+	} else {
+		d.Set("authorizer_config", nil)
+	}
+	d.Set("domain_name", output.DomainName)
+	d.Set("domain_type", output.DomainType)
+	d.Set("name", output.DomainConfigurationName)
+	if err := d.Set("server_certificates", flattenServerCertificateSummaries(output.ServerCertificates)); err != nil {
+		return diag.Errorf("setting server_certificates: %s", err)
+	}
+	d.Set("service_type", output.ServiceType)
+	d.Set("status", output.DomainConfigurationStatus)
+
+	// Customer managed domains are pointed at the account's endpoint for the service type via a CNAME record.
+	cnameTarget := aws.StringValue(output.DomainName)
+
+	if aws.StringValue(output.DomainType) == iot.DomainTypeCustomerManaged {
+		endpoint, err := conn.DescribeEndpointWithContext(ctx, &iot.DescribeEndpointInput{
+			EndpointType: aws.String(domainConfigurationEndpointType(aws.StringValue(output.ServiceType))),
+		})
+
+		if err != nil {
+			return diag.Errorf("reading IoT Domain Configuration (%s) endpoint: %s", name, err)
+		}
+
+		cnameTarget = aws.StringValue(endpoint.EndpointAddress)
+	}
+
+	d.Set("cname_target", cnameTarget)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for IoT Domain Configuration (%s): %s", name, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func domainConfigurationEndpointType(serviceType string) string {
+	switch serviceType {
+	case iot.ServiceTypeCredentialProvider:
+		return "iot:CredentialProvider"
+	case iot.ServiceTypeJobs:
+		return "iot:Jobs"
+	default:
+		return "iot:Data-ATS"
+	}
+}
+
+func flattenServerCertificateSummaries(apiObjects []*iot.ServerCertificateSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"server_certificate_arn":           aws.StringValue(apiObject.ServerCertificateArn),
+			"server_certificate_status":        aws.StringValue(apiObject.ServerCertificateStatus),
+			"server_certificate_status_detail": aws.StringValue(apiObject.ServerCertificateStatusDetail),
+		})
+	}
+
+	return tfList
+}
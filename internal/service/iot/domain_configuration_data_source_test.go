@@ -0,0 +1,47 @@
+package iot_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIoTDomainConfigurationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iot_domain_configuration.test"
+	resourceName := "aws_iot_domain_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "authorizer_config.#", resourceName, "authorizer_config.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cname_target", resourceName, "domain_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_type", resourceName, "domain_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_type", resourceName, "service_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainConfigurationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfigurationConfig_tags1(rName, "Name", rName), `
+data "aws_iot_domain_configuration" "test" {
+  name = aws_iot_domain_configuration.test.name
+}
+`)
+}
@@ -0,0 +1,285 @@
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTDomainConfiguration_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_domain_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iot", regexp.MustCompile(fmt.Sprintf("domainconfiguration/%s/.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_config.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "domain_type", iot.DomainTypeAwsManaged),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "service_type", iot.ServiceTypeData),
+					resource.TestCheckResourceAttr(resourceName, "status", iot.DomainConfigurationStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTDomainConfiguration_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_domain_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiot.ResourceDomainConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTDomainConfiguration_authorizerConfig(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_domain_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfigurationConfig_authorizerConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_config.0.allow_authorizer_override", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "authorizer_config.0.default_authorizer_name", "aws_iot_authorizer.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfigurationConfig_authorizerConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_config.0.allow_authorizer_override", "true"),
+				),
+			},
+			{
+				Config: testAccDomainConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTDomainConfiguration_status(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_domain_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfigurationConfig_status(rName, iot.DomainConfigurationStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", iot.DomainConfigurationStatusDisabled),
+				),
+			},
+			{
+				Config: testAccDomainConfigurationConfig_status(rName, iot.DomainConfigurationStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", iot.DomainConfigurationStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTDomainConfiguration_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_domain_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfigurationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfigurationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDomainConfigurationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDomainConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Domain Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+		_, err := tfiot.FindDomainConfigurationByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckDomainConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_domain_configuration" {
+			continue
+		}
+
+		_, err := tfiot.FindDomainConfigurationByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Domain Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDomainConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_domain_configuration" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccDomainConfigurationConfig_authorizerConfig(rName string, allowOverride bool) string {
+	return acctest.ConfigCompose(testAccAuthorizerConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iot_domain_configuration" "test" {
+  name = %[1]q
+
+  authorizer_config {
+    allow_authorizer_override = %[2]t
+    default_authorizer_name   = aws_iot_authorizer.test.name
+  }
+}
+`, rName, allowOverride))
+}
+
+func testAccDomainConfigurationConfig_status(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_domain_configuration" "test" {
+  name   = %[1]q
+  status = %[2]q
+}
+`, rName, status)
+}
+
+func testAccDomainConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_domain_configuration" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccDomainConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_domain_configuration" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
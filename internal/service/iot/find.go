@@ -223,3 +223,53 @@ func FindTopicRuleDestinationByARN(ctx context.Context, conn *iot.IoT, arn strin
 
 	return output.TopicRuleDestination, nil
 }
+
+func FindFleetMetricByName(ctx context.Context, conn *iot.IoT, name string) (*iot.DescribeFleetMetricOutput, error) {
+	input := &iot.DescribeFleetMetricInput{
+		MetricName: aws.String(name),
+	}
+
+	output, err := conn.DescribeFleetMetricWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDomainConfigurationByName(ctx context.Context, conn *iot.IoT, name string) (*iot.DescribeDomainConfigurationOutput, error) {
+	input := &iot.DescribeDomainConfigurationInput{
+		DomainConfigurationName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDomainConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
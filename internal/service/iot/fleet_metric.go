@@ -0,0 +1,344 @@
+package iot
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFleetMetric() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFleetMetricCreate,
+		ReadWithoutTimeout:   resourceFleetMetricRead,
+		UpdateWithoutTimeout: resourceFleetMetricUpdate,
+		DeleteWithoutTimeout: resourceFleetMetricDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation_field": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"aggregation_type": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iot.AggregationTypeName_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"index_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "AWS_Things",
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-\.]+$`), "must contain only alphanumeric characters, underscores, hyphens and periods"),
+				),
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.IntBetween(60, 86400),
+					validation.IntDivisibleBy(60),
+				),
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"query_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(iot.FleetMetricUnit_Values(), false),
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFleetMetricCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("metric_name").(string)
+	input := &iot.CreateFleetMetricInput{
+		AggregationField: aws.String(d.Get("aggregation_field").(string)),
+		IndexName:        aws.String(d.Get("index_name").(string)),
+		MetricName:       aws.String(name),
+		Period:           aws.Int64(int64(d.Get("period").(int))),
+		QueryString:      aws.String(d.Get("query_string").(string)),
+	}
+
+	if v, ok := d.GetOk("aggregation_type"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AggregationType = expandAggregationType(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("query_version"); ok {
+		input.QueryVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("unit"); ok {
+		input.Unit = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[INFO] Creating IoT Fleet Metric: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateFleetMetricWithContext(ctx, input)
+		},
+		iot.ErrCodeIndexNotReadyException)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Fleet Metric (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceFleetMetricRead(ctx, d, meta)
+}
+
+func resourceFleetMetricRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindFleetMetricByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Fleet Metric %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Fleet Metric (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.MetricArn)
+
+	d.Set("aggregation_field", output.AggregationField)
+	if output.AggregationType != nil {
+		if err := d.Set("aggregation_type", []interface{}{flattenAggregationType(output.AggregationType)}); err != nil {
+			return diag.Errorf("setting aggregation_type: %s", err)
+		}
+	} else {
+		d.Set("aggregation_type", nil)
+	}
+	d.Set("arn", arn)
+	d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("index_name", output.IndexName)
+	d.Set("last_modified_date", aws.TimeValue(output.LastModifiedDate).Format(time.RFC3339))
+	d.Set("metric_name", output.MetricName)
+	d.Set("period", output.Period)
+	d.Set("query_string", output.QueryString)
+	d.Set("query_version", output.QueryVersion)
+	d.Set("unit", output.Unit)
+	d.Set("version", output.Version)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for IoT Fleet Metric (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFleetMetricUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iot.UpdateFleetMetricInput{
+			ExpectedVersion: aws.Int64(int64(d.Get("version").(int))),
+			IndexName:       aws.String(d.Get("index_name").(string)),
+			MetricName:      aws.String(d.Id()),
+		}
+
+		if d.HasChange("aggregation_field") {
+			input.AggregationField = aws.String(d.Get("aggregation_field").(string))
+		}
+
+		if d.HasChange("aggregation_type") {
+			if v, ok := d.GetOk("aggregation_type"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.AggregationType = expandAggregationType(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("period") {
+			input.Period = aws.Int64(int64(d.Get("period").(int)))
+		}
+
+		if d.HasChange("query_string") {
+			input.QueryString = aws.String(d.Get("query_string").(string))
+		}
+
+		if d.HasChange("query_version") {
+			input.QueryVersion = aws.String(d.Get("query_version").(string))
+		}
+
+		if d.HasChange("unit") {
+			input.Unit = aws.String(d.Get("unit").(string))
+		}
+
+		log.Printf("[INFO] Updating IoT Fleet Metric: %s", input)
+		_, err := conn.UpdateFleetMetricWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Fleet Metric (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating IoT Fleet Metric (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFleetMetricRead(ctx, d, meta)
+}
+
+func resourceFleetMetricDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	log.Printf("[INFO] Deleting IoT Fleet Metric: %s", d.Id())
+	_, err := conn.DeleteFleetMetricWithContext(ctx, &iot.DeleteFleetMetricInput{
+		MetricName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Fleet Metric (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAggregationType(tfMap map[string]interface{}) *iot.AggregationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.AggregationType{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["values"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Values = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func flattenAggregationType(apiObject *iot.AggregationType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Values; v != nil {
+		tfMap["values"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
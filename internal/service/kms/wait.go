@@ -6,10 +6,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 const (
@@ -105,7 +105,7 @@ func WaitKeyPolicyPropagated(conn *kms.KMS, id, policy string) error {
 			return false, err
 		}
 
-		equivalent, err := awspolicy.PoliciesAreEquivalent(aws.StringValue(output), policy)
+		equivalent, err := verify.PoliciesAreEquivalent(aws.StringValue(output), policy)
 
 		if err != nil {
 			return false, err
@@ -0,0 +1,166 @@
+package lightsail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceBucketAccessKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketAccessKeyCreate,
+		ReadWithoutTimeout:   resourceBucketAccessKeyRead,
+		DeleteWithoutTimeout: resourceBucketAccessKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 54),
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBucketAccessKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName := d.Get("bucket_name").(string)
+	in := lightsail.CreateBucketAccessKeyInput{
+		BucketName: aws.String(bucketName),
+	}
+
+	out, err := conn.CreateBucketAccessKeyWithContext(ctx, &in)
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, lightsail.OperationTypeCreateBucketAccessKey, ResBucketAccessKey, bucketName, err)
+	}
+
+	if out.AccessKey == nil {
+		return create.DiagError(names.Lightsail, lightsail.OperationTypeCreateBucketAccessKey, ResBucketAccessKey, bucketName, errors.New("empty output"))
+	}
+
+	if len(out.Operations) > 0 {
+		if err := waitOperation(conn, out.Operations[0].Id); err != nil {
+			return create.DiagError(names.Lightsail, lightsail.OperationTypeCreateBucketAccessKey, ResBucketAccessKey, bucketName, errors.New("Error waiting for Create Bucket Access Key request operation"))
+		}
+	}
+
+	d.SetId(BucketAccessKeyCreateResourceID(bucketName, aws.StringValue(out.AccessKey.AccessKeyId)))
+
+	// The secret access key is only returned on creation.
+	d.Set("secret_access_key", out.AccessKey.SecretAccessKey)
+
+	return resourceBucketAccessKeyRead(ctx, d, meta)
+}
+
+func resourceBucketAccessKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	out, err := FindBucketAccessKeyById(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResBucketAccessKey, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, create.ErrActionReading, ResBucketAccessKey, d.Id(), err)
+	}
+
+	bucketName, _, err := BucketAccessKeyParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, create.ErrActionReading, ResBucketAccessKey, d.Id(), err)
+	}
+
+	d.Set("access_key_id", out.AccessKeyId)
+	d.Set("bucket_name", bucketName)
+	d.Set("created_at", aws.TimeValue(out.CreatedAt).Format(time.RFC3339))
+	d.Set("status", out.Status)
+
+	return nil
+}
+
+func resourceBucketAccessKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName, accessKeyID, err := BucketAccessKeyParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, lightsail.OperationTypeDeleteBucketAccessKey, ResBucketAccessKey, d.Id(), err)
+	}
+
+	out, err := conn.DeleteBucketAccessKeyWithContext(ctx, &lightsail.DeleteBucketAccessKeyInput{
+		AccessKeyId: aws.String(accessKeyID),
+		BucketName:  aws.String(bucketName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, lightsail.OperationTypeDeleteBucketAccessKey, ResBucketAccessKey, d.Id(), err)
+	}
+
+	if len(out.Operations) > 0 {
+		if err := waitOperation(conn, out.Operations[0].Id); err != nil {
+			return create.DiagError(names.Lightsail, lightsail.OperationTypeDeleteBucketAccessKey, ResBucketAccessKey, d.Id(), errors.New("Error waiting for Delete Bucket Access Key request operation"))
+		}
+	}
+
+	return nil
+}
+
+const bucketAccessKeyResourceIDSeparator = ","
+
+func BucketAccessKeyCreateResourceID(bucketName, accessKeyID string) string {
+	return strings.Join([]string{bucketName, accessKeyID}, bucketAccessKeyResourceIDSeparator)
+}
+
+func BucketAccessKeyParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, bucketAccessKeyResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET_NAME%[2]sACCESS_KEY_ID", id, bucketAccessKeyResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
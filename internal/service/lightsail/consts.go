@@ -1,6 +1,7 @@
 package lightsail
 
 const (
+	ResBucketAccessKey                    = "Bucket Access Key"
 	ResCertificate                        = "Certificate"
 	ResDatabase                           = "Database"
 	ResDisk                               = "Disk"
@@ -79,6 +79,11 @@ func ResourceContainerServiceDeploymentVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"image_digests": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"public_endpoint": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -229,6 +234,16 @@ func resourceContainerServiceDeploymentVersionRead(ctx context.Context, d *schem
 		return diag.Errorf("error setting container for Lightsail Container Service (%s) Deployment Version (%d): %s", serviceName, version, err)
 	}
 
+	imageDigests, err := containerServiceDeploymentImageDigests(ctx, conn, serviceName, deployment.Containers)
+
+	if err != nil {
+		return diag.Errorf("error reading Lightsail Container Service (%s) Deployment Version (%d) image digests: %s", serviceName, version, err)
+	}
+
+	if err := d.Set("image_digests", imageDigests); err != nil {
+		return diag.Errorf("error setting image_digests for Lightsail Container Service (%s) Deployment Version (%d): %s", serviceName, version, err)
+	}
+
 	if err := d.Set("public_endpoint", flattenContainerServiceDeploymentPublicEndpoint(deployment.PublicEndpoint)); err != nil {
 		return diag.Errorf("error setting public_endpoint for Lightsail Container Service (%s) Deployment Version (%d): %s", serviceName, version, err)
 	}
@@ -241,6 +256,48 @@ func resourceContainerServiceDeploymentVersionDelete(ctx context.Context, d *sch
 	return nil
 }
 
+// containerServiceDeploymentImageDigests returns the image digest of each deployed container, keyed by container name.
+// Digests are known for images registered to the container service (":<service>.<label>.<version>") and for images
+// referenced by digest ("<repository>@sha256:<digest>"); other images are omitted.
+func containerServiceDeploymentImageDigests(ctx context.Context, conn *lightsail.Lightsail, serviceName string, containers map[string]*lightsail.Container) (map[string]string, error) {
+	imageDigests := make(map[string]string)
+	var registeredImageDigests map[string]string
+
+	for name, container := range containers {
+		if container == nil {
+			continue
+		}
+
+		image := aws.StringValue(container.Image)
+
+		if _, digest, ok := strings.Cut(image, "@"); ok {
+			imageDigests[name] = digest
+			continue
+		}
+
+		if !strings.HasPrefix(image, ":") {
+			continue
+		}
+
+		if registeredImageDigests == nil {
+			var err error
+			registeredImageDigests, err = FindContainerImageDigests(ctx, conn, serviceName)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if digest, ok := registeredImageDigests[image]; ok {
+			imageDigests[name] = digest
+		} else if digest, ok := registeredImageDigests[strings.TrimPrefix(image, ":")]; ok {
+			imageDigests[name] = digest
+		}
+	}
+
+	return imageDigests, nil
+}
+
 func ContainerServiceDeploymentVersionParseResourceID(id string) (string, int, error) {
 	parts := strings.Split(id, "/")
 
@@ -116,6 +116,7 @@ func TestAccLightsailContainerServiceDeploymentVersion_Container_Basic(t *testin
 					resource.TestCheckResourceAttr(resourceName, "container.0.command.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "container.0.environment.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "container.0.ports.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "image_digests.%", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "service_name", "aws_lightsail_container_service.test", "name"),
 				),
 			},
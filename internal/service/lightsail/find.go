@@ -12,6 +12,39 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func FindBucketAccessKeyById(ctx context.Context, conn *lightsail.Lightsail, id string) (*lightsail.AccessKey, error) {
+	bucketName, accessKeyID, err := BucketAccessKeyParseResourceID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	in := &lightsail.GetBucketAccessKeysInput{
+		BucketName: aws.String(bucketName),
+	}
+
+	out, err := conn.GetBucketAccessKeysWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range out.AccessKeys {
+		if aws.StringValue(v.AccessKeyId) == accessKeyID {
+			return v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
 func FindCertificateByName(ctx context.Context, conn *lightsail.Lightsail, name string) (*lightsail.Certificate, error) {
 	in := &lightsail.GetCertificatesInput{
 		CertificateName: aws.String(name),
@@ -111,6 +144,34 @@ func FindContainerServiceDeploymentByVersion(ctx context.Context, conn *lightsai
 	return result, nil
 }
 
+// FindContainerImageDigests returns the digests of the container images registered to a container service, keyed by image name.
+func FindContainerImageDigests(ctx context.Context, conn *lightsail.Lightsail, serviceName string) (map[string]string, error) {
+	in := &lightsail.GetContainerImagesInput{
+		ServiceName: aws.String(serviceName),
+	}
+
+	out, err := conn.GetContainerImagesWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	digests := make(map[string]string, len(out.ContainerImages))
+
+	for _, v := range out.ContainerImages {
+		digests[aws.StringValue(v.Image)] = aws.StringValue(v.Digest)
+	}
+
+	return digests, nil
+}
+
 func FindDiskById(ctx context.Context, conn *lightsail.Lightsail, id string) (*lightsail.Disk, error) {
 	in := &lightsail.GetDiskInput{
 		DiskName: aws.String(id),
@@ -0,0 +1,307 @@
+package m2
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							ExactlyOneOf:     []string{"definition.0.content", "definition.0.s3_location"},
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+						"s3_location": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI"),
+							ExactlyOneOf: []string{"definition.0.content", "definition.0.s3_location"},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"engine_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(m2.EngineType_Values(), false),
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), "must be 2 to 60 alphanumeric characters, underscores or hyphens and start with an alphanumeric character"),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &m2.CreateApplicationInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		EngineType:  aws.String(d.Get("engine_type").(string)),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Definition = expandDefinition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Mainframe Modernization Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationId))
+
+	if _, err := waitApplicationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Mainframe Modernization Application (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindApplicationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Mainframe Modernization Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Mainframe Modernization Application (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("arn", output.ApplicationArn)
+	d.Set("description", output.Description)
+	d.Set("engine_type", output.EngineType)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("name", output.Name)
+
+	if output.LatestVersion != nil {
+		version := aws.Int64Value(output.LatestVersion.ApplicationVersion)
+		d.Set("current_version", version)
+
+		// A definition referenced from S3 cannot be read back, so only inline content is refreshed.
+		if d.Get("definition.0.s3_location").(string) == "" {
+			applicationVersion, err := FindApplicationVersionByTwoPartKey(ctx, conn, d.Id(), version)
+
+			if err != nil {
+				return diag.Errorf("reading Mainframe Modernization Application (%s) version (%d): %s", d.Id(), version, err)
+			}
+
+			if err := d.Set("definition", []interface{}{map[string]interface{}{
+				"content":     applicationDefinitionContentToSet(d.Get("definition.0.content").(string), aws.StringValue(applicationVersion.DefinitionContent)),
+				"s3_location": "",
+			}}); err != nil {
+				return diag.Errorf("setting definition: %s", err)
+			}
+		}
+	} else {
+		d.Set("current_version", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	if d.HasChanges("definition", "description") {
+		input := &m2.UpdateApplicationInput{
+			ApplicationId:             aws.String(d.Id()),
+			CurrentApplicationVersion: aws.Int64(int64(d.Get("current_version").(int))),
+		}
+
+		if d.HasChange("definition") {
+			if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Definition = expandDefinition(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Mainframe Modernization Application (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitApplicationVersionAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Mainframe Modernization Application (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Mainframe Modernization Application (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	log.Printf("[DEBUG] Deleting Mainframe Modernization Application: %s", d.Id())
+	_, err := conn.DeleteApplicationWithContext(ctx, &m2.DeleteApplicationInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Mainframe Modernization Application (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitApplicationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Mainframe Modernization Application (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// applicationDefinitionContentToSet returns the definition already in state if it is
+// semantically equivalent to the definition returned by the service.
+func applicationDefinitionContentToSet(old, new string) string {
+	if verify.JSONStringsEqual(old, new) {
+		return old
+	}
+
+	if v, err := structure.NormalizeJsonString(new); err == nil {
+		return v
+	}
+
+	return new
+}
+
+func expandDefinition(tfMap map[string]interface{}) *m2.Definition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &m2.Definition{}
+
+	if v, ok := tfMap["content"].(string); ok && v != "" {
+		apiObject.Content = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_location"].(string); ok && v != "" {
+		apiObject.S3Location = aws.String(v)
+	}
+
+	return apiObject
+}
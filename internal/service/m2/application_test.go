@@ -0,0 +1,293 @@
+package m2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/m2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccM2Application_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, m2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "m2", regexp.MustCompile(`app/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "current_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "definition.0.content"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "engine_type", "bluage"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationConfig_basic(rName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "current_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccM2Application_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, m2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfm2.ResourceApplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccM2Application_s3Location(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, m2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_s3Location(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.content", ""),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.s3_location", fmt.Sprintf("s3://%s/definition.json", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccM2Application_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, m2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Mainframe Modernization Application ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Conn
+
+		_, err := tfm2.FindApplicationByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckApplicationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).M2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_m2_application" {
+			continue
+		}
+
+		_, err := tfm2.FindApplicationByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Mainframe Modernization Application %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccApplicationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccApplicationConfig_definition(keyPrefix string) string {
+	return fmt.Sprintf(`
+locals {
+  definition = jsonencode({
+    "template-version" = "2.0"
+    "source-locations" = [{
+      "source-id"   = "s3-source"
+      "source-type" = "s3"
+      "properties" = {
+        "s3-bucket"     = aws_s3_bucket.test.id
+        "s3-key-prefix" = %[1]q
+      }
+    }]
+    "definition" = {
+      "listeners" = [{
+        "port" = 8196
+        "type" = "http"
+      }]
+      "ba-application" = {
+        "app-location" = "$${s3-source}/PlanetsDemo-v1.zip"
+      }
+    }
+  })
+}
+`, keyPrefix)
+}
+
+func testAccApplicationConfig_basic(rName, keyPrefix string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_base(rName), testAccApplicationConfig_definition(keyPrefix), fmt.Sprintf(`
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+
+  definition {
+    content = local.definition
+  }
+}
+`, rName))
+}
+
+func testAccApplicationConfig_s3Location(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_base(rName), testAccApplicationConfig_definition("v1"), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "definition.json"
+  content = local.definition
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+
+  definition {
+    s3_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+}
+`, rName))
+}
+
+func testAccApplicationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_base(rName), testAccApplicationConfig_definition("v1"), fmt.Sprintf(`
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+
+  definition {
+    content = local.definition
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccApplicationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_base(rName), testAccApplicationConfig_definition("v1"), fmt.Sprintf(`
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+
+  definition {
+    content = local.definition
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
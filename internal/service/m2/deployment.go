@@ -0,0 +1,257 @@
+package m2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		UpdateWithoutTimeout: resourceDeploymentUpdate,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"application_version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	applicationID := d.Get("application_id").(string)
+	input := &m2.CreateDeploymentInput{
+		ApplicationId:      aws.String(applicationID),
+		ApplicationVersion: aws.Int64(int64(d.Get("application_version").(int))),
+		ClientToken:        aws.String(resource.UniqueId()),
+		EnvironmentId:      aws.String(d.Get("environment_id").(string)),
+	}
+
+	output, err := conn.CreateDeploymentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Mainframe Modernization Deployment (%s): %s", applicationID, err)
+	}
+
+	d.SetId(DeploymentCreateResourceID(applicationID, aws.StringValue(output.DeploymentId)))
+
+	if _, err := waitDeploymentSucceeded(ctx, conn, applicationID, aws.StringValue(output.DeploymentId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Mainframe Modernization Deployment (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("start").(bool) {
+		if err := startApplication(ctx, conn, applicationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDeploymentRead(ctx, d, meta)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	applicationID, deploymentID, err := DeploymentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindDeploymentByTwoPartKey(ctx, conn, applicationID, deploymentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Mainframe Modernization Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Mainframe Modernization Deployment (%s): %s", d.Id(), err)
+	}
+
+	application, err := FindApplicationByID(ctx, conn, applicationID)
+
+	if err != nil {
+		return diag.Errorf("reading Mainframe Modernization Application (%s): %s", applicationID, err)
+	}
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("application_version", output.ApplicationVersion)
+	d.Set("deployment_id", output.DeploymentId)
+	d.Set("environment_id", output.EnvironmentId)
+	d.Set("start", aws.StringValue(application.Status) == m2.ApplicationLifecycleRunning)
+	d.Set("status", output.Status)
+
+	return nil
+}
+
+func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	if d.HasChange("start") {
+		applicationID := d.Get("application_id").(string)
+
+		if d.Get("start").(bool) {
+			if err := startApplication(ctx, conn, applicationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := stopApplication(ctx, conn, applicationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceDeploymentRead(ctx, d, meta)
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	applicationID, _, err := DeploymentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	application, err := FindApplicationByID(ctx, conn, applicationID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Mainframe Modernization Application (%s): %s", applicationID, err)
+	}
+
+	// A running application must be stopped before it can be removed from its environment.
+	if status := aws.StringValue(application.Status); status == m2.ApplicationLifecycleRunning || status == m2.ApplicationLifecycleStarting {
+		if err := stopApplication(ctx, conn, applicationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Mainframe Modernization Deployment: %s", d.Id())
+	_, err = conn.DeleteApplicationFromEnvironmentWithContext(ctx, &m2.DeleteApplicationFromEnvironmentInput{
+		ApplicationId: aws.String(applicationID),
+		EnvironmentId: aws.String(d.Get("environment_id").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Mainframe Modernization Deployment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitApplicationDeletedFromEnvironment(ctx, conn, applicationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Mainframe Modernization Deployment (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func startApplication(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) error {
+	_, err := conn.StartApplicationWithContext(ctx, &m2.StartApplicationInput{
+		ApplicationId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting Mainframe Modernization Application (%s): %w", id, err)
+	}
+
+	if _, err := waitApplicationRunning(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for Mainframe Modernization Application (%s) start: %w", id, err)
+	}
+
+	return nil
+}
+
+func stopApplication(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) error {
+	_, err := conn.StopApplicationWithContext(ctx, &m2.StopApplicationInput{
+		ApplicationId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping Mainframe Modernization Application (%s): %w", id, err)
+	}
+
+	if _, err := waitApplicationStopped(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for Mainframe Modernization Application (%s) stop: %w", id, err)
+	}
+
+	return nil
+}
+
+const deploymentResourceIDSeparator = "/"
+
+func DeploymentCreateResourceID(applicationID, deploymentID string) string {
+	parts := []string{applicationID, deploymentID}
+	id := strings.Join(parts, deploymentResourceIDSeparator)
+
+	return id
+}
+
+func DeploymentParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, deploymentResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected application-id%[2]sdeployment-id", id, deploymentResourceIDSeparator)
+}
@@ -0,0 +1,108 @@
+package m2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/m2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+)
+
+func TestAccM2Deployment_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_deployment.test"
+	applicationResourceName := "aws_m2_application.test"
+	environmentResourceName := "aws_m2_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, m2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", applicationResourceName, "application_id"),
+					resource.TestCheckResourceAttr(resourceName, "application_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id", environmentResourceName, "environment_id"),
+					resource.TestCheckResourceAttr(resourceName, "start", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Succeeded"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Mainframe Modernization Deployment ID is set")
+		}
+
+		applicationID, deploymentID, err := tfm2.DeploymentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Conn
+
+		_, err = tfm2.FindDeploymentByTwoPartKey(context.Background(), conn, applicationID, deploymentID)
+
+		return err
+	}
+}
+
+func testAccDeploymentConfig_basic(rName string, start bool) string {
+	return acctest.ConfigCompose(
+		testAccEnvironmentConfig_basic(rName),
+		testAccApplicationConfig_definition("v1"),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+
+  definition {
+    content = local.definition
+  }
+}
+
+resource "aws_m2_deployment" "test" {
+  application_id      = aws_m2_application.test.application_id
+  application_version = aws_m2_application.test.current_version
+  environment_id      = aws_m2_environment.test.environment_id
+  start               = %[2]t
+}
+`, rName, start))
+}
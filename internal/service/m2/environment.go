@@ -0,0 +1,467 @@
+package m2
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnvironmentCreate,
+		ReadWithoutTimeout:   resourceEnvironmentRead,
+		UpdateWithoutTimeout: resourceEnvironmentUpdate,
+		DeleteWithoutTimeout: resourceEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"apply_during_maintenance_window": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"engine_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(m2.EngineType_Values(), false),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"high_availability_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+					},
+				},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"load_balancer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), "must be 2 to 60 alphanumeric characters, underscores or hyphens and start with an alphanumeric character"),
+			},
+			"preferred_maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"storage_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"efs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     storageConfigurationFileSystemSchema(),
+						},
+						"fsx": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     storageConfigurationFileSystemSchema(),
+						},
+					},
+				},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func storageConfigurationFileSystemSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"file_system_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mount_point": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &m2.CreateEnvironmentInput{
+		ClientToken:  aws.String(resource.UniqueId()),
+		EngineType:   aws.String(d.Get("engine_type").(string)),
+		InstanceType: aws.String(d.Get("instance_type").(string)),
+		Name:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("high_availability_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.HighAvailabilityConfig = expandHighAvailabilityConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("publicly_accessible"); ok {
+		input.PubliclyAccessible = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("storage_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.StorageConfigurations = expandStorageConfigurations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateEnvironmentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Mainframe Modernization Environment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.EnvironmentId))
+
+	if _, err := waitEnvironmentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Mainframe Modernization Environment (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindEnvironmentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Mainframe Modernization Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Mainframe Modernization Environment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.EnvironmentArn)
+	d.Set("description", output.Description)
+	d.Set("engine_type", output.EngineType)
+	d.Set("engine_version", output.EngineVersion)
+	d.Set("environment_id", output.EnvironmentId)
+	if output.HighAvailabilityConfig != nil {
+		if err := d.Set("high_availability_config", []interface{}{flattenHighAvailabilityConfig(output.HighAvailabilityConfig)}); err != nil {
+			return diag.Errorf("setting high_availability_config: %s", err)
+		}
+	} else {
+		d.Set("high_availability_config", nil)
+	}
+	d.Set("instance_type", output.InstanceType)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("load_balancer_arn", output.LoadBalancerArn)
+	d.Set("name", output.Name)
+	d.Set("preferred_maintenance_window", output.PreferredMaintenanceWindow)
+	d.Set("publicly_accessible", output.PubliclyAccessible)
+	d.Set("security_group_ids", aws.StringValueSlice(output.SecurityGroupIds))
+	if err := d.Set("storage_configuration", flattenStorageConfigurations(output.StorageConfigurations)); err != nil {
+		return diag.Errorf("setting storage_configuration: %s", err)
+	}
+	d.Set("subnet_ids", aws.StringValueSlice(output.SubnetIds))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	if d.HasChanges("engine_version", "high_availability_config", "instance_type", "preferred_maintenance_window") {
+		applyDuringMaintenanceWindow := d.Get("apply_during_maintenance_window").(bool)
+		input := &m2.UpdateEnvironmentInput{
+			EnvironmentId: aws.String(d.Id()),
+		}
+
+		if applyDuringMaintenanceWindow {
+			input.ApplyDuringMaintenanceWindow = aws.Bool(applyDuringMaintenanceWindow)
+		}
+
+		if d.HasChange("engine_version") {
+			input.EngineVersion = aws.String(d.Get("engine_version").(string))
+		}
+
+		if d.HasChange("high_availability_config") {
+			if v, ok := d.GetOk("high_availability_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DesiredCapacity = expandHighAvailabilityConfig(v.([]interface{})[0].(map[string]interface{})).DesiredCapacity
+			}
+		}
+
+		if d.HasChange("instance_type") {
+			input.InstanceType = aws.String(d.Get("instance_type").(string))
+		}
+
+		if d.HasChange("preferred_maintenance_window") {
+			input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		}
+
+		_, err := conn.UpdateEnvironmentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Mainframe Modernization Environment (%s): %s", d.Id(), err)
+		}
+
+		// Changes deferred to the maintenance window leave the environment available.
+		if !applyDuringMaintenanceWindow {
+			if _, err := waitEnvironmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("waiting for Mainframe Modernization Environment (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Mainframe Modernization Environment (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).M2Conn
+
+	log.Printf("[DEBUG] Deleting Mainframe Modernization Environment: %s", d.Id())
+	_, err := conn.DeleteEnvironmentWithContext(ctx, &m2.DeleteEnvironmentInput{
+		EnvironmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Mainframe Modernization Environment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEnvironmentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Mainframe Modernization Environment (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandHighAvailabilityConfig(tfMap map[string]interface{}) *m2.HighAvailabilityConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &m2.HighAvailabilityConfig{
+		DesiredCapacity: aws.Int64(int64(tfMap["desired_capacity"].(int))),
+	}
+}
+
+func flattenHighAvailabilityConfig(apiObject *m2.HighAvailabilityConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"desired_capacity": aws.Int64Value(apiObject.DesiredCapacity),
+	}
+}
+
+func expandStorageConfigurations(tfList []interface{}) []*m2.StorageConfiguration {
+	var apiObjects []*m2.StorageConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &m2.StorageConfiguration{}
+
+		if v, ok := tfMap["efs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Efs = &m2.EfsStorageConfiguration{
+				FileSystemId: aws.String(tfMap["file_system_id"].(string)),
+				MountPoint:   aws.String(tfMap["mount_point"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["fsx"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Fsx = &m2.FsxStorageConfiguration{
+				FileSystemId: aws.String(tfMap["file_system_id"].(string)),
+				MountPoint:   aws.String(tfMap["mount_point"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenStorageConfigurations(apiObjects []*m2.StorageConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Efs; v != nil {
+			tfMap["efs"] = []interface{}{map[string]interface{}{
+				"file_system_id": aws.StringValue(v.FileSystemId),
+				"mount_point":    aws.StringValue(v.MountPoint),
+			}}
+		}
+
+		if v := apiObject.Fsx; v != nil {
+			tfMap["fsx"] = []interface{}{map[string]interface{}{
+				"file_system_id": aws.StringValue(v.FileSystemId),
+				"mount_point":    aws.StringValue(v.MountPoint),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
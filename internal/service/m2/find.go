@@ -0,0 +1,113 @@
+package m2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindApplicationByID(ctx context.Context, conn *m2.M2, id string) (*m2.GetApplicationOutput, error) {
+	input := &m2.GetApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+
+	output, err := conn.GetApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDeploymentByTwoPartKey(ctx context.Context, conn *m2.M2, applicationID, deploymentID string) (*m2.GetDeploymentOutput, error) {
+	input := &m2.GetDeploymentInput{
+		ApplicationId: aws.String(applicationID),
+		DeploymentId:  aws.String(deploymentID),
+	}
+
+	output, err := conn.GetDeploymentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindEnvironmentByID(ctx context.Context, conn *m2.M2, id string) (*m2.GetEnvironmentOutput, error) {
+	input := &m2.GetEnvironmentInput{
+		EnvironmentId: aws.String(id),
+	}
+
+	output, err := conn.GetEnvironmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindApplicationVersionByTwoPartKey(ctx context.Context, conn *m2.M2, id string, version int64) (*m2.GetApplicationVersionOutput, error) {
+	input := &m2.GetApplicationVersionInput{
+		ApplicationId:      aws.String(id),
+		ApplicationVersion: aws.Int64(version),
+	}
+
+	output, err := conn.GetApplicationVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,78 @@
+package m2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusApplication(ctx context.Context, conn *m2.M2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusApplicationVersion(ctx context.Context, conn *m2.M2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.LatestVersion == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.LatestVersion.Status), nil
+	}
+}
+
+func statusDeployment(ctx context.Context, conn *m2.M2, applicationID, deploymentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDeploymentByTwoPartKey(ctx, conn, applicationID, deploymentID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusEnvironment(ctx context.Context, conn *m2.M2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEnvironmentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,147 @@
+package macie2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAutomatedDiscoveryConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomatedDiscoveryConfigurationCreate,
+		ReadWithoutTimeout:   resourceAutomatedDiscoveryConfigurationRead,
+		UpdateWithoutTimeout: resourceAutomatedDiscoveryConfigurationUpdate,
+		DeleteWithoutTimeout: resourceAutomatedDiscoveryConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"classification_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disabled_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_enabled_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sensitivity_inspection_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(macie2.AutomatedDiscoveryStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAutomatedDiscoveryConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	status := d.Get("status").(string)
+
+	if err := updateAutomatedDiscoveryStatus(ctx, conn, status); err != nil {
+		return diag.Errorf("creating Macie Automated Discovery Configuration: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return resourceAutomatedDiscoveryConfigurationRead(ctx, d, meta)
+}
+
+func resourceAutomatedDiscoveryConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	output, err := FindAutomatedDiscoveryConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Automated Discovery Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Macie Automated Discovery Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("classification_scope_id", output.ClassificationScopeId)
+	d.Set("disabled_at", flattenTimestamp(output.DisabledAt))
+	d.Set("first_enabled_at", flattenTimestamp(output.FirstEnabledAt))
+	d.Set("last_updated_at", flattenTimestamp(output.LastUpdatedAt))
+	d.Set("sensitivity_inspection_template_id", output.SensitivityInspectionTemplateId)
+	d.Set("status", output.Status)
+
+	return nil
+}
+
+func resourceAutomatedDiscoveryConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	if d.HasChange("status") {
+		if err := updateAutomatedDiscoveryStatus(ctx, conn, d.Get("status").(string)); err != nil {
+			return diag.Errorf("updating Macie Automated Discovery Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAutomatedDiscoveryConfigurationRead(ctx, d, meta)
+}
+
+func resourceAutomatedDiscoveryConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	log.Printf("[DEBUG] Disabling Macie Automated Discovery Configuration: %s", d.Id())
+	err := updateAutomatedDiscoveryStatus(ctx, conn, macie2.AutomatedDiscoveryStatusDisabled)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Macie Automated Discovery Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateAutomatedDiscoveryStatus(ctx context.Context, conn *macie2.Macie2, status string) error {
+	input := &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+		Status: aws.String(status),
+	}
+
+	// Macie may still be provisioning the account's classification scope and
+	// sensitivity inspection template shortly after it's enabled.
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, 2*time.Minute, func() (interface{}, error) {
+		return conn.UpdateAutomatedDiscoveryConfigurationWithContext(ctx, input)
+	}, macie2.ErrCodeConflictException)
+
+	return err
+}
+
+func flattenTimestamp(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+
+	return aws.TimeValue(v).Format(time.RFC3339)
+}
@@ -0,0 +1,110 @@
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAutomatedDiscoveryConfiguration_basic(t *testing.T) {
+	resourceName := "aws_macie2_automated_discovery_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomatedDiscoveryConfigurationDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_basic(macie2.AutomatedDiscoveryStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "classification_scope_id"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "first_enabled_at"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "last_updated_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "sensitivity_inspection_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.AutomatedDiscoveryStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_basic(macie2.AutomatedDiscoveryStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(resourceName),
+					acctest.CheckResourceAttrRFC3339(resourceName, "disabled_at"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.AutomatedDiscoveryStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomatedDiscoveryConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_automated_discovery_configuration" {
+			continue
+		}
+
+		output, err := tfmacie2.FindAutomatedDiscoveryConfiguration(context.Background(), conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.Status) == macie2.AutomatedDiscoveryStatusEnabled {
+			return fmt.Errorf("Macie Automated Discovery Configuration %s still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAutomatedDiscoveryConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Automated Discovery Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn
+
+		_, err := tfmacie2.FindAutomatedDiscoveryConfiguration(context.Background(), conn)
+
+		return err
+	}
+}
+
+func testAccAutomatedDiscoveryConfigurationConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_automated_discovery_configuration" "test" {
+  status = %[1]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, status)
+}
@@ -0,0 +1,141 @@
+package macie2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ResourceClassificationScope manages the account's classification scope. Macie
+// creates exactly one classification scope per account, so this resource adopts
+// the existing scope on create and clears its exclusions on delete.
+func ResourceClassificationScope() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClassificationScopeCreate,
+		ReadWithoutTimeout:   resourceClassificationScopeRead,
+		UpdateWithoutTimeout: resourceClassificationScopeUpdate,
+		DeleteWithoutTimeout: resourceClassificationScopeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"excluded_s3_bucket_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1000,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(3, 255),
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceClassificationScopeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	id, err := FindClassificationScopeID(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("creating Macie Classification Scope: %s", err)
+	}
+
+	if err := updateClassificationScopeExclusions(ctx, conn, id, flex.ExpandStringSet(d.Get("excluded_s3_bucket_names").(*schema.Set))); err != nil {
+		return diag.Errorf("creating Macie Classification Scope (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceClassificationScopeRead(ctx, d, meta)
+}
+
+func resourceClassificationScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	output, err := FindClassificationScopeByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Classification Scope (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Macie Classification Scope (%s): %s", d.Id(), err)
+	}
+
+	var bucketNames []*string
+	if output.S3 != nil && output.S3.Excludes != nil {
+		bucketNames = output.S3.Excludes.BucketNames
+	}
+
+	d.Set("excluded_s3_bucket_names", aws.StringValueSlice(bucketNames))
+	d.Set("name", output.Name)
+
+	return nil
+}
+
+func resourceClassificationScopeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	if d.HasChange("excluded_s3_bucket_names") {
+		if err := updateClassificationScopeExclusions(ctx, conn, d.Id(), flex.ExpandStringSet(d.Get("excluded_s3_bucket_names").(*schema.Set))); err != nil {
+			return diag.Errorf("updating Macie Classification Scope (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceClassificationScopeRead(ctx, d, meta)
+}
+
+func resourceClassificationScopeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	log.Printf("[DEBUG] Resetting Macie Classification Scope: %s", d.Id())
+	err := updateClassificationScopeExclusions(ctx, conn, d.Id(), []*string{})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Macie Classification Scope (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateClassificationScopeExclusions(ctx context.Context, conn *macie2.Macie2, id string, bucketNames []*string) error {
+	if bucketNames == nil {
+		bucketNames = []*string{}
+	}
+
+	input := &macie2.UpdateClassificationScopeInput{
+		Id: aws.String(id),
+		S3: &macie2.S3ClassificationScopeUpdate{
+			Excludes: &macie2.S3ClassificationScopeExclusionUpdate{
+				BucketNames: bucketNames,
+				Operation:   aws.String(macie2.ClassificationScopeUpdateOperationReplace),
+			},
+		},
+	}
+
+	_, err := conn.UpdateClassificationScopeWithContext(ctx, input)
+
+	return err
+}
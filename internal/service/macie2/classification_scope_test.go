@@ -0,0 +1,110 @@
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccClassificationScope_basic(t *testing.T) {
+	resourceName := "aws_macie2_classification_scope.test"
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationScopeDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationScopeConfig_basic(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationScopeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "excluded_s3_bucket_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "excluded_s3_bucket_names.*", rName1),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClassificationScopeConfig_basic(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationScopeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "excluded_s3_bucket_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "excluded_s3_bucket_names.*", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClassificationScopeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_classification_scope" {
+			continue
+		}
+
+		output, err := tfmacie2.FindClassificationScopeByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output.S3 != nil && output.S3.Excludes != nil && len(output.S3.Excludes.BucketNames) > 0 {
+			return fmt.Errorf("Macie Classification Scope %s still has exclusions", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckClassificationScopeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Classification Scope ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn
+
+		_, err := tfmacie2.FindClassificationScopeByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccClassificationScopeConfig_basic(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_classification_scope" "test" {
+  excluded_s3_bucket_names = [%[1]q]
+
+  depends_on = [aws_macie2_account.test]
+}
+`, bucketName)
+}
@@ -1,8 +1,13 @@
 package macie2
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // findMemberNotAssociated Return a list of members not associated and compare with account ID
@@ -33,3 +38,159 @@ func findMemberNotAssociated(conn *macie2.Macie2, accountID string) (*macie2.Mem
 
 	return result, err
 }
+
+func FindAutomatedDiscoveryConfiguration(ctx context.Context, conn *macie2.Macie2) (*macie2.GetAutomatedDiscoveryConfigurationOutput, error) {
+	input := &macie2.GetAutomatedDiscoveryConfigurationInput{}
+
+	output, err := conn.GetAutomatedDiscoveryConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindClassificationScopeID(ctx context.Context, conn *macie2.Macie2) (string, error) {
+	input := &macie2.ListClassificationScopesInput{}
+	var result string
+
+	err := conn.ListClassificationScopesPagesWithContext(ctx, input, func(page *macie2.ListClassificationScopesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, scope := range page.ClassificationScopes {
+			if scope == nil {
+				continue
+			}
+
+			result = aws.StringValue(scope.Id)
+			return false
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if result == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+func FindClassificationScopeByID(ctx context.Context, conn *macie2.Macie2, id string) (*macie2.GetClassificationScopeOutput, error) {
+	input := &macie2.GetClassificationScopeInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetClassificationScopeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindSensitivityInspectionTemplateID(ctx context.Context, conn *macie2.Macie2) (string, error) {
+	input := &macie2.ListSensitivityInspectionTemplatesInput{}
+	var result string
+
+	err := conn.ListSensitivityInspectionTemplatesPagesWithContext(ctx, input, func(page *macie2.ListSensitivityInspectionTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, template := range page.SensitivityInspectionTemplates {
+			if template == nil {
+				continue
+			}
+
+			result = aws.StringValue(template.Id)
+			return false
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if result == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+func FindSensitivityInspectionTemplateByID(ctx context.Context, conn *macie2.Macie2, id string) (*macie2.GetSensitivityInspectionTemplateOutput, error) {
+	input := &macie2.GetSensitivityInspectionTemplateInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetSensitivityInspectionTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -13,6 +13,9 @@ func TestAccMacie2_serial(t *testing.T) {
 			"finding_and_status":           testAccAccount_WithFindingAndStatus,
 			"disappears":                   testAccAccount_disappears,
 		},
+		"AutomatedDiscoveryConfiguration": {
+			"basic": testAccAutomatedDiscoveryConfiguration_basic,
+		},
 		"ClassificationExportConfiguration": {
 			"basic": testAccClassificationExportConfiguration_basic,
 		},
@@ -26,6 +29,9 @@ func TestAccMacie2_serial(t *testing.T) {
 			"tags":            testAccClassificationJob_WithTags,
 			"bucket_criteria": testAccClassificationJob_BucketCriteria,
 		},
+		"ClassificationScope": {
+			"basic": testAccClassificationScope_basic,
+		},
 		"CustomDataIdentifier": {
 			"basic":              testAccCustomDataIdentifier_basic,
 			"name_generated":     testAccCustomDataIdentifier_Name_Generated,
@@ -60,6 +66,9 @@ func TestAccMacie2_serial(t *testing.T) {
 		"InvitationAccepter": {
 			"basic": testAccInvitationAccepter_basic,
 		},
+		"SensitivityInspectionTemplate": {
+			"basic": testAccSensitivityInspectionTemplate_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,240 @@
+package macie2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ResourceSensitivityInspectionTemplate manages the account's sensitivity
+// inspection template. Macie creates exactly one template per account, so this
+// resource adopts the existing template on create and resets it on delete.
+func ResourceSensitivityInspectionTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSensitivityInspectionTemplateCreate,
+		ReadWithoutTimeout:   resourceSensitivityInspectionTemplateRead,
+		UpdateWithoutTimeout: resourceSensitivityInspectionTemplateUpdate,
+		DeleteWithoutTimeout: resourceSensitivityInspectionTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"excludes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"includes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_list_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"custom_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"managed_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSensitivityInspectionTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	id, err := FindSensitivityInspectionTemplateID(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("creating Macie Sensitivity Inspection Template: %s", err)
+	}
+
+	input := &macie2.UpdateSensitivityInspectionTemplateInput{
+		Description: aws.String(d.Get("description").(string)),
+		Excludes:    expandSensitivityInspectionTemplateExcludes(d.Get("excludes").([]interface{})),
+		Id:          aws.String(id),
+		Includes:    expandSensitivityInspectionTemplateIncludes(d.Get("includes").([]interface{})),
+	}
+
+	_, err = conn.UpdateSensitivityInspectionTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Macie Sensitivity Inspection Template (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceSensitivityInspectionTemplateRead(ctx, d, meta)
+}
+
+func resourceSensitivityInspectionTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	output, err := FindSensitivityInspectionTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Sensitivity Inspection Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Macie Sensitivity Inspection Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", output.Description)
+	if err := d.Set("excludes", flattenSensitivityInspectionTemplateExcludes(output.Excludes)); err != nil {
+		return diag.Errorf("setting excludes: %s", err)
+	}
+	if err := d.Set("includes", flattenSensitivityInspectionTemplateIncludes(output.Includes)); err != nil {
+		return diag.Errorf("setting includes: %s", err)
+	}
+	d.Set("name", output.Name)
+
+	return nil
+}
+
+func resourceSensitivityInspectionTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	input := &macie2.UpdateSensitivityInspectionTemplateInput{
+		Description: aws.String(d.Get("description").(string)),
+		Excludes:    expandSensitivityInspectionTemplateExcludes(d.Get("excludes").([]interface{})),
+		Id:          aws.String(d.Id()),
+		Includes:    expandSensitivityInspectionTemplateIncludes(d.Get("includes").([]interface{})),
+	}
+
+	_, err := conn.UpdateSensitivityInspectionTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Macie Sensitivity Inspection Template (%s): %s", d.Id(), err)
+	}
+
+	return resourceSensitivityInspectionTemplateRead(ctx, d, meta)
+}
+
+func resourceSensitivityInspectionTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	log.Printf("[DEBUG] Resetting Macie Sensitivity Inspection Template: %s", d.Id())
+	_, err := conn.UpdateSensitivityInspectionTemplateWithContext(ctx, &macie2.UpdateSensitivityInspectionTemplateInput{
+		Excludes: &macie2.SensitivityInspectionTemplateExcludes{},
+		Id:       aws.String(d.Id()),
+		Includes: &macie2.SensitivityInspectionTemplateIncludes{},
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Macie Sensitivity Inspection Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSensitivityInspectionTemplateExcludes(tfList []interface{}) *macie2.SensitivityInspectionTemplateExcludes {
+	apiObject := &macie2.SensitivityInspectionTemplateExcludes{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["managed_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ManagedDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandSensitivityInspectionTemplateIncludes(tfList []interface{}) *macie2.SensitivityInspectionTemplateIncludes {
+	apiObject := &macie2.SensitivityInspectionTemplateIncludes{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["allow_list_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowListIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["custom_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CustomDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["managed_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ManagedDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenSensitivityInspectionTemplateExcludes(apiObject *macie2.SensitivityInspectionTemplateExcludes) []interface{} {
+	if apiObject == nil || len(apiObject.ManagedDataIdentifierIds) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"managed_data_identifier_ids": aws.StringValueSlice(apiObject.ManagedDataIdentifierIds),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSensitivityInspectionTemplateIncludes(apiObject *macie2.SensitivityInspectionTemplateIncludes) []interface{} {
+	if apiObject == nil || (len(apiObject.AllowListIds) == 0 && len(apiObject.CustomDataIdentifierIds) == 0 && len(apiObject.ManagedDataIdentifierIds) == 0) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_list_ids":              aws.StringValueSlice(apiObject.AllowListIds),
+		"custom_data_identifier_ids":  aws.StringValueSlice(apiObject.CustomDataIdentifierIds),
+		"managed_data_identifier_ids": aws.StringValueSlice(apiObject.ManagedDataIdentifierIds),
+	}
+
+	return []interface{}{tfMap}
+}
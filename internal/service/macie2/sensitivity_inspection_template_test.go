@@ -0,0 +1,114 @@
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccSensitivityInspectionTemplate_basic(t *testing.T) {
+	resourceName := "aws_macie2_sensitivity_inspection_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSensitivityInspectionTemplateDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSensitivityInspectionTemplateConfig_basic("test", "AWS_CREDENTIALS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSensitivityInspectionTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "excludes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "excludes.0.managed_data_identifier_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "excludes.0.managed_data_identifier_ids.*", "AWS_CREDENTIALS"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSensitivityInspectionTemplateConfig_basic("updated", "BANK_ACCOUNT_NUMBER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSensitivityInspectionTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "excludes.0.managed_data_identifier_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "excludes.0.managed_data_identifier_ids.*", "BANK_ACCOUNT_NUMBER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSensitivityInspectionTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_sensitivity_inspection_template" {
+			continue
+		}
+
+		output, err := tfmacie2.FindSensitivityInspectionTemplateByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output.Excludes != nil && len(output.Excludes.ManagedDataIdentifierIds) > 0 {
+			return fmt.Errorf("Macie Sensitivity Inspection Template %s still has exclusions", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSensitivityInspectionTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Sensitivity Inspection Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn
+
+		_, err := tfmacie2.FindSensitivityInspectionTemplateByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSensitivityInspectionTemplateConfig_basic(description, managedDataIdentifierID string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_sensitivity_inspection_template" "test" {
+  description = %[1]q
+
+  excludes {
+    managed_data_identifier_ids = [%[2]q]
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, description, managedDataIdentifierID)
+}
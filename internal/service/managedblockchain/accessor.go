@@ -0,0 +1,113 @@
+package managedblockchain
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAccessor() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessorCreate,
+		ReadWithoutTimeout:   resourceAccessorRead,
+		DeleteWithoutTimeout: resourceAccessorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accessor_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      managedblockchain.AccessorTypeBillingToken,
+				ValidateFunc: validation.StringInSlice(managedblockchain.AccessorType_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billing_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccessorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+
+	input := &managedblockchain.CreateAccessorInput{
+		AccessorType:       aws.String(d.Get("accessor_type").(string)),
+		ClientRequestToken: aws.String(resource.UniqueId()),
+	}
+
+	log.Printf("[DEBUG] Creating Managed Blockchain Accessor: %s", input)
+	output, err := conn.CreateAccessorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Managed Blockchain Accessor: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.AccessorId))
+
+	return resourceAccessorRead(ctx, d, meta)
+}
+
+func resourceAccessorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+
+	accessor, err := FindAccessorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Accessor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Managed Blockchain Accessor (%s): %s", d.Id(), err)
+	}
+
+	d.Set("accessor_type", accessor.Type)
+	d.Set("arn", accessor.Arn)
+	d.Set("billing_token", accessor.BillingToken)
+	d.Set("status", accessor.Status)
+
+	return nil
+}
+
+func resourceAccessorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+
+	log.Printf("[INFO] Deleting Managed Blockchain Accessor: %s", d.Id())
+	_, err := conn.DeleteAccessorWithContext(ctx, &managedblockchain.DeleteAccessorInput{
+		AccessorId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Managed Blockchain Accessor (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
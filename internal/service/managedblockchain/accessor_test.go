@@ -0,0 +1,114 @@
+package managedblockchain_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccManagedBlockchainAccessor_basic(t *testing.T) {
+	resourceName := "aws_managedblockchain_accessor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedblockchain.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessorConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accessor_type", managedblockchain.AccessorTypeBillingToken),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "managedblockchain", regexp.MustCompile(`accessors/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "billing_token"),
+					resource.TestCheckResourceAttr(resourceName, "status", managedblockchain.AccessorStatusAvailable),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainAccessor_disappears(t *testing.T) {
+	resourceName := "aws_managedblockchain_accessor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedblockchain.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessorConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessorExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmanagedblockchain.ResourceAccessor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Managed Blockchain Accessor ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn
+
+		_, err := tfmanagedblockchain.FindAccessorByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAccessorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_managedblockchain_accessor" {
+			continue
+		}
+
+		_, err := tfmanagedblockchain.FindAccessorByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Managed Blockchain Accessor %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAccessorConfig_basic() string {
+	return `
+resource "aws_managedblockchain_accessor" "test" {}
+`
+}
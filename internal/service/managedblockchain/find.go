@@ -0,0 +1,112 @@
+package managedblockchain
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAccessorByID(ctx context.Context, conn *managedblockchain.ManagedBlockchain, id string) (*managedblockchain.Accessor, error) {
+	input := &managedblockchain.GetAccessorInput{
+		AccessorId: aws.String(id),
+	}
+
+	output, err := conn.GetAccessorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Accessor == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Accessor.Status); status == managedblockchain.AccessorStatusDeleted || status == managedblockchain.AccessorStatusPendingDeletion {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Accessor, nil
+}
+
+func FindProposalByTwoPartKey(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, proposalID string) (*managedblockchain.Proposal, error) {
+	input := &managedblockchain.GetProposalInput{
+		NetworkId:  aws.String(networkID),
+		ProposalId: aws.String(proposalID),
+	}
+
+	output, err := conn.GetProposalWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Proposal == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Proposal, nil
+}
+
+func FindProposalVoteByThreePartKey(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, proposalID, memberID string) (*managedblockchain.VoteSummary, error) {
+	input := &managedblockchain.ListProposalVotesInput{
+		NetworkId:  aws.String(networkID),
+		ProposalId: aws.String(proposalID),
+	}
+	var output *managedblockchain.VoteSummary
+
+	err := conn.ListProposalVotesPagesWithContext(ctx, input, func(page *managedblockchain.ListProposalVotesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProposalVotes {
+			if aws.StringValue(v.MemberId) == memberID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
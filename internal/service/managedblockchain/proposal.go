@@ -0,0 +1,340 @@
+package managedblockchain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProposal() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProposalCreate,
+		ReadWithoutTimeout:   resourceProposalRead,
+		UpdateWithoutTimeout: resourceProposalUpdate,
+		DeleteWithoutTimeout: resourceProposalDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"invitations": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"actions.0.invitations", "actions.0.removals"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"principal": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						"removals": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"actions.0.invitations", "actions.0.removals"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"member_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"no_vote_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"outstanding_vote_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"proposal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"yes_vote_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProposalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	networkID := d.Get("network_id").(string)
+	input := &managedblockchain.CreateProposalInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		MemberId:           aws.String(d.Get("member_id").(string)),
+		NetworkId:          aws.String(networkID),
+	}
+
+	if v, ok := d.GetOk("actions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Actions = expandProposalActions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Managed Blockchain Proposal: %s", input)
+	output, err := conn.CreateProposalWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Managed Blockchain Proposal (%s): %s", networkID, err)
+	}
+
+	d.SetId(ProposalCreateResourceID(networkID, aws.StringValue(output.ProposalId)))
+
+	return resourceProposalRead(ctx, d, meta)
+}
+
+func resourceProposalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	networkID, proposalID, err := ProposalParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	proposal, err := FindProposalByTwoPartKey(ctx, conn, networkID, proposalID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Proposal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Managed Blockchain Proposal (%s): %s", d.Id(), err)
+	}
+
+	if proposal.Actions != nil {
+		if err := d.Set("actions", []interface{}{flattenProposalActions(proposal.Actions)}); err != nil {
+			return diag.Errorf("setting actions: %s", err)
+		}
+	} else {
+		d.Set("actions", nil)
+	}
+	d.Set("arn", proposal.Arn)
+	d.Set("description", proposal.Description)
+	if proposal.ExpirationDate != nil {
+		d.Set("expiration_date", aws.TimeValue(proposal.ExpirationDate).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_date", nil)
+	}
+	d.Set("member_id", proposal.ProposedByMemberId)
+	d.Set("network_id", proposal.NetworkId)
+	d.Set("no_vote_count", proposal.NoVoteCount)
+	d.Set("outstanding_vote_count", proposal.OutstandingVoteCount)
+	d.Set("proposal_id", proposal.ProposalId)
+	d.Set("status", proposal.Status)
+	d.Set("yes_vote_count", proposal.YesVoteCount)
+
+	tags := KeyValueTags(proposal.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceProposalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Managed Blockchain Proposal (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceProposalRead(ctx, d, meta)
+}
+
+func resourceProposalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Proposals cannot be deleted. They expire once the network's voting period has elapsed.
+	log.Printf("[WARN] Managed Blockchain Proposal (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+const proposalResourceIDSeparator = ","
+
+func ProposalCreateResourceID(networkID, proposalID string) string {
+	parts := []string{networkID, proposalID}
+	id := strings.Join(parts, proposalResourceIDSeparator)
+
+	return id
+}
+
+func ProposalParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, proposalResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NETWORK-ID%[2]sPROPOSAL-ID", id, proposalResourceIDSeparator)
+}
+
+func expandProposalActions(tfMap map[string]interface{}) *managedblockchain.ProposalActions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &managedblockchain.ProposalActions{}
+
+	if v, ok := tfMap["invitations"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Invitations = append(apiObject.Invitations, &managedblockchain.InviteAction{
+				Principal: aws.String(tfMap["principal"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["removals"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Removals = append(apiObject.Removals, &managedblockchain.RemoveAction{
+				MemberId: aws.String(tfMap["member_id"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenProposalActions(apiObject *managedblockchain.ProposalActions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Invitations; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"principal": aws.StringValue(apiObject.Principal),
+			})
+		}
+
+		tfMap["invitations"] = tfList
+	}
+
+	if v := apiObject.Removals; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"member_id": aws.StringValue(apiObject.MemberId),
+			})
+		}
+
+		tfMap["removals"] = tfList
+	}
+
+	return tfMap
+}
@@ -0,0 +1,120 @@
+package managedblockchain_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+)
+
+// Proposals and votes require an existing Hyperledger Fabric network and member.
+func testAccProposalPreCheck(t *testing.T) (string, string) {
+	networkID := os.Getenv("MANAGEDBLOCKCHAIN_NETWORK_ID")
+	if networkID == "" {
+		t.Skip("Environment variable MANAGEDBLOCKCHAIN_NETWORK_ID is not set")
+	}
+
+	memberID := os.Getenv("MANAGEDBLOCKCHAIN_MEMBER_ID")
+	if memberID == "" {
+		t.Skip("Environment variable MANAGEDBLOCKCHAIN_MEMBER_ID is not set")
+	}
+
+	return networkID, memberID
+}
+
+func TestAccManagedBlockchainProposal_basic(t *testing.T) {
+	networkID, memberID := testAccProposalPreCheck(t)
+	resourceName := "aws_managedblockchain_proposal.test"
+	voteResourceName := "aws_managedblockchain_proposal_vote.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedblockchain.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProposalConfig_basic(networkID, memberID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProposalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.invitations.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "actions.0.invitations.0.principal", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttr(resourceName, "member_id", memberID),
+					resource.TestCheckResourceAttr(resourceName, "network_id", networkID),
+					resource.TestCheckResourceAttrSet(resourceName, "proposal_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(voteResourceName, "proposal_id", resourceName, "proposal_id"),
+					resource.TestCheckResourceAttr(voteResourceName, "vote", managedblockchain.VoteValueYes),
+					resource.TestCheckResourceAttr(voteResourceName, "voter_member_id", memberID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      voteResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProposalExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		networkID, proposalID, err := tfmanagedblockchain.ProposalParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn
+
+		_, err = tfmanagedblockchain.FindProposalByTwoPartKey(context.Background(), conn, networkID, proposalID)
+
+		return err
+	}
+}
+
+func testAccProposalConfig_basic(networkID, memberID string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_managedblockchain_proposal" "test" {
+  network_id  = %[1]q
+  member_id   = %[2]q
+  description = "test"
+
+  actions {
+    invitations {
+      principal = data.aws_caller_identity.current.account_id
+    }
+  }
+}
+
+resource "aws_managedblockchain_proposal_vote" "test" {
+  network_id      = %[1]q
+  proposal_id     = aws_managedblockchain_proposal.test.proposal_id
+  voter_member_id = %[2]q
+  vote            = "YES"
+}
+`, networkID, memberID)
+}
@@ -0,0 +1,138 @@
+package managedblockchain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceProposalVote() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProposalVoteCreate,
+		ReadWithoutTimeout:   resourceProposalVoteRead,
+		DeleteWithoutTimeout: resourceProposalVoteDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"proposal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vote": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedblockchain.VoteValue_Values(), false),
+			},
+			"voter_member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"voter_member_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceProposalVoteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+
+	networkID := d.Get("network_id").(string)
+	proposalID := d.Get("proposal_id").(string)
+	memberID := d.Get("voter_member_id").(string)
+	id := ProposalVoteCreateResourceID(networkID, proposalID, memberID)
+	input := &managedblockchain.VoteOnProposalInput{
+		NetworkId:     aws.String(networkID),
+		ProposalId:    aws.String(proposalID),
+		Vote:          aws.String(d.Get("vote").(string)),
+		VoterMemberId: aws.String(memberID),
+	}
+
+	log.Printf("[DEBUG] Creating Managed Blockchain Proposal Vote: %s", input)
+	_, err := conn.VoteOnProposalWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Managed Blockchain Proposal Vote (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceProposalVoteRead(ctx, d, meta)
+}
+
+func resourceProposalVoteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+
+	networkID, proposalID, memberID, err := ProposalVoteParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vote, err := FindProposalVoteByThreePartKey(ctx, conn, networkID, proposalID, memberID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Proposal Vote (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Managed Blockchain Proposal Vote (%s): %s", d.Id(), err)
+	}
+
+	d.Set("network_id", networkID)
+	d.Set("proposal_id", proposalID)
+	d.Set("vote", vote.Vote)
+	d.Set("voter_member_id", vote.MemberId)
+	d.Set("voter_member_name", vote.MemberName)
+
+	return nil
+}
+
+func resourceProposalVoteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Votes cannot be withdrawn.
+	log.Printf("[WARN] Managed Blockchain Proposal Vote (%s) cannot be withdrawn, removing from state", d.Id())
+
+	return nil
+}
+
+const proposalVoteResourceIDSeparator = ","
+
+func ProposalVoteCreateResourceID(networkID, proposalID, memberID string) string {
+	parts := []string{networkID, proposalID, memberID}
+	id := strings.Join(parts, proposalVoteResourceIDSeparator)
+
+	return id
+}
+
+func ProposalVoteParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, proposalVoteResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NETWORK-ID%[2]sPROPOSAL-ID%[2]sMEMBER-ID", id, proposalVoteResourceIDSeparator)
+}
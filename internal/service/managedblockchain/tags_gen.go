@@ -0,0 +1,63 @@
+// Code generated by internal/generate/tags/main.go; DO NOT EDIT.
+package managedblockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/managedblockchain/managedblockchainiface"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// map[string]*string handling
+
+// Tags returns managedblockchain service tags.
+func Tags(tags tftags.KeyValueTags) map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// KeyValueTags creates KeyValueTags from managedblockchain service tags.
+func KeyValueTags(tags map[string]*string) tftags.KeyValueTags {
+	return tftags.New(tags)
+}
+
+// UpdateTags updates managedblockchain service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func UpdateTags(conn managedblockchainiface.ManagedBlockchainAPI, identifier string, oldTags interface{}, newTags interface{}) error {
+	return UpdateTagsWithContext(context.Background(), conn, identifier, oldTags, newTags)
+}
+func UpdateTagsWithContext(ctx context.Context, conn managedblockchainiface.ManagedBlockchainAPI, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &managedblockchain.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.IgnoreAWS().Keys()),
+		}
+
+		_, err := conn.UntagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &managedblockchain.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        Tags(updatedTags.IgnoreAWS()),
+		}
+
+		_, err := conn.TagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package mwaa
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/mwaa"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -252,7 +254,10 @@ func ResourceEnvironment() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffWorkers,
+		),
 	}
 }
 
@@ -496,14 +501,26 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.WeeklyMaintenanceWindowStart = aws.String(d.Get("weekly_maintenance_window_start").(string))
 		}
 
+		// The environment's last update before this one is recorded so that its status isn't mistaken for this update's.
+		environment, err := FindEnvironmentByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("reading MWAA Environment (%s): %s", d.Id(), err)
+		}
+
+		var previousUpdateCreatedAt *time.Time
+		if environment.LastUpdate != nil {
+			previousUpdateCreatedAt = environment.LastUpdate.CreatedAt
+		}
+
 		log.Printf("[INFO] Updating MWAA Environment: %s", input)
-		_, err := conn.UpdateEnvironmentWithContext(ctx, input)
+		_, err = conn.UpdateEnvironmentWithContext(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating MWAA Environment (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitEnvironmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitEnvironmentUpdated(ctx, conn, d.Id(), previousUpdateCreatedAt, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.Errorf("waiting for MWAA Environment (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -542,6 +559,21 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+func customizeDiffWorkers(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Unknown values, e.g. computed defaults, are not validated.
+	if !diff.NewValueKnown("max_workers") || !diff.NewValueKnown("min_workers") {
+		return nil
+	}
+
+	maxWorkers, minWorkers := diff.Get("max_workers").(int), diff.Get("min_workers").(int)
+
+	if maxWorkers > 0 && minWorkers > 0 && minWorkers > maxWorkers {
+		return fmt.Errorf("min_workers (%d) must be less than or equal to max_workers (%d)", minWorkers, maxWorkers)
+	}
+
+	return nil
+}
+
 func environmentModuleLoggingConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -626,7 +658,7 @@ func waitEnvironmentCreated(ctx context.Context, conn *mwaa.MWAA, name string, t
 	return nil, err
 }
 
-func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, timeout time.Duration) (*mwaa.Environment, error) {
+func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, previousUpdateCreatedAt *time.Time, timeout time.Duration) (*mwaa.Environment, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{mwaa.EnvironmentStatusUpdating},
 		Target:  []string{mwaa.EnvironmentStatusAvailable},
@@ -641,6 +673,10 @@ func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, t
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.LastUpdate.Error.ErrorCode), aws.StringValue(v.LastUpdate.Error.ErrorMessage)))
 		}
 
+		if err == nil {
+			err = environmentUpdateError(v.LastUpdate, previousUpdateCreatedAt)
+		}
+
 		return v, err
 	}
 
@@ -668,6 +704,26 @@ func waitEnvironmentDeleted(ctx context.Context, conn *mwaa.MWAA, name string, t
 	return nil, err
 }
 
+// environmentUpdateError returns an error if the environment's last update failed and is not the update created at previousUpdateCreatedAt.
+// A failed update, e.g. an Airflow version upgrade, is rolled back and the environment returns to AVAILABLE.
+// The status of the previous update is ignored, as it may still be reported before the new update is picked up.
+// Update creation times are compared with each other rather than with the local clock, which may be skewed from the service's.
+func environmentUpdateError(lastUpdate *mwaa.LastUpdate, previousUpdateCreatedAt *time.Time) error {
+	if lastUpdate == nil || aws.StringValue(lastUpdate.Status) != mwaa.UpdateStatusFailed {
+		return nil
+	}
+
+	if aws.TimeValue(lastUpdate.CreatedAt).Equal(aws.TimeValue(previousUpdateCreatedAt)) {
+		return nil
+	}
+
+	if lastUpdate.Error != nil {
+		return fmt.Errorf("update failed and was rolled back: %s: %s", aws.StringValue(lastUpdate.Error.ErrorCode), aws.StringValue(lastUpdate.Error.ErrorMessage))
+	}
+
+	return errors.New("update failed and was rolled back")
+}
+
 func expandEnvironmentLoggingConfiguration(l []interface{}) *mwaa.LoggingConfigurationInput {
 	if len(l) == 0 || l[0] == nil {
 		return nil
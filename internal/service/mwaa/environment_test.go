@@ -3,8 +3,11 @@ package mwaa_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mwaa"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -15,6 +18,104 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestEnvironmentUpdateError(t *testing.T) {
+	t.Parallel()
+
+	// Update creation times are reported by the service to the second.
+	previousUpdateCreatedAt := time.Date(2023, time.January, 10, 12, 0, 0, 0, time.UTC)
+	failedUpdateError := &mwaa.UpdateError{
+		ErrorCode:    aws.String("UpgradeFailed"),
+		ErrorMessage: aws.String("update failed"),
+	}
+
+	testCases := []struct {
+		Name                    string
+		LastUpdate              *mwaa.LastUpdate
+		PreviousUpdateCreatedAt *time.Time
+		ExpectError             bool
+	}{
+		{
+			Name:                    "no last update",
+			PreviousUpdateCreatedAt: aws.Time(previousUpdateCreatedAt),
+		},
+		{
+			Name: "succeeded",
+			LastUpdate: &mwaa.LastUpdate{
+				CreatedAt: aws.Time(previousUpdateCreatedAt.Add(time.Minute)),
+				Status:    aws.String(mwaa.UpdateStatusSuccess),
+			},
+			PreviousUpdateCreatedAt: aws.Time(previousUpdateCreatedAt),
+		},
+		{
+			Name: "previous update failed",
+			LastUpdate: &mwaa.LastUpdate{
+				CreatedAt: aws.Time(previousUpdateCreatedAt),
+				Error:     failedUpdateError,
+				Status:    aws.String(mwaa.UpdateStatusFailed),
+			},
+			PreviousUpdateCreatedAt: aws.Time(previousUpdateCreatedAt),
+		},
+		{
+			Name: "failed",
+			LastUpdate: &mwaa.LastUpdate{
+				CreatedAt: aws.Time(previousUpdateCreatedAt.Add(24 * time.Hour)),
+				Error:     failedUpdateError,
+				Status:    aws.String(mwaa.UpdateStatusFailed),
+			},
+			PreviousUpdateCreatedAt: aws.Time(previousUpdateCreatedAt),
+			ExpectError:             true,
+		},
+		{
+			Name: "failed without previous update",
+			LastUpdate: &mwaa.LastUpdate{
+				CreatedAt: aws.Time(previousUpdateCreatedAt),
+				Error:     failedUpdateError,
+				Status:    aws.String(mwaa.UpdateStatusFailed),
+			},
+			ExpectError: true,
+		},
+		{
+			// The update was requested at 13:00:00.700 local time and its creation time is reported truncated to 13:00:00.
+			Name: "failed same second",
+			LastUpdate: &mwaa.LastUpdate{
+				CreatedAt: aws.Time(previousUpdateCreatedAt.Add(time.Hour)),
+				Error:     failedUpdateError,
+				Status:    aws.String(mwaa.UpdateStatusFailed),
+			},
+			PreviousUpdateCreatedAt: aws.Time(previousUpdateCreatedAt),
+			ExpectError:             true,
+		},
+		{
+			// The update was requested at 13:00:00 local time, but the service's clock is five minutes behind.
+			Name: "failed skewed clock",
+			LastUpdate: &mwaa.LastUpdate{
+				CreatedAt: aws.Time(previousUpdateCreatedAt.Add(55 * time.Minute)),
+				Error:     failedUpdateError,
+				Status:    aws.String(mwaa.UpdateStatusFailed),
+			},
+			PreviousUpdateCreatedAt: aws.Time(previousUpdateCreatedAt),
+			ExpectError:             true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfmwaa.EnvironmentUpdateError(testCase.LastUpdate, testCase.PreviousUpdateCreatedAt)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccMWAAEnvironment_basic(t *testing.T) {
 	var environment mwaa.Environment
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -343,6 +444,54 @@ func TestAccMWAAEnvironment_pluginsS3ObjectVersion(t *testing.T) {
 	})
 }
 
+func TestAccMWAAEnvironment_airflowVersionUpgrade(t *testing.T) {
+	var environment mwaa.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mwaa.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_airflowVersion(rName, "2.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "airflow_version", "2.2.2"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_airflowVersion(rName, "2.4.3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName, &environment),
+					testAccCheckEnvironmentNotRecreated(&environment, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "airflow_version", "2.4.3"),
+					resource.TestCheckResourceAttr(resourceName, "last_updated.0.status", mwaa.UpdateStatusSuccess),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMWAAEnvironment_workersInvalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mwaa.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvironmentConfig_workers(rName, 5, 2),
+				ExpectError: regexp.MustCompile(`min_workers \(5\) must be less than or equal to max_workers \(2\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckEnvironmentExists(n string, v *mwaa.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -821,3 +970,58 @@ resource "aws_s3_object" "plugins" {
 }
 `, rName, content))
 }
+
+func testAccCheckEnvironmentNotRecreated(before *mwaa.Environment, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAConn
+
+		after, err := tfmwaa.FindEnvironmentByName(context.Background(), conn, s.RootModule().Resources[n].Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if !aws.TimeValue(before.CreatedAt).Equal(aws.TimeValue(after.CreatedAt)) {
+			return fmt.Errorf("MWAA Environment (%s) recreated", aws.StringValue(after.Name))
+		}
+
+		return nil
+	}
+}
+
+func testAccEnvironmentConfig_airflowVersion(rName, airflowVersion string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  airflow_version    = %[2]q
+  dag_s3_path        = aws_s3_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+`, rName, airflowVersion))
+}
+
+func testAccEnvironmentConfig_workers(rName string, minWorkers, maxWorkers int) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  max_workers        = %[3]d
+  min_workers        = %[2]d
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+`, rName, minWorkers, maxWorkers))
+}
@@ -0,0 +1,6 @@
+package mwaa
+
+// Exports for use in tests only.
+var (
+	EnvironmentUpdateError = environmentUpdateError
+)
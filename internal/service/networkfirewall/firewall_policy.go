@@ -59,9 +59,14 @@ func ResourceFirewallPolicy() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"rule_order": {
 										Type:         schema.TypeString,
-										Required:     true,
+										Optional:     true,
 										ValidateFunc: validation.StringInSlice(networkfirewall.RuleOrder_Values(), false),
 									},
+									"stream_exception_policy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(networkfirewall.StreamExceptionPolicy_Values(), false),
+									},
 								},
 							},
 						},
@@ -359,9 +364,12 @@ func expandStatefulEngineOptions(l []interface{}) *networkfirewall.StatefulEngin
 	options := &networkfirewall.StatefulEngineOptions{}
 
 	m := l[0].(map[string]interface{})
-	if v, ok := m["rule_order"].(string); ok {
+	if v, ok := m["rule_order"].(string); ok && v != "" {
 		options.RuleOrder = aws.String(v)
 	}
+	if v, ok := m["stream_exception_policy"].(string); ok && v != "" {
+		options.StreamExceptionPolicy = aws.String(v)
+	}
 
 	return options
 }
@@ -501,7 +509,8 @@ func flattenStatefulEngineOptions(options *networkfirewall.StatefulEngineOptions
 	}
 
 	m := map[string]interface{}{
-		"rule_order": aws.StringValue(options.RuleOrder),
+		"rule_order":              aws.StringValue(options.RuleOrder),
+		"stream_exception_policy": aws.StringValue(options.StreamExceptionPolicy),
 	}
 
 	return []interface{}{m}
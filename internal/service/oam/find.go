@@ -0,0 +1,44 @@
+package oam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/oam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func FindAttachedLinksBySinkIdentifier(ctx context.Context, conn *oam.OAM, sinkIdentifier string) ([]*oam.ListAttachedLinksItem, error) {
+	input := &oam.ListAttachedLinksInput{
+		SinkIdentifier: aws.String(sinkIdentifier),
+	}
+	var output []*oam.ListAttachedLinksItem
+
+	err := conn.ListAttachedLinksPagesWithContext(ctx, input, func(page *oam.ListAttachedLinksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, oam.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
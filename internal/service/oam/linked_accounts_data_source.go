@@ -0,0 +1,116 @@
+package oam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/oam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceLinkedAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLinkedAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"linked_accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_types": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"sink_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceLinkedAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn
+
+	sinkIdentifier := d.Get("sink_identifier").(string)
+	links, err := FindAttachedLinksBySinkIdentifier(ctx, conn, sinkIdentifier)
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Observability Access Manager Sink (%s) attached links: %s", sinkIdentifier, err)
+	}
+
+	linkedAccounts, err := flattenListAttachedLinksItems(links)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var accountIDs []string
+
+	for _, v := range linkedAccounts {
+		accountIDs = append(accountIDs, v.(map[string]interface{})["account_id"].(string))
+	}
+
+	d.SetId(sinkIdentifier)
+	d.Set("account_ids", accountIDs)
+	if err := d.Set("linked_accounts", linkedAccounts); err != nil {
+		return diag.Errorf("setting linked_accounts: %s", err)
+	}
+
+	return nil
+}
+
+func flattenListAttachedLinksItems(apiObjects []*oam.ListAttachedLinksItem) ([]interface{}, error) {
+	if len(apiObjects) == 0 {
+		return nil, nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		linkARN := aws.StringValue(apiObject.LinkArn)
+		parsedARN, err := arn.Parse(linkARN)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"account_id":     parsedARN.AccountID,
+			"label":          aws.StringValue(apiObject.Label),
+			"link_arn":       linkARN,
+			"resource_types": aws.StringValueSlice(apiObject.ResourceTypes),
+		})
+	}
+
+	return tfList, nil
+}
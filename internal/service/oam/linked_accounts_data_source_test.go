@@ -0,0 +1,46 @@
+package oam_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/oam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccObservabilityAccessManagerLinkedAccountsDataSource_basic(t *testing.T) {
+	sinkIdentifier := os.Getenv("OAM_SINK_IDENTIFIER")
+	if sinkIdentifier == "" {
+		t.Skip("Environment variable OAM_SINK_IDENTIFIER is not set")
+	}
+
+	dataSourceName := "data.aws_oam_linked_accounts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, oam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkedAccountsDataSourceConfig_basic(sinkIdentifier),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", sinkIdentifier),
+					resource.TestCheckResourceAttr(dataSourceName, "sink_identifier", sinkIdentifier),
+					resource.TestCheckResourceAttrSet(dataSourceName, "account_ids.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "linked_accounts.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLinkedAccountsDataSourceConfig_basic(sinkIdentifier string) string {
+	return fmt.Sprintf(`
+data "aws_oam_linked_accounts" "test" {
+  sink_identifier = %[1]q
+}
+`, sinkIdentifier)
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -901,7 +900,7 @@ func resourceDomainUpdate(d *schema.ResourceData, meta interface{}) error {
 		if d.HasChange("access_policies") {
 			o, n := d.GetChange("access_policies")
 
-			if equivalent, err := awspolicy.PoliciesAreEquivalent(o.(string), n.(string)); err != nil || !equivalent {
+			if equivalent, err := verify.PoliciesAreEquivalent(o.(string), n.(string)); err != nil || !equivalent {
 				input.AccessPolicies = aws.String(d.Get("access_policies").(string))
 			}
 		}
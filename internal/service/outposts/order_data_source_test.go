@@ -0,0 +1,46 @@
+package outposts_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOutpostsOrderDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_outposts_order.test"
+	ordersDataSourceName := "data.aws_outposts_orders.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, outposts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "order_id", ordersDataSourceName, "order_ids.0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "outpost_id", ordersDataSourceName, "orders.0.outpost_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "line_items.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "payment_option"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", ordersDataSourceName, "orders.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrderDataSourceConfig_basic() string {
+	return `
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_orders" "test" {
+  outpost_identifier = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+data "aws_outposts_order" "test" {
+  order_id = data.aws_outposts_orders.test.order_ids[0]
+}
+`
+}
@@ -0,0 +1,129 @@
+package outposts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceOrders() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrdersRead,
+
+		Schema: map[string]*schema.Schema{
+			"order_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"orders": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"line_item_counts_by_status": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"order_fulfilled_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"order_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"order_submission_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"order_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outpost_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"outpost_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrdersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OutpostsConn
+
+	input := &outposts.ListOrdersInput{}
+
+	if v, ok := d.GetOk("outpost_identifier"); ok {
+		input.OutpostIdentifierFilter = aws.String(v.(string))
+	}
+
+	var orderIDs []string
+	var orders []interface{}
+
+	err := conn.ListOrdersPages(input, func(page *outposts.ListOrdersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, order := range page.Orders {
+			if order == nil {
+				continue
+			}
+
+			orderIDs = append(orderIDs, aws.StringValue(order.OrderId))
+			orders = append(orders, flattenOrderSummary(order))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Outposts Orders: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("order_ids", orderIDs)
+
+	if err := d.Set("orders", orders); err != nil {
+		return fmt.Errorf("error setting orders: %w", err)
+	}
+
+	return nil
+}
+
+func flattenOrderSummary(apiObject *outposts.OrderSummary) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"line_item_counts_by_status": aws.Int64ValueMap(apiObject.LineItemCountsByStatus),
+		"order_id":                   aws.StringValue(apiObject.OrderId),
+		"order_type":                 aws.StringValue(apiObject.OrderType),
+		"outpost_id":                 aws.StringValue(apiObject.OutpostId),
+		"status":                     aws.StringValue(apiObject.Status),
+	}
+
+	if v := apiObject.OrderFulfilledDate; v != nil {
+		tfMap["order_fulfilled_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.OrderSubmissionDate; v != nil {
+		tfMap["order_submission_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,38 @@
+package outposts_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOutpostsOrdersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_outposts_orders.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, outposts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrdersDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "order_ids.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "orders.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrdersDataSourceConfig_basic() string {
+	return `
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_orders" "test" {
+  outpost_identifier = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+`
+}
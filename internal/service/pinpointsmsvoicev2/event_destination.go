@@ -0,0 +1,363 @@
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEventDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEventDestinationCreate,
+		ReadWithoutTimeout:   resourceEventDestinationRead,
+		UpdateWithoutTimeout: resourceEventDestinationUpdate,
+		DeleteWithoutTimeout: resourceEventDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloudwatch_logs_destination": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"log_group_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"cloudwatch_logs_destination", "kinesis_firehose_destination", "sns_destination"},
+			},
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"event_destination_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"kinesis_firehose_destination": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delivery_stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"iam_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"cloudwatch_logs_destination", "kinesis_firehose_destination", "sns_destination"},
+			},
+			"matching_event_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(pinpointsmsvoicev2.EventType_Values(), false),
+				},
+			},
+			"sns_destination": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"cloudwatch_logs_destination", "kinesis_firehose_destination", "sns_destination"},
+			},
+		},
+	}
+}
+
+func resourceEventDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	configurationSetName := d.Get("configuration_set_name").(string)
+	eventDestinationName := d.Get("event_destination_name").(string)
+	id := EventDestinationCreateResourceID(configurationSetName, eventDestinationName)
+	input := &pinpointsmsvoicev2.CreateEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestinationName: aws.String(eventDestinationName),
+		MatchingEventTypes:   flex.ExpandStringSet(d.Get("matching_event_types").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("cloudwatch_logs_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CloudWatchLogsDestination = expandCloudWatchLogsDestination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("kinesis_firehose_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.KinesisFirehoseDestination = expandKinesisFirehoseDestination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sns_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SnsDestination = expandSNSDestination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateEventDestinationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating End User Messaging SMS Event Destination (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	// Event destinations are created enabled.
+	if !d.Get("enabled").(bool) {
+		_, err := conn.UpdateEventDestinationWithContext(ctx, &pinpointsmsvoicev2.UpdateEventDestinationInput{
+			ConfigurationSetName: aws.String(configurationSetName),
+			Enabled:              aws.Bool(false),
+			EventDestinationName: aws.String(eventDestinationName),
+		})
+
+		if err != nil {
+			return diag.Errorf("disabling End User Messaging SMS Event Destination (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceEventDestinationRead(ctx, d, meta)
+}
+
+func resourceEventDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	configurationSetName, eventDestinationName, err := EventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindEventDestinationByTwoPartKey(ctx, conn, configurationSetName, eventDestinationName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] End User Messaging SMS Event Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading End User Messaging SMS Event Destination (%s): %s", d.Id(), err)
+	}
+
+	if output.CloudWatchLogsDestination != nil {
+		if err := d.Set("cloudwatch_logs_destination", []interface{}{flattenCloudWatchLogsDestination(output.CloudWatchLogsDestination)}); err != nil {
+			return diag.Errorf("setting cloudwatch_logs_destination: %s", err)
+		}
+	} else {
+		d.Set("cloudwatch_logs_destination", nil)
+	}
+	d.Set("configuration_set_name", configurationSetName)
+	d.Set("enabled", output.Enabled)
+	d.Set("event_destination_name", output.EventDestinationName)
+	if output.KinesisFirehoseDestination != nil {
+		if err := d.Set("kinesis_firehose_destination", []interface{}{flattenKinesisFirehoseDestination(output.KinesisFirehoseDestination)}); err != nil {
+			return diag.Errorf("setting kinesis_firehose_destination: %s", err)
+		}
+	} else {
+		d.Set("kinesis_firehose_destination", nil)
+	}
+	d.Set("matching_event_types", aws.StringValueSlice(output.MatchingEventTypes))
+	if output.SnsDestination != nil {
+		if err := d.Set("sns_destination", []interface{}{flattenSNSDestination(output.SnsDestination)}); err != nil {
+			return diag.Errorf("setting sns_destination: %s", err)
+		}
+	} else {
+		d.Set("sns_destination", nil)
+	}
+
+	return nil
+}
+
+func resourceEventDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	configurationSetName, eventDestinationName, err := EventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &pinpointsmsvoicev2.UpdateEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		Enabled:              aws.Bool(d.Get("enabled").(bool)),
+		EventDestinationName: aws.String(eventDestinationName),
+		MatchingEventTypes:   flex.ExpandStringSet(d.Get("matching_event_types").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("cloudwatch_logs_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CloudWatchLogsDestination = expandCloudWatchLogsDestination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("kinesis_firehose_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.KinesisFirehoseDestination = expandKinesisFirehoseDestination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sns_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SnsDestination = expandSNSDestination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err = conn.UpdateEventDestinationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating End User Messaging SMS Event Destination (%s): %s", d.Id(), err)
+	}
+
+	return resourceEventDestinationRead(ctx, d, meta)
+}
+
+func resourceEventDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	configurationSetName, eventDestinationName, err := EventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting End User Messaging SMS Event Destination: %s", d.Id())
+	_, err = conn.DeleteEventDestinationWithContext(ctx, &pinpointsmsvoicev2.DeleteEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestinationName: aws.String(eventDestinationName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting End User Messaging SMS Event Destination (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const eventDestinationResourceIDSeparator = "/"
+
+func EventDestinationCreateResourceID(configurationSetName, eventDestinationName string) string {
+	parts := []string{configurationSetName, eventDestinationName}
+	id := strings.Join(parts, eventDestinationResourceIDSeparator)
+
+	return id
+}
+
+func EventDestinationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, eventDestinationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected configuration-set-name%[2]sevent-destination-name", id, eventDestinationResourceIDSeparator)
+}
+
+func expandCloudWatchLogsDestination(tfMap map[string]interface{}) *pinpointsmsvoicev2.CloudWatchLogsDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &pinpointsmsvoicev2.CloudWatchLogsDestination{
+		IamRoleArn:  aws.String(tfMap["iam_role_arn"].(string)),
+		LogGroupArn: aws.String(tfMap["log_group_arn"].(string)),
+	}
+}
+
+func flattenCloudWatchLogsDestination(apiObject *pinpointsmsvoicev2.CloudWatchLogsDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"iam_role_arn":  aws.StringValue(apiObject.IamRoleArn),
+		"log_group_arn": aws.StringValue(apiObject.LogGroupArn),
+	}
+}
+
+func expandKinesisFirehoseDestination(tfMap map[string]interface{}) *pinpointsmsvoicev2.KinesisFirehoseDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &pinpointsmsvoicev2.KinesisFirehoseDestination{
+		DeliveryStreamArn: aws.String(tfMap["delivery_stream_arn"].(string)),
+		IamRoleArn:        aws.String(tfMap["iam_role_arn"].(string)),
+	}
+}
+
+func flattenKinesisFirehoseDestination(apiObject *pinpointsmsvoicev2.KinesisFirehoseDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"delivery_stream_arn": aws.StringValue(apiObject.DeliveryStreamArn),
+		"iam_role_arn":        aws.StringValue(apiObject.IamRoleArn),
+	}
+}
+
+func expandSNSDestination(tfMap map[string]interface{}) *pinpointsmsvoicev2.SnsDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &pinpointsmsvoicev2.SnsDestination{
+		TopicArn: aws.String(tfMap["topic_arn"].(string)),
+	}
+}
+
+func flattenSNSDestination(apiObject *pinpointsmsvoicev2.SnsDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"topic_arn": aws.StringValue(apiObject.TopicArn),
+	}
+}
@@ -0,0 +1,157 @@
+package pinpointsmsvoicev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpointsmsvoicev2 "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointSMSVoiceV2EventDestination_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpointsmsvoicev2_event_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventDestinationConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs_destination.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_firehose_destination.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "matching_event_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "matching_event_types.*", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "sns_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "sns_destination.0.topic_arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventDestinationConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2EventDestination_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpointsmsvoicev2_event_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventDestinationConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDestinationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpointsmsvoicev2.ResourceEventDestination(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEventDestinationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No End User Messaging SMS Event Destination ID is set")
+		}
+
+		configurationSetName, eventDestinationName, err := tfpinpointsmsvoicev2.EventDestinationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+		_, err = tfpinpointsmsvoicev2.FindEventDestinationByTwoPartKey(context.Background(), conn, configurationSetName, eventDestinationName)
+
+		return err
+	}
+}
+
+func testAccCheckEventDestinationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpointsmsvoicev2_event_destination" {
+			continue
+		}
+
+		configurationSetName, eventDestinationName, err := tfpinpointsmsvoicev2.EventDestinationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfpinpointsmsvoicev2.FindEventDestinationByTwoPartKey(context.Background(), conn, configurationSetName, eventDestinationName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("End User Messaging SMS Event Destination %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEventDestinationConfig_basic(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpointsmsvoicev2_configuration_set" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpointsmsvoicev2_event_destination" "test" {
+  configuration_set_name = aws_pinpointsmsvoicev2_configuration_set.test.name
+  event_destination_name = %[1]q
+  enabled                = %[2]t
+  matching_event_types   = ["ALL"]
+
+  sns_destination {
+    topic_arn = aws_sns_topic.test.arn
+  }
+}
+`, rName, enabled)
+}
@@ -0,0 +1,186 @@
+package pinpointsmsvoicev2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindConfigurationSetByName(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, name string) (*pinpointsmsvoicev2.ConfigurationSetInformation, error) {
+	input := &pinpointsmsvoicev2.DescribeConfigurationSetsInput{
+		ConfigurationSetNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeConfigurationSetsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ConfigurationSets) == 0 || output.ConfigurationSets[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ConfigurationSets); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ConfigurationSets[0], nil
+}
+
+func FindEventDestinationByTwoPartKey(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, configurationSetName, eventDestinationName string) (*pinpointsmsvoicev2.EventDestination, error) {
+	configurationSet, err := FindConfigurationSetByName(ctx, conn, configurationSetName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range configurationSet.EventDestinations {
+		if aws.StringValue(v.EventDestinationName) == eventDestinationName {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+func FindOptOutListByName(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, name string) (*pinpointsmsvoicev2.OptOutListInformation, error) {
+	input := &pinpointsmsvoicev2.DescribeOptOutListsInput{
+		OptOutListNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeOptOutListsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.OptOutLists) == 0 || output.OptOutLists[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.OptOutLists); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.OptOutLists[0], nil
+}
+
+func FindPhoneNumberByID(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) (*pinpointsmsvoicev2.PhoneNumberInformation, error) {
+	input := &pinpointsmsvoicev2.DescribePhoneNumbersInput{
+		PhoneNumberIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribePhoneNumbersWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.PhoneNumbers) == 0 || output.PhoneNumbers[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.PhoneNumbers); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	phoneNumber := output.PhoneNumbers[0]
+
+	if status := aws.StringValue(phoneNumber.Status); status == pinpointsmsvoicev2.NumberStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return phoneNumber, nil
+}
+
+func FindPoolByID(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) (*pinpointsmsvoicev2.PoolInformation, error) {
+	input := &pinpointsmsvoicev2.DescribePoolsInput{
+		PoolIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribePoolsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Pools) == 0 || output.Pools[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Pools); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Pools[0], nil
+}
+
+func FindPoolOriginationIdentities(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, poolID string) ([]*pinpointsmsvoicev2.OriginationIdentityMetadata, error) {
+	input := &pinpointsmsvoicev2.ListPoolOriginationIdentitiesInput{
+		PoolId: aws.String(poolID),
+	}
+	var output []*pinpointsmsvoicev2.OriginationIdentityMetadata
+
+	err := conn.ListPoolOriginationIdentitiesPagesWithContext(ctx, input, func(page *pinpointsmsvoicev2.ListPoolOriginationIdentitiesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.OriginationIdentities {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,254 @@
+package pinpointsmsvoicev2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpointsmsvoicev2 "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointSMSVoiceV2PhoneNumber_basic(t *testing.T) {
+	resourceName := "aws_pinpointsmsvoicev2_phone_number.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhoneNumberConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "sms-voice", regexp.MustCompile(`phone-number/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "iso_country_code", "US"),
+					resource.TestCheckResourceAttr(resourceName, "message_type", "TRANSACTIONAL"),
+					resource.TestCheckResourceAttrSet(resourceName, "monthly_leasing_price"),
+					resource.TestCheckResourceAttr(resourceName, "number_capabilities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "number_capabilities.*", "SMS"),
+					resource.TestCheckResourceAttr(resourceName, "number_type", "TOLL_FREE"),
+					resource.TestCheckResourceAttr(resourceName, "opt_out_list_name", "Default"),
+					resource.TestCheckResourceAttrSet(resourceName, "phone_number"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "two_way_channel_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2PhoneNumber_disappears(t *testing.T) {
+	resourceName := "aws_pinpointsmsvoicev2_phone_number.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhoneNumberConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpointsmsvoicev2.ResourcePhoneNumber(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2PhoneNumber_optOutList(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpointsmsvoicev2_phone_number.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhoneNumberConfig_optOutList(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "opt_out_list_name", "aws_pinpointsmsvoicev2_opt_out_list.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPhoneNumberConfig_optOutList(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "opt_out_list_name", "aws_pinpointsmsvoicev2_opt_out_list.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2PhoneNumber_tags(t *testing.T) {
+	resourceName := "aws_pinpointsmsvoicev2_phone_number.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhoneNumberConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPhoneNumberConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPhoneNumberConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPhoneNumberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No End User Messaging SMS Phone Number ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+		_, err := tfpinpointsmsvoicev2.FindPhoneNumberByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckPhoneNumberDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpointsmsvoicev2_phone_number" {
+			continue
+		}
+
+		_, err := tfpinpointsmsvoicev2.FindPhoneNumberByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("End User Messaging SMS Phone Number %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccPhoneNumberConfig_basic = `
+resource "aws_pinpointsmsvoicev2_phone_number" "test" {
+  iso_country_code    = "US"
+  message_type        = "TRANSACTIONAL"
+  number_capabilities = ["SMS"]
+  number_type         = "TOLL_FREE"
+}
+`
+
+func testAccPhoneNumberConfig_optOutList(rName string, selfManagedOptOutsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_pinpointsmsvoicev2_opt_out_list" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpointsmsvoicev2_phone_number" "test" {
+  iso_country_code    = "US"
+  message_type        = "TRANSACTIONAL"
+  number_capabilities = ["SMS"]
+  number_type         = "TOLL_FREE"
+
+  opt_out_list_name             = aws_pinpointsmsvoicev2_opt_out_list.test.name
+  self_managed_opt_outs_enabled = %[2]t
+}
+`, rName, selfManagedOptOutsEnabled)
+}
+
+func testAccPhoneNumberConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpointsmsvoicev2_phone_number" "test" {
+  iso_country_code    = "US"
+  message_type        = "TRANSACTIONAL"
+  number_capabilities = ["SMS"]
+  number_type         = "TOLL_FREE"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccPhoneNumberConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpointsmsvoicev2_phone_number" "test" {
+  iso_country_code    = "US"
+  message_type        = "TRANSACTIONAL"
+  number_capabilities = ["SMS"]
+  number_type         = "TOLL_FREE"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,311 @@
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePool() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePoolCreate,
+		ReadWithoutTimeout:   resourcePoolRead,
+		UpdateWithoutTimeout: resourcePoolUpdate,
+		DeleteWithoutTimeout: resourcePoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"iso_country_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 2),
+			},
+			"message_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(pinpointsmsvoicev2.MessageType_Values(), false),
+			},
+			"opt_out_list_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"origination_identities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"self_managed_opt_outs_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"shared_routes_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"two_way_channel_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"two_way_channel_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	// A pool is created with a single origination identity; any others are associated afterwards.
+	originationIdentities := d.Get("origination_identities").(*schema.Set).List()
+	isoCountryCode := d.Get("iso_country_code").(string)
+	input := &pinpointsmsvoicev2.CreatePoolInput{
+		ClientToken:               aws.String(resource.UniqueId()),
+		DeletionProtectionEnabled: aws.Bool(d.Get("deletion_protection_enabled").(bool)),
+		IsoCountryCode:            aws.String(isoCountryCode),
+		MessageType:               aws.String(d.Get("message_type").(string)),
+		OriginationIdentity:       aws.String(originationIdentities[0].(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreatePoolWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating End User Messaging SMS Pool: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PoolId))
+
+	if _, err := waitPoolActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for End User Messaging SMS Pool (%s) create: %s", d.Id(), err)
+	}
+
+	for _, v := range originationIdentities[1:] {
+		if err := associateOriginationIdentity(ctx, conn, d.Id(), v.(string), isoCountryCode); err != nil {
+			return diag.Errorf("associating End User Messaging SMS Pool (%s) origination identity (%s): %s", d.Id(), v, err)
+		}
+	}
+
+	// The remaining settings are only available via UpdatePool.
+	if _, ok := d.GetOk("opt_out_list_name"); ok || d.Get("self_managed_opt_outs_enabled").(bool) || d.Get("shared_routes_enabled").(bool) || d.Get("two_way_channel_enabled").(bool) {
+		if err := updatePool(ctx, conn, d); err != nil {
+			return diag.Errorf("updating End User Messaging SMS Pool (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourcePoolRead(ctx, d, meta)
+}
+
+func resourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindPoolByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] End User Messaging SMS Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading End User Messaging SMS Pool (%s): %s", d.Id(), err)
+	}
+
+	originationIdentities, err := FindPoolOriginationIdentities(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading End User Messaging SMS Pool (%s) origination identities: %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.PoolArn)
+	d.Set("arn", arn)
+	d.Set("deletion_protection_enabled", output.DeletionProtectionEnabled)
+	d.Set("message_type", output.MessageType)
+	d.Set("opt_out_list_name", output.OptOutListName)
+	var originationIdentityARNs []string
+	for _, v := range originationIdentities {
+		originationIdentityARNs = append(originationIdentityARNs, aws.StringValue(v.OriginationIdentityArn))
+	}
+	d.Set("origination_identities", originationIdentityARNs)
+	d.Set("self_managed_opt_outs_enabled", output.SelfManagedOptOutsEnabled)
+	d.Set("shared_routes_enabled", output.SharedRoutesEnabled)
+	d.Set("two_way_channel_arn", output.TwoWayChannelArn)
+	d.Set("two_way_channel_enabled", output.TwoWayEnabled)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for End User Messaging SMS Pool (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	if d.HasChange("origination_identities") {
+		o, n := d.GetChange("origination_identities")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		isoCountryCode := d.Get("iso_country_code").(string)
+
+		// Associate first so that the pool is never left without an origination identity.
+		for _, v := range ns.Difference(os).List() {
+			if err := associateOriginationIdentity(ctx, conn, d.Id(), v.(string), isoCountryCode); err != nil {
+				return diag.Errorf("associating End User Messaging SMS Pool (%s) origination identity (%s): %s", d.Id(), v, err)
+			}
+		}
+
+		for _, v := range os.Difference(ns).List() {
+			_, err := conn.DisassociateOriginationIdentityWithContext(ctx, &pinpointsmsvoicev2.DisassociateOriginationIdentityInput{
+				ClientToken:         aws.String(resource.UniqueId()),
+				IsoCountryCode:      aws.String(isoCountryCode),
+				OriginationIdentity: aws.String(v.(string)),
+				PoolId:              aws.String(d.Id()),
+			})
+
+			if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return diag.Errorf("disassociating End User Messaging SMS Pool (%s) origination identity (%s): %s", d.Id(), v, err)
+			}
+		}
+	}
+
+	if d.HasChanges("deletion_protection_enabled", "opt_out_list_name", "self_managed_opt_outs_enabled", "shared_routes_enabled", "two_way_channel_arn", "two_way_channel_enabled") {
+		if err := updatePool(ctx, conn, d); err != nil {
+			return diag.Errorf("updating End User Messaging SMS Pool (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating End User Messaging SMS Pool (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePoolRead(ctx, d, meta)
+}
+
+func resourcePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	log.Printf("[DEBUG] Deleting End User Messaging SMS Pool: %s", d.Id())
+	_, err := conn.DeletePoolWithContext(ctx, &pinpointsmsvoicev2.DeletePoolInput{
+		PoolId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting End User Messaging SMS Pool (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPoolDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for End User Messaging SMS Pool (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func associateOriginationIdentity(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, poolID, originationIdentity, isoCountryCode string) error {
+	_, err := conn.AssociateOriginationIdentityWithContext(ctx, &pinpointsmsvoicev2.AssociateOriginationIdentityInput{
+		ClientToken:         aws.String(resource.UniqueId()),
+		IsoCountryCode:      aws.String(isoCountryCode),
+		OriginationIdentity: aws.String(originationIdentity),
+		PoolId:              aws.String(poolID),
+	})
+
+	return err
+}
+
+func updatePool(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, d *schema.ResourceData) error {
+	input := &pinpointsmsvoicev2.UpdatePoolInput{
+		DeletionProtectionEnabled: aws.Bool(d.Get("deletion_protection_enabled").(bool)),
+		PoolId:                    aws.String(d.Id()),
+		SelfManagedOptOutsEnabled: aws.Bool(d.Get("self_managed_opt_outs_enabled").(bool)),
+		SharedRoutesEnabled:       aws.Bool(d.Get("shared_routes_enabled").(bool)),
+		TwoWayEnabled:             aws.Bool(d.Get("two_way_channel_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("opt_out_list_name"); ok {
+		input.OptOutListName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("two_way_channel_arn"); ok {
+		input.TwoWayChannelArn = aws.String(v.(string))
+	}
+
+	_, err := conn.UpdatePoolWithContext(ctx, input)
+
+	return err
+}
@@ -0,0 +1,148 @@
+package pinpointsmsvoicev2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpointsmsvoicev2 "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointSMSVoiceV2Pool_basic(t *testing.T) {
+	resourceName := "aws_pinpointsmsvoicev2_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "sms-voice", regexp.MustCompile(`pool/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "iso_country_code", "US"),
+					resource.TestCheckResourceAttr(resourceName, "message_type", "TRANSACTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "origination_identities.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "origination_identities.*", "aws_pinpointsmsvoicev2_phone_number.test.0", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "shared_routes_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "two_way_channel_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iso_country_code"},
+			},
+			{
+				Config: testAccPoolConfig_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origination_identities.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "origination_identities.*", "aws_pinpointsmsvoicev2_phone_number.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "origination_identities.*", "aws_pinpointsmsvoicev2_phone_number.test.1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2Pool_disappears(t *testing.T) {
+	resourceName := "aws_pinpointsmsvoicev2_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpointsmsvoicev2.ResourcePool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPoolExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No End User Messaging SMS Pool ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+		_, err := tfpinpointsmsvoicev2.FindPoolByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckPoolDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpointsmsvoicev2_pool" {
+			continue
+		}
+
+		_, err := tfpinpointsmsvoicev2.FindPoolByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("End User Messaging SMS Pool %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPoolConfig_basic(both bool) string {
+	originationIdentities := "[aws_pinpointsmsvoicev2_phone_number.test[0].arn]"
+	if both {
+		originationIdentities = "aws_pinpointsmsvoicev2_phone_number.test[*].arn"
+	}
+
+	return fmt.Sprintf(`
+resource "aws_pinpointsmsvoicev2_phone_number" "test" {
+  count = 2
+
+  iso_country_code    = "US"
+  message_type        = "TRANSACTIONAL"
+  number_capabilities = ["SMS"]
+  number_type         = "TOLL_FREE"
+}
+
+resource "aws_pinpointsmsvoicev2_pool" "test" {
+  iso_country_code       = "US"
+  message_type           = "TRANSACTIONAL"
+  origination_identities = %[1]s
+}
+`, originationIdentities)
+}
@@ -0,0 +1,42 @@
+package pinpointsmsvoicev2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusPhoneNumber(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPhoneNumberByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusPool(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPoolByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,101 @@
+// Code generated by internal/generate/tags/main.go; DO NOT EDIT.
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2/pinpointsmsvoicev2iface"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ListTags lists pinpointsmsvoicev2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ListTags(conn pinpointsmsvoicev2iface.PinpointSMSVoiceV2API, identifier string) (tftags.KeyValueTags, error) {
+	return ListTagsWithContext(context.Background(), conn, identifier)
+}
+
+func ListTagsWithContext(ctx context.Context, conn pinpointsmsvoicev2iface.PinpointSMSVoiceV2API, identifier string) (tftags.KeyValueTags, error) {
+	input := &pinpointsmsvoicev2.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResourceWithContext(ctx, input)
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(output.Tags), nil
+}
+
+// []*SERVICE.Tag handling
+
+// Tags returns pinpointsmsvoicev2 service tags.
+func Tags(tags tftags.KeyValueTags) []*pinpointsmsvoicev2.Tag {
+	result := make([]*pinpointsmsvoicev2.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &pinpointsmsvoicev2.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// KeyValueTags creates tftags.KeyValueTags from pinpointsmsvoicev2 service tags.
+func KeyValueTags(tags []*pinpointsmsvoicev2.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(m)
+}
+
+// UpdateTags updates pinpointsmsvoicev2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func UpdateTags(conn pinpointsmsvoicev2iface.PinpointSMSVoiceV2API, identifier string, oldTags interface{}, newTags interface{}) error {
+	return UpdateTagsWithContext(context.Background(), conn, identifier, oldTags, newTags)
+}
+func UpdateTagsWithContext(ctx context.Context, conn pinpointsmsvoicev2iface.PinpointSMSVoiceV2API, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &pinpointsmsvoicev2.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.IgnoreAWS().Keys()),
+		}
+
+		_, err := conn.UntagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &pinpointsmsvoicev2.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        Tags(updatedTags.IgnoreAWS()),
+		}
+
+		_, err := conn.TagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
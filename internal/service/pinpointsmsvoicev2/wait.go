@@ -0,0 +1,81 @@
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitPhoneNumberActive(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string, timeout time.Duration) (*pinpointsmsvoicev2.PhoneNumberInformation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pinpointsmsvoicev2.NumberStatusPending, pinpointsmsvoicev2.NumberStatusAssociating, pinpointsmsvoicev2.NumberStatusDisassociating},
+		Target:  []string{pinpointsmsvoicev2.NumberStatusActive},
+		Refresh: statusPhoneNumber(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pinpointsmsvoicev2.PhoneNumberInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPhoneNumberDeleted(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string, timeout time.Duration) (*pinpointsmsvoicev2.PhoneNumberInformation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pinpointsmsvoicev2.NumberStatusActive, pinpointsmsvoicev2.NumberStatusPending, pinpointsmsvoicev2.NumberStatusAssociating, pinpointsmsvoicev2.NumberStatusDisassociating},
+		Target:  []string{},
+		Refresh: statusPhoneNumber(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pinpointsmsvoicev2.PhoneNumberInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolActive(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string, timeout time.Duration) (*pinpointsmsvoicev2.PoolInformation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pinpointsmsvoicev2.PoolStatusCreating},
+		Target:  []string{pinpointsmsvoicev2.PoolStatusActive},
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pinpointsmsvoicev2.PoolInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolDeleted(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string, timeout time.Duration) (*pinpointsmsvoicev2.PoolInformation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pinpointsmsvoicev2.PoolStatusActive, pinpointsmsvoicev2.PoolStatusDeleting},
+		Target:  []string{},
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pinpointsmsvoicev2.PoolInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,139 @@
+package qldb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/qldb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqldb "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
+)
+
+func TestAccQLDBJournalS3Export_basic(t *testing.T) {
+	var v qldb.JournalS3ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qldb_journal_s3_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(qldb.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, qldb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Journal exports cannot be deleted.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJournalS3ExportConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJournalS3ExportExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "exclusive_end_time", "aws_iam_role.test", "create_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "export_creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "inclusive_start_time", "2021-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrPair(resourceName, "ledger_name", "aws_qldb_ledger.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "JSON"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_export_configuration.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.0.encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.0.encryption_configuration.0.object_encryption_type", "SSE_S3"),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.0.prefix", "export/"),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJournalS3ExportExists(n string, v *qldb.JournalS3ExportDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QLDB Journal S3 Export ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QLDBConn
+
+		output, err := tfqldb.FindJournalS3Export(context.Background(), conn, rs.Primary.Attributes["ledger_name"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJournalS3ExportConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = "ALLOW_ALL"
+  deletion_protection = false
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  # The role's creation time is used as the export's end time, so it must be after the ledger's creation time.
+  depends_on = [aws_qldb_ledger.test]
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "qldb.amazonaws.com"
+      }
+    }]
+  })
+
+  inline_policy {
+    name = "test-qldb-policy"
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action = [
+          "s3:PutObject",
+          "s3:PutObjectAcl",
+        ]
+        Effect   = "Allow"
+        Resource = "${aws_s3_bucket.test.arn}/*"
+      }]
+    })
+  }
+}
+
+resource "aws_qldb_journal_s3_export" "test" {
+  ledger_name          = aws_qldb_ledger.test.id
+  inclusive_start_time = "2021-01-01T00:00:00Z"
+  exclusive_end_time   = aws_iam_role.test.create_date
+  output_format        = "JSON"
+  role_arn             = aws_iam_role.test.arn
+
+  s3_export_configuration {
+    bucket = aws_s3_bucket.test.bucket
+    prefix = "export/"
+
+    encryption_configuration {
+      object_encryption_type = "SSE_S3"
+    }
+  }
+}
+`, rName)
+}
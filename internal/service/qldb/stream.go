@@ -31,6 +31,10 @@ func ResourceStream() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"error_cause": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"exclusive_end_time": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -79,6 +83,10 @@ func ResourceStream() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"stream_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -158,6 +166,7 @@ func resourceStreamRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	d.Set("arn", stream.Arn)
+	d.Set("error_cause", stream.ErrorCause)
 	if stream.ExclusiveEndTime != nil {
 		d.Set("exclusive_end_time", aws.TimeValue(stream.ExclusiveEndTime).Format(time.RFC3339))
 	} else {
@@ -177,6 +186,7 @@ func resourceStreamRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	d.Set("ledger_name", stream.LedgerName)
 	d.Set("role_arn", stream.RoleArn)
+	d.Set("status", stream.Status)
 	d.Set("stream_name", stream.StreamName)
 
 	tags, err := ListTags(conn, d.Get("arn").(string))
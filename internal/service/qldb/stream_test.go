@@ -32,6 +32,7 @@ func TestAccQLDBStream_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckStreamExists(resourceName, &v),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "qldb", regexp.MustCompile(`stream/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "error_cause", ""),
 					resource.TestCheckResourceAttr(resourceName, "exclusive_end_time", ""),
 					resource.TestCheckResourceAttrSet(resourceName, "inclusive_start_time"),
 					resource.TestCheckResourceAttr(resourceName, "kinesis_configuration.#", "1"),
@@ -39,6 +40,7 @@ func TestAccQLDBStream_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "kinesis_configuration.0.stream_arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "ledger_name"),
 					resource.TestCheckResourceAttrSet(resourceName, "role_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
 					resource.TestCheckResourceAttr(resourceName, "stream_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
@@ -1,8 +1,14 @@
 package quicksight
 
 import (
+	"context"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindGroupMembership(conn *quicksight.QuickSight, listInput *quicksight.ListGroupMembershipsInput, userName string) (bool, error) {
@@ -30,3 +36,78 @@ func FindGroupMembership(conn *quicksight.QuickSight, listInput *quicksight.List
 
 	return found, nil
 }
+
+func FindFolderByTwoPartKey(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, folderID string) (*quicksight.Folder, error) {
+	input := &quicksight.DescribeFolderInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	output, err := conn.DescribeFolderWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Folder == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Folder, nil
+}
+
+func FindFolderMembershipByFourPartKey(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, folderID, memberType, memberID string) (*quicksight.MemberIdArnPair, error) {
+	input := &quicksight.ListFolderMembersInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	for {
+		output, err := conn.ListFolderMembersWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, v := range output.FolderMemberList {
+			if v == nil || aws.StringValue(v.MemberId) != memberID {
+				continue
+			}
+
+			// Member ARNs take the form arn:aws:quicksight:region:account:<type>/<id>.
+			if arn := aws.StringValue(v.MemberArn); arn != "" && !strings.Contains(arn, ":"+strings.ToLower(memberType)+"/") {
+				continue
+			}
+
+			return v, nil
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
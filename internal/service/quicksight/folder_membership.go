@@ -0,0 +1,166 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFolderMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFolderMembershipCreate,
+		ReadWithoutTimeout:   resourceFolderMembershipRead,
+		DeleteWithoutTimeout: resourceFolderMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.MemberType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceFolderMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	folderID := d.Get("folder_id").(string)
+	memberType := d.Get("member_type").(string)
+	memberID := d.Get("member_id").(string)
+
+	_, err := conn.CreateFolderMembershipWithContext(ctx, &quicksight.CreateFolderMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+		MemberId:     aws.String(memberID),
+		MemberType:   aws.String(memberType),
+	})
+
+	if err != nil {
+		return diag.Errorf("adding QuickSight %s (%s) to Folder (%s): %s", memberType, memberID, folderID, err)
+	}
+
+	d.SetId(FolderMembershipCreateResourceID(awsAccountID, folderID, memberType, memberID))
+
+	return resourceFolderMembershipRead(ctx, d, meta)
+}
+
+func resourceFolderMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountID, folderID, memberType, memberID, err := FolderMembershipParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member, err := FindFolderMembershipByFourPartKey(ctx, conn, awsAccountID, folderID, memberType, memberID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Folder Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading QuickSight Folder Membership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("folder_id", folderID)
+	d.Set("member_arn", member.MemberArn)
+	d.Set("member_id", member.MemberId)
+	d.Set("member_type", memberType)
+
+	return nil
+}
+
+func resourceFolderMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountID, folderID, memberType, memberID, err := FolderMembershipParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Folder Membership: %s", d.Id())
+	_, err = conn.DeleteFolderMembershipWithContext(ctx, &quicksight.DeleteFolderMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+		MemberId:     aws.String(memberID),
+		MemberType:   aws.String(memberType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting QuickSight Folder Membership (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const folderMembershipResourceIDSeparator = "/"
+
+func FolderMembershipCreateResourceID(awsAccountID, folderID, memberType, memberID string) string {
+	parts := []string{awsAccountID, folderID, memberType, memberID}
+	id := strings.Join(parts, folderMembershipResourceIDSeparator)
+
+	return id
+}
+
+func FolderMembershipParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, folderMembershipResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sFOLDER_ID%[2]sMEMBER_TYPE%[2]sMEMBER_ID", id, folderMembershipResourceIDSeparator)
+}
@@ -0,0 +1,150 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Data sets, dashboards and analyses cannot yet be managed by the provider, so the
+// membership tests add an existing data set to the folder.
+func TestAccQuickSightFolderMembership_basic(t *testing.T) {
+	dataSetID := os.Getenv("QUICKSIGHT_DATA_SET_ID")
+	if dataSetID == "" {
+		t.Skip("Environment variable QUICKSIGHT_DATA_SET_ID is not set")
+	}
+
+	resourceName := "aws_quicksight_folder_membership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderMembershipConfig_basic(rName, dataSetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderMembershipExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "folder_id", "aws_quicksight_folder.test", "folder_id"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "member_arn", "quicksight", fmt.Sprintf("dataset/%s", dataSetID)),
+					resource.TestCheckResourceAttr(resourceName, "member_id", dataSetID),
+					resource.TestCheckResourceAttr(resourceName, "member_type", quicksight.MemberTypeDataset),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolderMembership_disappears(t *testing.T) {
+	dataSetID := os.Getenv("QUICKSIGHT_DATA_SET_ID")
+	if dataSetID == "" {
+		t.Skip("Environment variable QUICKSIGHT_DATA_SET_ID is not set")
+	}
+
+	resourceName := "aws_quicksight_folder_membership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderMembershipConfig_basic(rName, dataSetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderMembershipExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceFolderMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFolderMembershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Folder Membership ID is set")
+		}
+
+		awsAccountID, folderID, memberType, memberID, err := tfquicksight.FolderMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		_, err = tfquicksight.FindFolderMembershipByFourPartKey(context.Background(), conn, awsAccountID, folderID, memberType, memberID)
+
+		return err
+	}
+}
+
+func testAccCheckFolderMembershipDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_folder_membership" {
+			continue
+		}
+
+		awsAccountID, folderID, memberType, memberID, err := tfquicksight.FolderMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindFolderMembershipByFourPartKey(context.Background(), conn, awsAccountID, folderID, memberType, memberID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Folder Membership %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFolderMembershipConfig_basic(rName, dataSetID string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[1]q
+}
+
+resource "aws_quicksight_folder_membership" "test" {
+  folder_id   = aws_quicksight_folder.test.folder_id
+  member_type = "DATASET"
+  member_id   = %[2]q
+}
+`, rName, dataSetID)
+}
@@ -0,0 +1,324 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightFolder_basic(t *testing.T) {
+	resourceName := "aws_quicksight_folder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("folder/%s", rName)),
+					acctest.CheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "folder_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "folder_path.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "folder_type", quicksight.FolderTypeShared),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFolderConfig_basic(rName, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "folder_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolder_disappears(t *testing.T) {
+	resourceName := "aws_quicksight_folder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceFolder(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolder_parentFolder(t *testing.T) {
+	resourceName := "aws_quicksight_folder.test"
+	parentResourceName := "aws_quicksight_folder.parent"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig_parentFolder(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "folder_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "folder_path.0", parentResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_folder_arn", parentResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolder_permissions(t *testing.T) {
+	resourceName := "aws_quicksight_folder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig_permissions(rName, `"quicksight:DescribeFolder"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]*regexp.Regexp{
+						"principal": regexp.MustCompile(fmt.Sprintf(`user/default/%s`, rName)),
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission.*.actions.*", "quicksight:DescribeFolder"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFolderConfig_permissions(rName, `"quicksight:CreateFolder", "quicksight:DescribeFolder", "quicksight:UpdateFolder", "quicksight:DeleteFolder", "quicksight:CreateFolderMembership", "quicksight:DeleteFolderMembership", "quicksight:DescribeFolderPermissions", "quicksight:UpdateFolderPermissions"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission.*.actions.*", "quicksight:DescribeFolder"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission.*.actions.*", "quicksight:UpdateFolderPermissions"),
+				),
+			},
+			{
+				Config: testAccFolderConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolder_tags(t *testing.T) {
+	resourceName := "aws_quicksight_folder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFolderConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFolderConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFolderExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Folder ID is set")
+		}
+
+		awsAccountID, folderID, err := tfquicksight.FolderParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		_, err = tfquicksight.FindFolderByTwoPartKey(context.Background(), conn, awsAccountID, folderID)
+
+		return err
+	}
+}
+
+func testAccCheckFolderDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_folder" {
+			continue
+		}
+
+		awsAccountID, folderID, err := tfquicksight.FolderParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindFolderByTwoPartKey(context.Background(), conn, awsAccountID, folderID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Folder %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFolderConfig_basic(rId, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[2]q
+}
+`, rId, rName)
+}
+
+func testAccFolderConfig_parentFolder(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "parent" {
+  folder_id = "%[1]s-parent"
+  name      = "%[1]s-parent"
+}
+
+resource "aws_quicksight_folder" "test" {
+  folder_id         = %[1]q
+  name              = %[1]q
+  parent_folder_arn = aws_quicksight_folder.parent.arn
+}
+`, rName)
+}
+
+func testAccFolderConfig_permissions(rName, actions string) string {
+	return acctest.ConfigCompose(
+		testAccDataSource_UserConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[1]q
+
+  permission {
+    actions   = [%[2]s]
+    principal = aws_quicksight_user.test.arn
+  }
+}
+`, rName, actions))
+}
+
+func testAccFolderConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFolderConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
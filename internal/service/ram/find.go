@@ -209,3 +209,83 @@ func FindResourceSharePrincipalAssociationByShareARNPrincipal(conn *ram.RAM, res
 
 	return output.ResourceShareAssociations[0], nil
 }
+
+func FindResourceSharePermissionByShareARNPermissionARN(conn *ram.RAM, resourceShareARN, permissionARN string) (*ram.ResourceSharePermissionSummary, error) {
+	input := &ram.ListResourceSharePermissionsInput{
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	var permission *ram.ResourceSharePermissionSummary
+
+	err := conn.ListResourceSharePermissionsPages(input, func(page *ram.ListResourceSharePermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Permissions {
+			if v != nil && aws.StringValue(v.Arn) == permissionARN {
+				permission = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if permission == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return permission, nil
+}
+
+func FindResourceShareAssociationsByShareARN(conn *ram.RAM, resourceShareARN, associationType, associationStatus string) ([]*ram.ResourceShareAssociation, error) {
+	input := &ram.GetResourceShareAssociationsInput{
+		AssociationType:   aws.String(associationType),
+		ResourceShareArns: aws.StringSlice([]string{resourceShareARN}),
+	}
+
+	if associationStatus != "" {
+		input.AssociationStatus = aws.String(associationStatus)
+	}
+
+	var associations []*ram.ResourceShareAssociation
+
+	err := conn.GetResourceShareAssociationsPages(input, func(page *ram.GetResourceShareAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceShareAssociations {
+			if v != nil {
+				associations = append(associations, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return associations, nil
+}
@@ -0,0 +1,115 @@
+package ram
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceResourceShareAssociations() *schema.Resource {
+	associationSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"associated_entity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceResourceShareAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"association_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ram.ResourceShareAssociationStatus_Values(), false),
+			},
+
+			"principals": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     associationSchema,
+			},
+
+			"resource_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     associationSchema,
+			},
+		},
+	}
+}
+
+func dataSourceResourceShareAssociationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RAMConn
+
+	resourceShareARN := d.Get("resource_share_arn").(string)
+	associationStatus := d.Get("association_status").(string)
+
+	principals, err := FindResourceShareAssociationsByShareARN(conn, resourceShareARN, ram.ResourceShareAssociationTypePrincipal, associationStatus)
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s) principal associations: %w", resourceShareARN, err)
+	}
+
+	resources, err := FindResourceShareAssociationsByShareARN(conn, resourceShareARN, ram.ResourceShareAssociationTypeResource, associationStatus)
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s) resource associations: %w", resourceShareARN, err)
+	}
+
+	d.SetId(resourceShareARN)
+
+	if err := d.Set("principals", flattenResourceShareAssociations(principals)); err != nil {
+		return fmt.Errorf("error setting principals: %w", err)
+	}
+
+	if err := d.Set("resources", flattenResourceShareAssociations(resources)); err != nil {
+		return fmt.Errorf("error setting resources: %w", err)
+	}
+
+	return nil
+}
+
+func flattenResourceShareAssociations(apiObjects []*ram.ResourceShareAssociation) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"associated_entity": aws.StringValue(apiObject.AssociatedEntity),
+			"external":          aws.BoolValue(apiObject.External),
+			"status":            aws.StringValue(apiObject.Status),
+			"status_message":    aws.StringValue(apiObject.StatusMessage),
+		})
+	}
+
+	return tfList
+}
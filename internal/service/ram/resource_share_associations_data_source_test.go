@@ -0,0 +1,78 @@
+package ram_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRAMResourceShareAssociationsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	datasourceName := "data.aws_ram_resource_share_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareAssociationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", "aws_ram_resource_share.test", "arn"),
+					resource.TestCheckResourceAttr(datasourceName, "principals.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "principals.0.associated_entity", "111111111111"),
+					resource.TestCheckResourceAttr(datasourceName, "principals.0.external", "true"),
+					resource.TestCheckResourceAttr(datasourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "resources.0.associated_entity", "aws_subnet.test", "arn"),
+					resource.TestCheckResourceAttr(datasourceName, "resources.0.status", ram.ResourceShareAssociationStatusAssociated),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceShareAssociationsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "10.0.0.0/24"
+  vpc_id     = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ram_resource_share" "test" {
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_resource_association" "test" {
+  resource_arn       = aws_subnet.test.arn
+  resource_share_arn = aws_ram_resource_share.test.id
+}
+
+resource "aws_ram_principal_association" "test" {
+  principal          = "111111111111"
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+data "aws_ram_resource_share_associations" "test" {
+  resource_share_arn = aws_ram_resource_share.test.arn
+
+  depends_on = [aws_ram_resource_association.test, aws_ram_principal_association.test]
+}
+`, rName)
+}
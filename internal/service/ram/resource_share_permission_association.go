@@ -0,0 +1,164 @@
+package ram
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourceSharePermissionAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourceSharePermissionAssociationCreate,
+		Read:   resourceResourceSharePermissionAssociationRead,
+		Delete: resourceResourceSharePermissionAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"permission_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"permission_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"replace": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"resource_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourceSharePermissionAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RAMConn
+	resourceShareARN := d.Get("resource_share_arn").(string)
+	permissionARN := d.Get("permission_arn").(string)
+
+	input := &ram.AssociateResourceSharePermissionInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		PermissionArn:    aws.String(permissionARN),
+		Replace:          aws.Bool(d.Get("replace").(bool)),
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	if v, ok := d.GetOk("permission_version"); ok {
+		input.PermissionVersion = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Associating RAM Resource Share Permission: %s", input)
+	_, err := conn.AssociateResourceSharePermission(input)
+	if err != nil {
+		return fmt.Errorf("error associating RAM Resource Share (%s) Permission (%s): %w", resourceShareARN, permissionARN, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", resourceShareARN, permissionARN))
+
+	return resourceResourceSharePermissionAssociationRead(d, meta)
+}
+
+func resourceResourceSharePermissionAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RAMConn
+
+	resourceShareARN, permissionARN, err := DecodeResourceSharePermissionAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	permission, err := FindResourceSharePermissionByShareARNPermissionARN(conn, resourceShareARN, permissionARN)
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RAM Resource Share (%s) Permission Association (%s) not found, removing from state", resourceShareARN, permissionARN)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s) Permission Association (%s): %w", resourceShareARN, permissionARN, err)
+	}
+
+	d.Set("permission_arn", permissionARN)
+	if v, err := strconv.Atoi(aws.StringValue(permission.Version)); err == nil {
+		d.Set("permission_version", v)
+	}
+	d.Set("resource_share_arn", resourceShareARN)
+	d.Set("resource_type", permission.ResourceType)
+	d.Set("status", permission.Status)
+
+	return nil
+}
+
+func resourceResourceSharePermissionAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RAMConn
+
+	resourceShareARN, permissionARN, err := DecodeResourceSharePermissionAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ram.DisassociateResourceSharePermissionInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		PermissionArn:    aws.String(permissionARN),
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	log.Printf("[DEBUG] Disassociating RAM Resource Share Permission: %s", input)
+	_, err = conn.DisassociateResourceSharePermission(input)
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating RAM Resource Share (%s) Permission (%s): %w", resourceShareARN, permissionARN, err)
+	}
+
+	return nil
+}
+
+func DecodeResourceSharePermissionAssociationID(id string) (string, string, error) {
+	idFormatErr := fmt.Errorf("unexpected format of ID (%s), expected SHARE,PERMISSION", id)
+
+	parts := strings.SplitN(id, ",", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", idFormatErr
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,117 @@
+package ram_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfram "github.com/hashicorp/terraform-provider-aws/internal/service/ram"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRAMResourceSharePermissionAssociation_basic(t *testing.T) {
+	var permission ram.ResourceSharePermissionSummary
+	resourceName := "aws_ram_resource_share_permission_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceSharePermissionAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSharePermissionAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceSharePermissionAssociationExists(resourceName, &permission),
+					resource.TestCheckResourceAttrSet(resourceName, "permission_version"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "acm-pca:CertificateAuthority"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace"},
+			},
+		},
+	})
+}
+
+func testAccCheckResourceSharePermissionAssociationExists(resourceName string, permission *ram.ResourceSharePermissionSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn
+
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceShareARN, permissionARN, err := tfram.DecodeResourceSharePermissionAssociationID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfram.FindResourceSharePermissionByShareARNPermissionARN(conn, resourceShareARN, permissionARN)
+
+		if err != nil {
+			return err
+		}
+
+		*permission = *output
+
+		return nil
+	}
+}
+
+func testAccCheckResourceSharePermissionAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ram_resource_share_permission_association" {
+			continue
+		}
+
+		resourceShareARN, permissionARN, err := tfram.DecodeResourceSharePermissionAssociationID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfram.FindResourceSharePermissionByShareARNPermissionARN(conn, resourceShareARN, permissionARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RAM Resource Share (%s) Permission Association (%s) still exists", resourceShareARN, permissionARN)
+	}
+
+	return nil
+}
+
+func testAccResourceSharePermissionAssociationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+}
+
+resource "aws_ram_resource_share_permission_association" "test" {
+  permission_arn     = "arn:${data.aws_partition.current.partition}:ram::aws:permission/AWSRAMBlankEndEntityCertificateAPICSRPassthroughIssuanceCertificateAuthority"
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+`, rName)
+}
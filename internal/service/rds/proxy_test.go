@@ -373,6 +373,45 @@ func TestAccRDSProxy_authIAMAuth(t *testing.T) {
 	})
 }
 
+func TestAccRDSProxy_multipleAuth(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbProxy rds.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyConfig_multipleAuth(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyExists(resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "auth.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"client_password_auth_type": "MYSQL_NATIVE_PASSWORD",
+						"iam_auth":                  "DISABLED",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"client_password_auth_type": "MYSQL_NATIVE_PASSWORD",
+						"iam_auth":                  "REQUIRED",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccRDSProxy_authSecretARN(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -898,6 +937,63 @@ resource "aws_db_proxy" "test" {
 `, rName, iamAuth)
 }
 
+func testAccProxyConfig_multipleAuth(rName string) string {
+	return testAccProxyBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test2" {
+  name                    = "%[1]s-2"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  secret_id     = aws_secretsmanager_secret.test2.id
+  secret_string = "{\"username\":\"db_user2\",\"password\":\"db_user2_password\"}"
+}
+
+resource "aws_iam_role_policy" "test2" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "secretsmanager:*"
+      Effect   = "Allow"
+      Resource = aws_secretsmanager_secret.test2.arn
+    }]
+  })
+}
+
+resource "aws_db_proxy" "test" {
+  depends_on = [
+    aws_secretsmanager_secret_version.test,
+    aws_secretsmanager_secret_version.test2,
+    aws_iam_role_policy.test,
+    aws_iam_role_policy.test2,
+  ]
+
+  name                   = "%[1]s"
+  engine_family          = "MYSQL"
+  role_arn               = aws_iam_role.test.arn
+  require_tls            = true
+  vpc_security_group_ids = [aws_security_group.test.id]
+  vpc_subnet_ids         = aws_subnet.test[*].id
+
+  auth {
+    auth_scheme               = "SECRETS"
+    client_password_auth_type = "MYSQL_NATIVE_PASSWORD"
+    iam_auth                  = "DISABLED"
+    secret_arn                = aws_secretsmanager_secret.test.arn
+  }
+
+  auth {
+    auth_scheme               = "SECRETS"
+    client_password_auth_type = "MYSQL_NATIVE_PASSWORD"
+    iam_auth                  = "REQUIRED"
+    secret_arn                = aws_secretsmanager_secret.test2.arn
+  }
+}
+`, rName)
+}
+
 func testAccProxyConfig_authSecretARN(rName, nName string) string {
 	return testAccProxyBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_db_proxy" "test" {
@@ -0,0 +1,234 @@
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppCreate,
+		ReadWithoutTimeout:   resourceAppRead,
+		UpdateWithoutTimeout: resourceAppUpdate,
+		DeleteWithoutTimeout: resourceAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.AppAssessmentScheduleType_Values(), false),
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), "must begin with an alphanumeric character and contain only alphanumeric characters, hyphens or underscores, and be between 2 and 60 characters"),
+			},
+			"policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameApp = "App"
+)
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	in := &resiliencehub.CreateAppInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("assessment_schedule"); ok {
+		in.AssessmentSchedule = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_arn"); ok {
+		in.PolicyArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateAppWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameApp, name, err)
+	}
+
+	if out == nil || out.App == nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameApp, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.App.AppArn))
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	out, err := FindAppByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ResilienceHub App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameApp, d.Id(), err)
+	}
+
+	d.Set("arn", out.AppArn)
+	d.Set("assessment_schedule", out.AssessmentSchedule)
+	d.Set("compliance_status", out.ComplianceStatus)
+	d.Set("description", out.Description)
+	d.Set("name", out.Name)
+	d.Set("policy_arn", out.PolicyArn)
+	d.Set("resiliency_score", out.ResiliencyScore)
+	d.Set("status", out.Status)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameApp, d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameApp, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameApp, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &resiliencehub.UpdateAppInput{
+			AppArn:      aws.String(d.Id()),
+			Description: aws.String(d.Get("description").(string)),
+		}
+
+		if d.HasChange("assessment_schedule") {
+			in.AssessmentSchedule = aws.String(d.Get("assessment_schedule").(string))
+		}
+
+		if d.HasChange("policy_arn") {
+			if v, ok := d.GetOk("policy_arn"); ok {
+				in.PolicyArn = aws.String(v.(string))
+			} else {
+				in.ClearResiliencyPolicyArn = aws.Bool(true)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating ResilienceHub App (%s): %#v", d.Id(), in)
+		if _, err := conn.UpdateAppWithContext(ctx, in); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameApp, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameApp, d.Id(), err)
+		}
+	}
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	log.Printf("[INFO] Deleting ResilienceHub App %s", d.Id())
+
+	_, err := conn.DeleteAppWithContext(ctx, &resiliencehub.DeleteAppInput{
+		AppArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionDeleting, ResNameApp, d.Id(), err)
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionWaitingForDeletion, ResNameApp, d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceAppAssessment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppAssessmentCreate,
+		ReadWithoutTimeout:   resourceAppAssessmentRead,
+		DeleteWithoutTimeout: resourceAppAssessmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"app_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "release",
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"assessment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchemaForceNew(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameAppAssessment = "App Assessment"
+)
+
+func resourceAppAssessmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("assessment_name").(string)
+	in := &resiliencehub.StartAppAssessmentInput{
+		AppArn:         aws.String(d.Get("app_arn").(string)),
+		AppVersion:     aws.String(d.Get("app_version").(string)),
+		AssessmentName: aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.StartAppAssessmentWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameAppAssessment, name, err)
+	}
+
+	if out == nil || out.Assessment == nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameAppAssessment, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.Assessment.AssessmentArn))
+
+	if _, err := waitAppAssessmentCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionWaitingForCreation, ResNameAppAssessment, d.Id(), err)
+	}
+
+	return resourceAppAssessmentRead(ctx, d, meta)
+}
+
+func resourceAppAssessmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	out, err := FindAppAssessmentByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ResilienceHub AppAssessment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameAppAssessment, d.Id(), err)
+	}
+
+	d.Set("app_arn", out.AppArn)
+	d.Set("app_version", out.AppVersion)
+	d.Set("arn", out.AssessmentArn)
+	d.Set("assessment_name", out.AssessmentName)
+	d.Set("assessment_status", out.AssessmentStatus)
+	d.Set("compliance_status", out.ComplianceStatus)
+	d.Set("message", out.Message)
+	if out.ResiliencyScore != nil {
+		d.Set("resiliency_score", out.ResiliencyScore.Score)
+	} else {
+		d.Set("resiliency_score", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameAppAssessment, d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameAppAssessment, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameAppAssessment, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAppAssessmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	log.Printf("[INFO] Deleting ResilienceHub AppAssessment %s", d.Id())
+
+	_, err := conn.DeleteAppAssessmentWithContext(ctx, &resiliencehub.DeleteAppAssessmentInput{
+		AssessmentArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionDeleting, ResNameAppAssessment, d.Id(), err)
+	}
+
+	return nil
+}
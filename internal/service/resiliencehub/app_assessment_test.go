@@ -0,0 +1,116 @@
+package resiliencehub_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfresiliencehub "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResilienceHubAppAssessment_basic(t *testing.T) {
+	var assessment resiliencehub.AppAssessment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app_assessment.test"
+	appResourceName := "aws_resiliencehub_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(resiliencehub.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAssessmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAssessmentExists(resourceName, &assessment),
+					resource.TestCheckResourceAttrPair(resourceName, "app_arn", appResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "app_version", "release"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "resiliencehub", regexp.MustCompile(`app-assessment/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "assessment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "assessment_status", "Success"),
+					resource.TestCheckResourceAttrSet(resourceName, "compliance_status"),
+					resource.TestCheckResourceAttrSet(resourceName, "resiliency_score"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAppAssessmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resiliencehub_app_assessment" {
+			continue
+		}
+
+		_, err := tfresiliencehub.FindAppAssessmentByARN(ctx, conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.ResilienceHub, create.ErrActionCheckingDestroyed, tfresiliencehub.ResNameAppAssessment, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckAppAssessmentExists(name string, assessment *resiliencehub.AppAssessment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ResilienceHub, create.ErrActionCheckingExistence, tfresiliencehub.ResNameAppAssessment, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ResilienceHub, create.ErrActionCheckingExistence, tfresiliencehub.ResNameAppAssessment, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+		ctx := context.Background()
+		resp, err := tfresiliencehub.FindAppAssessmentByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.ResilienceHub, create.ErrActionCheckingExistence, tfresiliencehub.ResNameAppAssessment, rs.Primary.ID, err)
+		}
+
+		*assessment = *resp
+
+		return nil
+	}
+}
+
+func testAccAppAssessmentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAppConfig_policy(rName),
+		testAccAppVersionConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_resiliencehub_app_assessment" "test" {
+  app_arn         = aws_resiliencehub_app_version.test.app_arn
+  assessment_name = %[1]q
+}
+`, rName))
+}
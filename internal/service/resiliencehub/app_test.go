@@ -0,0 +1,182 @@
+package resiliencehub_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfresiliencehub "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResilienceHubApp_basic(t *testing.T) {
+	var app resiliencehub.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(resiliencehub.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &app),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "resiliencehub", regexp.MustCompile(`app/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_update(t *testing.T) {
+	var app resiliencehub.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+	policyResourceName := "aws_resiliencehub_resiliency_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(resiliencehub.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "policy_arn", ""),
+				),
+			},
+			{
+				Config: testAccAppConfig_policy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_arn", policyResourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "policy_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_disappears(t *testing.T) {
+	var app resiliencehub.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(resiliencehub.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &app),
+					acctest.CheckResourceDisappears(acctest.Provider, tfresiliencehub.ResourceApp(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAppDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resiliencehub_app" {
+			continue
+		}
+
+		_, err := tfresiliencehub.FindAppByARN(ctx, conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.ResilienceHub, create.ErrActionCheckingDestroyed, tfresiliencehub.ResNameApp, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckAppExists(name string, app *resiliencehub.App) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ResilienceHub, create.ErrActionCheckingExistence, tfresiliencehub.ResNameApp, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ResilienceHub, create.ErrActionCheckingExistence, tfresiliencehub.ResNameApp, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+		ctx := context.Background()
+		resp, err := tfresiliencehub.FindAppByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.ResilienceHub, create.ErrActionCheckingExistence, tfresiliencehub.ResNameApp, rs.Primary.ID, err)
+		}
+
+		*app = *resp
+
+		return nil
+	}
+}
+
+func testAccAppConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAppConfig_policy(rName string) string {
+	return acctest.ConfigCompose(testAccResiliencyPolicyConfig_basic(rName), fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  name        = %[1]q
+  description = "updated"
+  policy_arn  = aws_resiliencehub_resiliency_policy.test.arn
+}
+`, rName))
+}
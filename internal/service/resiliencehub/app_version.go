@@ -0,0 +1,401 @@
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	appVersionDraft = "draft"
+)
+
+func ResourceAppVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppVersionCreate,
+		ReadWithoutTimeout:   resourceAppVersionRead,
+		UpdateWithoutTimeout: resourceAppVersionUpdate,
+		DeleteWithoutTimeout: resourceAppVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"app_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_mapping": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_registry_app_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"logical_stack_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mapping_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(resiliencehub.ResourceMappingType_Values(), false),
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aws_account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"aws_region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"identifier": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(resiliencehub.PhysicalIdentifierType_Values(), false),
+									},
+								},
+							},
+						},
+						"resource_group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"terraform_source_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameAppVersion = "App Version"
+)
+
+func resourceAppVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	appARN := d.Get("app_arn").(string)
+
+	n := d.Get("resource_mapping").(*schema.Set)
+
+	if err := syncDraftAppVersionResourceMappings(ctx, conn, appARN, schema.NewSet(n.F, nil), n); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameAppVersion, appARN, err)
+	}
+
+	d.SetId(appARN)
+
+	if err := resolveAndPublishAppVersion(ctx, conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameAppVersion, d.Id(), err)
+	}
+
+	return resourceAppVersionRead(ctx, d, meta)
+}
+
+func resourceAppVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	out, err := FindAppVersionResourceMappings(ctx, conn, d.Id(), appVersionDraft)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ResilienceHub App (%s) not found, removing app version from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameAppVersion, d.Id(), err)
+	}
+
+	d.Set("app_arn", d.Id())
+
+	if err := d.Set("resource_mapping", flattenResourceMappings(out)); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameAppVersion, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAppVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	if d.HasChange("resource_mapping") {
+		o, n := d.GetChange("resource_mapping")
+
+		if err := syncDraftAppVersionResourceMappings(ctx, conn, d.Id(), o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameAppVersion, d.Id(), err)
+		}
+
+		if err := resolveAndPublishAppVersion(ctx, conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameAppVersion, d.Id(), err)
+		}
+	}
+
+	return resourceAppVersionRead(ctx, d, meta)
+}
+
+func resourceAppVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	log.Printf("[INFO] Deleting ResilienceHub App (%s) draft version resource mappings", d.Id())
+
+	o := d.Get("resource_mapping").(*schema.Set)
+	err := syncDraftAppVersionResourceMappings(ctx, conn, d.Id(), o, schema.NewSet(o.F, nil))
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionDeleting, ResNameAppVersion, d.Id(), err)
+	}
+
+	return nil
+}
+
+// syncDraftAppVersionResourceMappings removes and adds resource mappings on the application's draft version to move from the old to the new set.
+func syncDraftAppVersionResourceMappings(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN string, oldSet, newSet *schema.Set) error {
+	if del := oldSet.Difference(newSet).List(); len(del) > 0 {
+		input := expandRemoveDraftAppVersionResourceMappingsInput(appARN, del)
+
+		log.Printf("[DEBUG] Removing ResilienceHub App draft version resource mappings: %s", input)
+		if _, err := conn.RemoveDraftAppVersionResourceMappingsWithContext(ctx, input); err != nil {
+			return fmt.Errorf("removing resource mappings: %w", err)
+		}
+	}
+
+	if add := newSet.Difference(oldSet).List(); len(add) > 0 {
+		input := &resiliencehub.AddDraftAppVersionResourceMappingsInput{
+			AppArn:           aws.String(appARN),
+			ResourceMappings: expandResourceMappings(add),
+		}
+
+		log.Printf("[DEBUG] Adding ResilienceHub App draft version resource mappings: %s", input)
+		if _, err := conn.AddDraftAppVersionResourceMappingsWithContext(ctx, input); err != nil {
+			return fmt.Errorf("adding resource mappings: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// resolveAndPublishAppVersion resolves the resources of the application's draft version and publishes it as a new release.
+func resolveAndPublishAppVersion(ctx context.Context, conn *resiliencehub.ResilienceHub, d *schema.ResourceData, timeout time.Duration) error {
+	resolveOutput, err := conn.ResolveAppVersionResourcesWithContext(ctx, &resiliencehub.ResolveAppVersionResourcesInput{
+		AppArn:     aws.String(d.Id()),
+		AppVersion: aws.String(appVersionDraft),
+	})
+
+	if err != nil {
+		return fmt.Errorf("resolving resources: %w", err)
+	}
+
+	if _, err := waitAppVersionResourcesResolved(ctx, conn, d.Id(), appVersionDraft, aws.StringValue(resolveOutput.ResolutionId), timeout); err != nil {
+		return fmt.Errorf("waiting for resource resolution: %w", err)
+	}
+
+	publishOutput, err := conn.PublishAppVersionWithContext(ctx, &resiliencehub.PublishAppVersionInput{
+		AppArn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("publishing: %w", err)
+	}
+
+	if publishOutput == nil {
+		return errors.New("publishing: empty output")
+	}
+
+	d.Set("app_version", publishOutput.AppVersion)
+
+	return nil
+}
+
+func expandRemoveDraftAppVersionResourceMappingsInput(appARN string, tfList []interface{}) *resiliencehub.RemoveDraftAppVersionResourceMappingsInput {
+	input := &resiliencehub.RemoveDraftAppVersionResourceMappingsInput{
+		AppArn: aws.String(appARN),
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		switch tfMap["mapping_type"].(string) {
+		case resiliencehub.ResourceMappingTypeAppRegistryApp:
+			input.AppRegistryAppNames = append(input.AppRegistryAppNames, aws.String(tfMap["app_registry_app_name"].(string)))
+		case resiliencehub.ResourceMappingTypeCfnStack:
+			input.LogicalStackNames = append(input.LogicalStackNames, aws.String(tfMap["logical_stack_name"].(string)))
+		case resiliencehub.ResourceMappingTypeResourceGroup:
+			input.ResourceGroupNames = append(input.ResourceGroupNames, aws.String(tfMap["resource_group_name"].(string)))
+		case resiliencehub.ResourceMappingTypeResource:
+			input.ResourceNames = append(input.ResourceNames, aws.String(tfMap["resource_name"].(string)))
+		case resiliencehub.ResourceMappingTypeTerraform:
+			input.TerraformSourceNames = append(input.TerraformSourceNames, aws.String(tfMap["terraform_source_name"].(string)))
+		}
+	}
+
+	return input
+}
+
+func expandResourceMappings(tfList []interface{}) []*resiliencehub.ResourceMapping {
+	var apiObjects []*resiliencehub.ResourceMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandResourceMapping(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandResourceMapping(tfMap map[string]interface{}) *resiliencehub.ResourceMapping {
+	apiObject := &resiliencehub.ResourceMapping{}
+
+	if v, ok := tfMap["app_registry_app_name"].(string); ok && v != "" {
+		apiObject.AppRegistryAppName = aws.String(v)
+	}
+
+	if v, ok := tfMap["logical_stack_name"].(string); ok && v != "" {
+		apiObject.LogicalStackName = aws.String(v)
+	}
+
+	if v, ok := tfMap["mapping_type"].(string); ok && v != "" {
+		apiObject.MappingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["physical_resource_id"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PhysicalResourceId = expandPhysicalResourceID(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["resource_group_name"].(string); ok && v != "" {
+		apiObject.ResourceGroupName = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_name"].(string); ok && v != "" {
+		apiObject.ResourceName = aws.String(v)
+	}
+
+	if v, ok := tfMap["terraform_source_name"].(string); ok && v != "" {
+		apiObject.TerraformSourceName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPhysicalResourceID(tfMap map[string]interface{}) *resiliencehub.PhysicalResourceId {
+	apiObject := &resiliencehub.PhysicalResourceId{}
+
+	if v, ok := tfMap["aws_account_id"].(string); ok && v != "" {
+		apiObject.AwsAccountId = aws.String(v)
+	}
+
+	if v, ok := tfMap["aws_region"].(string); ok && v != "" {
+		apiObject.AwsRegion = aws.String(v)
+	}
+
+	if v, ok := tfMap["identifier"].(string); ok && v != "" {
+		apiObject.Identifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenResourceMappings(apiObjects []*resiliencehub.ResourceMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenResourceMapping(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenResourceMapping(apiObject *resiliencehub.ResourceMapping) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"app_registry_app_name": aws.StringValue(apiObject.AppRegistryAppName),
+		"logical_stack_name":    aws.StringValue(apiObject.LogicalStackName),
+		"mapping_type":          aws.StringValue(apiObject.MappingType),
+		"resource_group_name":   aws.StringValue(apiObject.ResourceGroupName),
+		"resource_name":         aws.StringValue(apiObject.ResourceName),
+		"terraform_source_name": aws.StringValue(apiObject.TerraformSourceName),
+	}
+
+	if v := apiObject.PhysicalResourceId; v != nil {
+		tfMap["physical_resource_id"] = []interface{}{flattenPhysicalResourceID(v)}
+	}
+
+	return tfMap
+}
+
+func flattenPhysicalResourceID(apiObject *resiliencehub.PhysicalResourceId) map[string]interface{} {
+	return map[string]interface{}{
+		"aws_account_id": aws.StringValue(apiObject.AwsAccountId),
+		"aws_region":     aws.StringValue(apiObject.AwsRegion),
+		"identifier":     aws.StringValue(apiObject.Identifier),
+		"type":           aws.StringValue(apiObject.Type),
+	}
+}
@@ -0,0 +1,93 @@
+package resiliencehub_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccResilienceHubAppVersion_basic(t *testing.T) {
+	var app resiliencehub.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app_version.test"
+	appResourceName := "aws_resiliencehub_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(resiliencehub.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(appResourceName, &app),
+					resource.TestCheckResourceAttrPair(resourceName, "app_arn", appResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_version"),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_mapping.*", map[string]string{
+						"logical_stack_name":          rName,
+						"mapping_type":                "CfnStack",
+						"physical_resource_id.#":      "1",
+						"physical_resource_id.0.type": "Arn",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"app_version"},
+			},
+		},
+	})
+}
+
+func testAccAppVersionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = jsonencode({
+    Resources = {
+      Table = {
+        Type = "AWS::DynamoDB::Table"
+        Properties = {
+          BillingMode = "PAY_PER_REQUEST"
+          AttributeDefinitions = [{
+            AttributeName = "id"
+            AttributeType = "S"
+          }]
+          KeySchema = [{
+            AttributeName = "id"
+            KeyType       = "HASH"
+          }]
+        }
+      }
+    }
+  })
+}
+
+resource "aws_resiliencehub_app_version" "test" {
+  app_arn = aws_resiliencehub_app.test.arn
+
+  resource_mapping {
+    logical_stack_name = %[1]q
+    mapping_type       = "CfnStack"
+
+    physical_resource_id {
+      identifier = aws_cloudformation_stack.test.id
+      type       = "Arn"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAppVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAppConfig_basic(rName), testAccAppVersionConfig_base(rName))
+}
@@ -0,0 +1,136 @@
+package resiliencehub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAppByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.App, error) {
+	in := &resiliencehub.DescribeAppInput{
+		AppArn: aws.String(arn),
+	}
+	out, err := conn.DescribeAppWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.App == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.App, nil
+}
+
+func FindAppAssessmentByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.AppAssessment, error) {
+	in := &resiliencehub.DescribeAppAssessmentInput{
+		AssessmentArn: aws.String(arn),
+	}
+	out, err := conn.DescribeAppAssessmentWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Assessment == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Assessment, nil
+}
+
+func FindAppVersionResourceMappings(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN, appVersion string) ([]*resiliencehub.ResourceMapping, error) {
+	in := &resiliencehub.ListAppVersionResourceMappingsInput{
+		AppArn:     aws.String(appARN),
+		AppVersion: aws.String(appVersion),
+	}
+	var out []*resiliencehub.ResourceMapping
+
+	err := conn.ListAppVersionResourceMappingsPagesWithContext(ctx, in, func(page *resiliencehub.ListAppVersionResourceMappingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		out = append(out, page.ResourceMappings...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func FindAppVersionResourcesResolutionStatus(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN, appVersion, resolutionID string) (*resiliencehub.DescribeAppVersionResourcesResolutionStatusOutput, error) {
+	in := &resiliencehub.DescribeAppVersionResourcesResolutionStatusInput{
+		AppArn:       aws.String(appARN),
+		AppVersion:   aws.String(appVersion),
+		ResolutionId: aws.String(resolutionID),
+	}
+	out, err := conn.DescribeAppVersionResourcesResolutionStatusWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindResiliencyPolicyByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.ResiliencyPolicy, error) {
+	in := &resiliencehub.DescribeResiliencyPolicyInput{
+		PolicyArn: aws.String(arn),
+	}
+	out, err := conn.DescribeResiliencyPolicyWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Policy, nil
+}
@@ -0,0 +1,315 @@
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceResiliencyPolicy() *schema.Resource {
+	failurePolicySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rpo_in_secs": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"rto_in_secs": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResiliencyPolicyCreate,
+		ReadWithoutTimeout:   resourceResiliencyPolicyRead,
+		UpdateWithoutTimeout: resourceResiliencyPolicyUpdate,
+		DeleteWithoutTimeout: resourceResiliencyPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_location_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.DataLocationConstraint_Values(), false),
+			},
+			"estimated_cost_tier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"az":       failurePolicySchema(),
+						"hardware": failurePolicySchema(),
+						"region": func() *schema.Schema {
+							s := failurePolicySchema()
+							s.Required = false
+							s.Optional = true
+							return s
+						}(),
+						"software": failurePolicySchema(),
+					},
+				},
+			},
+			"policy_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"policy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), "must begin with an alphanumeric character and contain only alphanumeric characters, hyphens or underscores, and be between 2 and 60 characters"),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.ResiliencyPolicyTier_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameResiliencyPolicy = "Resiliency Policy"
+)
+
+func resourceResiliencyPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("policy_name").(string)
+	in := &resiliencehub.CreateResiliencyPolicyInput{
+		Policy:     expandFailurePolicies(d.Get("policy").([]interface{})),
+		PolicyName: aws.String(name),
+		Tier:       aws.String(d.Get("tier").(string)),
+	}
+
+	if v, ok := d.GetOk("data_location_constraint"); ok {
+		in.DataLocationConstraint = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_description"); ok {
+		in.PolicyDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateResiliencyPolicyWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameResiliencyPolicy, name, err)
+	}
+
+	if out == nil || out.Policy == nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameResiliencyPolicy, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.Policy.PolicyArn))
+
+	return resourceResiliencyPolicyRead(ctx, d, meta)
+}
+
+func resourceResiliencyPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	out, err := FindResiliencyPolicyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ResilienceHub ResiliencyPolicy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	d.Set("arn", out.PolicyArn)
+	d.Set("data_location_constraint", out.DataLocationConstraint)
+	d.Set("estimated_cost_tier", out.EstimatedCostTier)
+
+	if err := d.Set("policy", flattenFailurePolicies(out.Policy)); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	d.Set("policy_description", out.PolicyDescription)
+	d.Set("policy_name", out.PolicyName)
+	d.Set("tier", out.Tier)
+
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceResiliencyPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &resiliencehub.UpdateResiliencyPolicyInput{
+			Policy:            expandFailurePolicies(d.Get("policy").([]interface{})),
+			PolicyArn:         aws.String(d.Id()),
+			PolicyDescription: aws.String(d.Get("policy_description").(string)),
+			PolicyName:        aws.String(d.Get("policy_name").(string)),
+			Tier:              aws.String(d.Get("tier").(string)),
+		}
+
+		if v, ok := d.GetOk("data_location_constraint"); ok {
+			in.DataLocationConstraint = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating ResilienceHub ResiliencyPolicy (%s): %#v", d.Id(), in)
+		if _, err := conn.UpdateResiliencyPolicyWithContext(ctx, in); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameResiliencyPolicy, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameResiliencyPolicy, d.Id(), err)
+		}
+	}
+
+	return resourceResiliencyPolicyRead(ctx, d, meta)
+}
+
+func resourceResiliencyPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	log.Printf("[INFO] Deleting ResilienceHub ResiliencyPolicy %s", d.Id())
+
+	_, err := conn.DeleteResiliencyPolicyWithContext(ctx, &resiliencehub.DeleteResiliencyPolicyInput{
+		PolicyArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionDeleting, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	return nil
+}
+
+var failurePolicyDisruptionTypes = map[string]string{
+	"az":       resiliencehub.DisruptionTypeAz,
+	"hardware": resiliencehub.DisruptionTypeHardware,
+	"region":   resiliencehub.DisruptionTypeRegion,
+	"software": resiliencehub.DisruptionTypeSoftware,
+}
+
+func expandFailurePolicies(tfList []interface{}) map[string]*resiliencehub.FailurePolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := map[string]*resiliencehub.FailurePolicy{}
+
+	for key, disruptionType := range failurePolicyDisruptionTypes {
+		if v, ok := tfMap[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject[disruptionType] = expandFailurePolicy(v[0].(map[string]interface{}))
+		}
+	}
+
+	return apiObject
+}
+
+func expandFailurePolicy(tfMap map[string]interface{}) *resiliencehub.FailurePolicy {
+	apiObject := &resiliencehub.FailurePolicy{}
+
+	if v, ok := tfMap["rpo_in_secs"].(int); ok {
+		apiObject.RpoInSecs = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["rto_in_secs"].(int); ok {
+		apiObject.RtoInSecs = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenFailurePolicies(apiObject map[string]*resiliencehub.FailurePolicy) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for key, disruptionType := range failurePolicyDisruptionTypes {
+		if v, ok := apiObject[disruptionType]; ok && v != nil {
+			tfMap[key] = []interface{}{flattenFailurePolicy(v)}
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFailurePolicy(apiObject *resiliencehub.FailurePolicy) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.RpoInSecs; v != nil {
+		tfMap["rpo_in_secs"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.RtoInSecs; v != nil {
+		tfMap["rto_in_secs"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,327 @@
+package resiliencehub_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfresiliencehub "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResilienceHubResiliencyPolicy_basic(t *testing.T) {
+	var policy resiliencehub.ResiliencyPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(resiliencehub.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(resourceName, &policy),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "resiliencehub", regexp.MustCompile(`resiliency-policy/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "estimated_cost_tier"),
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.az.0.rpo_in_secs", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.az.0.rto_in_secs", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tier", "NonCritical"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubResiliencyPolicy_update(t *testing.T) {
+	var policy resiliencehub.ResiliencyPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(resiliencehub.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "tier", "NonCritical"),
+				),
+			},
+			{
+				Config: testAccResiliencyPolicyConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "data_location_constraint", "SameContinent"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.0.rpo_in_secs", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.0.rto_in_secs", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.software.0.rto_in_secs", "300"),
+					resource.TestCheckResourceAttr(resourceName, "policy_description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Critical"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubResiliencyPolicy_disappears(t *testing.T) {
+	var policy resiliencehub.ResiliencyPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(resiliencehub.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(resourceName, &policy),
+					acctest.CheckResourceDisappears(acctest.Provider, tfresiliencehub.ResourceResiliencyPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubResiliencyPolicy_tags(t *testing.T) {
+	var policy resiliencehub.ResiliencyPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(resiliencehub.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResiliencyPolicyConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccResiliencyPolicyConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResiliencyPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resiliencehub_resiliency_policy" {
+			continue
+		}
+
+		_, err := tfresiliencehub.FindResiliencyPolicyByARN(ctx, conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.ResilienceHub, create.ErrActionCheckingDestroyed, tfresiliencehub.ResNameResiliencyPolicy, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckResiliencyPolicyExists(name string, policy *resiliencehub.ResiliencyPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ResilienceHub, create.ErrActionCheckingExistence, tfresiliencehub.ResNameResiliencyPolicy, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ResilienceHub, create.ErrActionCheckingExistence, tfresiliencehub.ResNameResiliencyPolicy, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+		ctx := context.Background()
+		resp, err := tfresiliencehub.FindResiliencyPolicyByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.ResilienceHub, create.ErrActionCheckingExistence, tfresiliencehub.ResNameResiliencyPolicy, rs.Primary.ID, err)
+		}
+
+		*policy = *resp
+
+		return nil
+	}
+}
+
+func testAccResiliencyPolicyConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  policy_name = %[1]q
+  tier        = "NonCritical"
+
+  policy {
+    az {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    hardware {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    software {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+  }
+}
+`, rName)
+}
+
+func testAccResiliencyPolicyConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  policy_name              = %[1]q
+  policy_description       = "updated"
+  tier                     = "Critical"
+  data_location_constraint = "SameContinent"
+
+  policy {
+    az {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    hardware {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    software {
+      rpo_in_secs = 300
+      rto_in_secs = 300
+    }
+
+    region {
+      rpo_in_secs = 86400
+      rto_in_secs = 86400
+    }
+  }
+}
+`, rName)
+}
+
+func testAccResiliencyPolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  policy_name = %[1]q
+  tier        = "NonCritical"
+
+  policy {
+    az {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    hardware {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    software {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccResiliencyPolicyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  policy_name = %[1]q
+  tier        = "NonCritical"
+
+  policy {
+    az {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    hardware {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    software {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,55 @@
+package resiliencehub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusApp(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindAppByARN(ctx, conn, arn)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.Status), nil
+	}
+}
+
+func statusAppAssessment(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindAppAssessmentByARN(ctx, conn, arn)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.AssessmentStatus), nil
+	}
+}
+
+func statusAppVersionResourcesResolution(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN, appVersion, resolutionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindAppVersionResourcesResolutionStatus(ctx, conn, appARN, appVersion, resolutionID)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.Status), nil
+	}
+}
@@ -0,0 +1,68 @@
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitAppDeleted(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, timeout time.Duration) (*resiliencehub.App, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resiliencehub.AppStatusTypeActive, resiliencehub.AppStatusTypeDeleting},
+		Target:  []string{},
+		Refresh: statusApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*resiliencehub.App); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitAppAssessmentCompleted(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, timeout time.Duration) (*resiliencehub.AppAssessment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resiliencehub.AssessmentStatusPending, resiliencehub.AssessmentStatusInProgress},
+		Target:  []string{resiliencehub.AssessmentStatusSuccess},
+		Refresh: statusAppAssessment(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*resiliencehub.AppAssessment); ok {
+		if aws.StringValue(out.AssessmentStatus) == resiliencehub.AssessmentStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(out.Message)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitAppVersionResourcesResolved(ctx context.Context, conn *resiliencehub.ResilienceHub, appARN, appVersion, resolutionID string, timeout time.Duration) (*resiliencehub.DescribeAppVersionResourcesResolutionStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resiliencehub.ResourceResolutionStatusTypePending, resiliencehub.ResourceResolutionStatusTypeInProgress},
+		Target:  []string{resiliencehub.ResourceResolutionStatusTypeSuccess},
+		Refresh: statusAppVersionResourcesResolution(ctx, conn, appARN, appVersion, resolutionID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*resiliencehub.DescribeAppVersionResourcesResolutionStatusOutput); ok {
+		if aws.StringValue(out.Status) == resiliencehub.ResourceResolutionStatusTypeFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(out.ErrorMessage)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
@@ -987,7 +987,13 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if output, ok := pol.(*s3.GetBucketPolicyOutput); ok {
-		d.Set("policy", output.Policy)
+		policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
+
+		if err != nil {
+			return create.Error(names.S3, create.ErrActionReading, resNameBucket, d.Id(), err)
+		}
+
+		d.Set("policy", policyToSet)
 	} else {
 		d.Set("policy", nil)
 	}
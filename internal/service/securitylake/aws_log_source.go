@@ -0,0 +1,166 @@
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAWSLogSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAWSLogSourceCreate,
+		ReadWithoutTimeout:   resourceAWSLogSourceRead,
+		DeleteWithoutTimeout: resourceAWSLogSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"regions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(securitylake.Region_Values(), false),
+				},
+			},
+			"source_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(securitylake.AwsLogSourceType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAWSLogSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	sourceType := d.Get("source_type").(string)
+	regions := flex.ExpandStringValueSet(d.Get("regions").(*schema.Set))
+	var accounts []string
+	if v, ok := d.GetOk("accounts"); ok && v.(*schema.Set).Len() > 0 {
+		accounts = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	input := &securitylake.CreateAwsLogSourceInput{}
+
+	if len(accounts) > 0 {
+		input.InputOrder = aws.StringSlice([]string{securitylake.DimensionRegion, securitylake.DimensionSourceType, securitylake.DimensionMember})
+		input.EnableAllDimensions = make(map[string]map[string][]*string)
+		for _, region := range regions {
+			input.EnableAllDimensions[region] = map[string][]*string{
+				sourceType: aws.StringSlice(accounts),
+			}
+		}
+	} else {
+		input.InputOrder = aws.StringSlice([]string{securitylake.DimensionRegion, securitylake.DimensionSourceType})
+		input.EnableTwoDimensions = make(map[string][]*string)
+		for _, region := range regions {
+			input.EnableTwoDimensions[region] = aws.StringSlice([]string{sourceType})
+		}
+	}
+
+	output, err := conn.CreateAwsLogSourceWithContext(ctx, input)
+
+	if err == nil && output != nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed in Regions: %s", strings.Join(aws.StringValueSlice(output.Failed), ", "))
+	}
+
+	if err != nil {
+		return diag.Errorf("creating Security Lake AWS Log Source (%s): %s", sourceType, err)
+	}
+
+	d.SetId(sourceType)
+
+	return resourceAWSLogSourceRead(ctx, d, meta)
+}
+
+func resourceAWSLogSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	regions, accounts, err := FindLogSourceBySourceType(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake AWS Log Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Lake AWS Log Source (%s): %s", d.Id(), err)
+	}
+
+	d.Set("accounts", accounts)
+	d.Set("regions", regions)
+	d.Set("source_type", d.Id())
+
+	return nil
+}
+
+func resourceAWSLogSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	regions := flex.ExpandStringValueSet(d.Get("regions").(*schema.Set))
+	accounts := flex.ExpandStringValueSet(d.Get("accounts").(*schema.Set))
+
+	input := &securitylake.DeleteAwsLogSourceInput{}
+
+	if len(accounts) > 0 {
+		input.InputOrder = aws.StringSlice([]string{securitylake.DimensionRegion, securitylake.DimensionSourceType, securitylake.DimensionMember})
+		input.DisableAllDimensions = make(map[string]map[string][]*string)
+		for _, region := range regions {
+			input.DisableAllDimensions[region] = map[string][]*string{
+				d.Id(): aws.StringSlice(accounts),
+			}
+		}
+	} else {
+		input.InputOrder = aws.StringSlice([]string{securitylake.DimensionRegion, securitylake.DimensionSourceType})
+		input.DisableTwoDimensions = make(map[string][]*string)
+		for _, region := range regions {
+			input.DisableTwoDimensions[region] = aws.StringSlice([]string{d.Id()})
+		}
+	}
+
+	log.Printf("[INFO] Deleting Security Lake AWS Log Source: %s", d.Id())
+	output, err := conn.DeleteAwsLogSourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err == nil && output != nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed in Regions: %s", strings.Join(aws.StringValueSlice(output.Failed), ", "))
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Lake AWS Log Source (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAWSLogSource_basic(t *testing.T) {
+	resourceName := "aws_securitylake_aws_log_source.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(securitylake.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securitylake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAWSLogSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLogSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLogSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "accounts.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "source_type", securitylake.AwsLogSourceTypeRoute53),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSLogSource_disappears(t *testing.T) {
+	resourceName := "aws_securitylake_aws_log_source.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(securitylake.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securitylake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAWSLogSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLogSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLogSourceExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsecuritylake.ResourceAWSLogSource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLogSourceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securitylake_aws_log_source" {
+			continue
+		}
+
+		_, _, err := tfsecuritylake.FindLogSourceBySourceType(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Security Lake AWS Log Source %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLogSourceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Lake AWS Log Source ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+		_, _, err := tfsecuritylake.FindLogSourceBySourceType(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSLogSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(), `
+resource "aws_securitylake_aws_log_source" "test" {
+  source_type = "ROUTE53"
+  regions     = [aws_securitylake_data_lake.test.configuration[0].region]
+  accounts    = [data.aws_caller_identity.current.account_id]
+}
+`)
+}
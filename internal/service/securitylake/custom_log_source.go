@@ -0,0 +1,140 @@
+package securitylake
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomLogSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomLogSourceCreate,
+		ReadWithoutTimeout:   resourceCustomLogSourceRead,
+		DeleteWithoutTimeout: resourceCustomLogSourceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"custom_data_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_source_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"event_class": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(securitylake.OcsfEventClass_Values(), false),
+			},
+			"glue_crawler_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"glue_database_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"glue_invocation_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"glue_table_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_provider_access_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_provider_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+		},
+	}
+}
+
+func resourceCustomLogSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	name := d.Get("custom_source_name").(string)
+	input := &securitylake.CreateCustomLogSourceInput{
+		CustomSourceName:      aws.String(name),
+		EventClass:            aws.String(d.Get("event_class").(string)),
+		GlueInvocationRoleArn: aws.String(d.Get("glue_invocation_role_arn").(string)),
+		LogProviderAccountId:  aws.String(d.Get("log_provider_account_id").(string)),
+	}
+
+	output, err := conn.CreateCustomLogSourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Security Lake Custom Log Source (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	// The Glue and IAM resources created on behalf of the custom source are
+	// only returned by the create operation.
+	d.Set("custom_data_location", output.CustomDataLocation)
+	d.Set("glue_crawler_name", output.GlueCrawlerName)
+	d.Set("glue_database_name", output.GlueDatabaseName)
+	d.Set("glue_table_name", output.GlueTableName)
+	d.Set("log_provider_access_role_arn", output.LogProviderAccessRoleArn)
+
+	return resourceCustomLogSourceRead(ctx, d, meta)
+}
+
+func resourceCustomLogSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	_, _, err := FindLogSourceBySourceType(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Custom Log Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil && !tfresource.NotFound(err) {
+		return diag.Errorf("reading Security Lake Custom Log Source (%s): %s", d.Id(), err)
+	}
+
+	d.Set("custom_source_name", d.Id())
+
+	return nil
+}
+
+func resourceCustomLogSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	log.Printf("[INFO] Deleting Security Lake Custom Log Source: %s", d.Id())
+	_, err := conn.DeleteCustomLogSourceWithContext(ctx, &securitylake.DeleteCustomLogSourceInput{
+		CustomSourceName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Lake Custom Log Source (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
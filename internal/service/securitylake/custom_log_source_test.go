@@ -0,0 +1,121 @@
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccCustomLogSource_basic(t *testing.T) {
+	resourceName := "aws_securitylake_custom_log_source.test"
+	rName := sdkacctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(securitylake.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securitylake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLogSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLogSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomLogSourceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "custom_data_location"),
+					resource.TestCheckResourceAttr(resourceName, "custom_source_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "event_class", securitylake.OcsfEventClassNetworkActivity),
+					resource.TestCheckResourceAttrSet(resourceName, "glue_crawler_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "glue_database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "glue_invocation_role_arn", "aws_iam_role.glue", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "glue_table_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "log_provider_access_role_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_provider_account_id", "data.aws_caller_identity.current", "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomLogSourceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securitylake_custom_log_source" {
+			continue
+		}
+
+		_, _, err := tfsecuritylake.FindLogSourceBySourceType(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Security Lake Custom Log Source %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCustomLogSourceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Lake Custom Log Source ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccCustomLogSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(), fmt.Sprintf(`
+resource "aws_iam_role" "glue" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "glue" {
+  role       = aws_iam_role.glue.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+}
+
+resource "aws_securitylake_custom_log_source" "test" {
+  custom_source_name       = %[1]q
+  event_class              = "NETWORK_ACTIVITY"
+  glue_invocation_role_arn = aws_iam_role.glue.arn
+  log_provider_account_id  = data.aws_caller_identity.current.account_id
+
+  depends_on = [aws_securitylake_data_lake.test, aws_iam_role_policy_attachment.glue]
+}
+`, rName))
+}
@@ -0,0 +1,340 @@
+package securitylake
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataLake() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataLakeCreate,
+		ReadWithoutTimeout:   resourceDataLakeRead,
+		UpdateWithoutTimeout: resourceDataLakeUpdate,
+		DeleteWithoutTimeout: resourceDataLakeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(securitylake.Region_Values(), false),
+						},
+						"replication_destination_regions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(securitylake.Region_Values(), false),
+							},
+						},
+						"replication_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"retention_setting": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"retention_period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(securitylake.StorageClass_Values(), false),
+									},
+								},
+							},
+						},
+						"s3_bucket_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"meta_store_manager_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceDataLakeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	configurations := expandLakeConfigurationRequests(d.Get("configuration").([]interface{}))
+	input := &securitylake.CreateDatalakeInput{
+		Configurations:          configurations,
+		MetaStoreManagerRoleArn: aws.String(d.Get("meta_store_manager_role_arn").(string)),
+	}
+
+	for region := range configurations {
+		input.Regions = append(input.Regions, aws.String(region))
+	}
+
+	_, err := conn.CreateDatalakeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Security Lake Data Lake: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	if _, err := waitDataLakeCompleted(ctx, conn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Security Lake Data Lake (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDataLakeRead(ctx, d, meta)
+}
+
+func resourceDataLakeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	configurations, err := FindDataLake(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Data Lake (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Lake Data Lake (%s): %s", d.Id(), err)
+	}
+
+	// The API returns configurations keyed by Region, so preserve the order
+	// of any Regions already in configuration to avoid spurious diffs.
+	var regions []string
+	for _, tfMapRaw := range d.Get("configuration").([]interface{}) {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			regions = append(regions, tfMap["region"].(string))
+		}
+	}
+
+	if err := d.Set("configuration", flattenLakeConfigurationResponses(configurations, regions)); err != nil {
+		return diag.Errorf("setting configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataLakeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	if d.HasChange("configuration") {
+		input := &securitylake.UpdateDatalakeInput{
+			Configurations: expandLakeConfigurationRequests(d.Get("configuration").([]interface{})),
+		}
+
+		_, err := conn.UpdateDatalakeWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Security Lake Data Lake (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDataLakeCompleted(ctx, conn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Security Lake Data Lake (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDataLakeRead(ctx, d, meta)
+}
+
+func resourceDataLakeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	log.Printf("[INFO] Deleting Security Lake Data Lake: %s", d.Id())
+	_, err := conn.DeleteDatalakeWithContext(ctx, &securitylake.DeleteDatalakeInput{})
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Lake Data Lake (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDataLakeDeleted(ctx, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Security Lake Data Lake (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLakeConfigurationRequests(tfList []interface{}) map[string]*securitylake.LakeConfigurationRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*securitylake.LakeConfigurationRequest)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &securitylake.LakeConfigurationRequest{}
+
+		if v, ok := tfMap["encryption_key"].(string); ok && v != "" {
+			apiObject.EncryptionKey = aws.String(v)
+		}
+
+		if v, ok := tfMap["replication_destination_regions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ReplicationDestinationRegions = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["replication_role_arn"].(string); ok && v != "" {
+			apiObject.ReplicationRoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["retention_setting"].([]interface{}); ok && len(v) > 0 {
+			apiObject.RetentionSettings = expandRetentionSettings(v)
+		}
+
+		apiObjects[tfMap["region"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandRetentionSettings(tfList []interface{}) []*securitylake.RetentionSetting {
+	var apiObjects []*securitylake.RetentionSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &securitylake.RetentionSetting{}
+
+		if v, ok := tfMap["retention_period"].(int); ok && v != 0 {
+			apiObject.RetentionPeriod = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+			apiObject.StorageClass = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLakeConfigurationResponses(apiObjects map[string]*securitylake.LakeConfigurationResponse, regionOrder []string) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var regions []string
+	seen := make(map[string]bool)
+
+	for _, region := range regionOrder {
+		if _, ok := apiObjects[region]; ok && !seen[region] {
+			seen[region] = true
+			regions = append(regions, region)
+		}
+	}
+
+	var remaining []string
+
+	for region := range apiObjects {
+		if !seen[region] {
+			remaining = append(remaining, region)
+		}
+	}
+
+	sort.Strings(remaining)
+	regions = append(regions, remaining...)
+
+	var tfList []interface{}
+
+	for _, region := range regions {
+		apiObject := apiObjects[region]
+
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"encryption_key":                  aws.StringValue(apiObject.EncryptionKey),
+			"region":                          region,
+			"replication_destination_regions": aws.StringValueSlice(apiObject.ReplicationDestinationRegions),
+			"replication_role_arn":            aws.StringValue(apiObject.ReplicationRoleArn),
+			"retention_setting":               flattenRetentionSettings(apiObject.RetentionSettings),
+			"s3_bucket_arn":                   aws.StringValue(apiObject.S3BucketArn),
+			"status":                          aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
+
+func flattenRetentionSettings(apiObjects []*securitylake.RetentionSetting) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"retention_period": int(aws.Int64Value(apiObject.RetentionPeriod)),
+			"storage_class":    aws.StringValue(apiObject.StorageClass),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,178 @@
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccDataLake_basic(t *testing.T) {
+	resourceName := "aws_securitylake_data_lake.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(securitylake.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securitylake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataLakeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLakeConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataLakeExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.region", acctest.Region()),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration.0.s3_bucket_arn"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.status", securitylake.SettingsStatusCompleted),
+					resource.TestCheckResourceAttrPair(resourceName, "meta_store_manager_role_arn", "aws_iam_role.meta_store_manager", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"meta_store_manager_role_arn"},
+			},
+		},
+	})
+}
+
+func testAccDataLake_disappears(t *testing.T) {
+	resourceName := "aws_securitylake_data_lake.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(securitylake.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securitylake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataLakeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLakeConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataLakeExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsecuritylake.ResourceDataLake(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccDataLake_update(t *testing.T) {
+	resourceName := "aws_securitylake_data_lake.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(securitylake.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securitylake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataLakeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLakeConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataLakeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_setting.#", "0"),
+				),
+			},
+			{
+				Config: testAccDataLakeConfig_retention(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataLakeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_setting.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_setting.0.retention_period", "31"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_setting.0.storage_class", securitylake.StorageClassStandardIa),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_setting.1.retention_period", "365"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_setting.1.storage_class", securitylake.StorageClassExpire),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataLakeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securitylake_data_lake" {
+			continue
+		}
+
+		_, err := tfsecuritylake.FindDataLake(context.Background(), conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Security Lake Data Lake %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDataLakeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Lake Data Lake ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+		_, err := tfsecuritylake.FindDataLake(context.Background(), conn)
+
+		return err
+	}
+}
+
+func testAccDataLakeConfig_retention() string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_base(), `
+resource "aws_securitylake_data_lake" "test" {
+  meta_store_manager_role_arn = aws_iam_role.meta_store_manager.arn
+
+  configuration {
+    region = data.aws_region.current.name
+
+    retention_setting {
+      retention_period = 31
+      storage_class    = "STANDARD_IA"
+    }
+
+    retention_setting {
+      retention_period = 365
+      storage_class    = "EXPIRE"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.meta_store_manager]
+}
+`)
+}
@@ -0,0 +1,148 @@
+package securitylake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDataLake(ctx context.Context, conn *securitylake.SecurityLake) (map[string]*securitylake.LakeConfigurationResponse, error) {
+	input := &securitylake.GetDatalakeInput{}
+
+	output, err := conn.GetDatalakeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Configurations) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Configurations, nil
+}
+
+// FindLogSources returns the enabled log sources as a map of Region to source
+// type to member accounts.
+func FindLogSources(ctx context.Context, conn *securitylake.SecurityLake) (map[string]map[string][]string, error) {
+	input := &securitylake.ListLogSourcesInput{
+		InputOrder: aws.StringSlice([]string{
+			securitylake.DimensionRegion,
+			securitylake.DimensionSourceType,
+			securitylake.DimensionMember,
+		}),
+	}
+	output := make(map[string]map[string][]string)
+
+	err := conn.ListLogSourcesPagesWithContext(ctx, input, func(page *securitylake.ListLogSourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RegionSourceTypesAccountsList {
+			for region, sourceTypes := range v {
+				if _, ok := output[region]; !ok {
+					output[region] = make(map[string][]string)
+				}
+
+				for sourceType, accounts := range sourceTypes {
+					output[region][sourceType] = append(output[region][sourceType], aws.StringValueSlice(accounts)...)
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindLogSourceBySourceType returns the Regions and member accounts in which
+// the specified log source type is enabled.
+func FindLogSourceBySourceType(ctx context.Context, conn *securitylake.SecurityLake, sourceType string) ([]string, []string, error) {
+	sources, err := FindLogSources(ctx, conn)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var regions, accounts []string
+	seen := make(map[string]bool)
+
+	for region, sourceTypes := range sources {
+		v, ok := sourceTypes[sourceType]
+
+		if !ok {
+			continue
+		}
+
+		regions = append(regions, region)
+
+		for _, account := range v {
+			if !seen[account] {
+				seen[account] = true
+				accounts = append(accounts, account)
+			}
+		}
+	}
+
+	if len(regions) == 0 {
+		return nil, nil, &resource.NotFoundError{}
+	}
+
+	return regions, accounts, nil
+}
+
+func FindSubscriberByID(ctx context.Context, conn *securitylake.SecurityLake, id string) (*securitylake.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriberWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Subscriber.SubscriptionStatus); status == securitylake.SubscriptionStatusDeactivated {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Subscriber, nil
+}
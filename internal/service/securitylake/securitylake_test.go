@@ -0,0 +1,109 @@
+package securitylake_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Security Lake is an account-level singleton, so all acceptance tests that
+// create a data lake must run serially.
+func TestAccSecurityLake_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"DataLake": {
+			"basic":      testAccDataLake_basic,
+			"disappears": testAccDataLake_disappears,
+			"update":     testAccDataLake_update,
+		},
+		"AWSLogSource": {
+			"basic":      testAccAWSLogSource_basic,
+			"disappears": testAccAWSLogSource_disappears,
+		},
+		"CustomLogSource": {
+			"basic": testAccCustomLogSource_basic,
+		},
+		"Subscriber": {
+			"basic":      testAccSubscriber_basic,
+			"disappears": testAccSubscriber_disappears,
+			"update":     testAccSubscriber_update,
+		},
+		"SubscriberNotification": {
+			"sqs": testAccSubscriberNotification_sqs,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+	input := &securitylake.ListSubscribersInput{}
+
+	_, err := conn.ListSubscribers(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccDataLakeConfig_base() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "meta_store_manager" {
+  name = "AmazonSecurityLakeMetaStoreManager"
+  path = "/service-role/"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "meta_store_manager" {
+  role       = aws_iam_role.meta_store_manager.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonSecurityLakeMetastoreManager"
+}
+`
+}
+
+func testAccDataLakeConfig_basic() string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_base(), `
+resource "aws_securitylake_data_lake" "test" {
+  meta_store_manager_role_arn = aws_iam_role.meta_store_manager.arn
+
+  configuration {
+    region = data.aws_region.current.name
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.meta_store_manager]
+}
+`)
+}
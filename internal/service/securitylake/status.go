@@ -0,0 +1,41 @@
+package securitylake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusDataLake returns the aggregate status of the data lake configurations:
+// FAILED if any Region failed, COMPLETED once all Regions are complete and
+// PENDING otherwise.
+func statusDataLake(ctx context.Context, conn *securitylake.SecurityLake) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDataLake(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := securitylake.SettingsStatusCompleted
+
+		for _, v := range output {
+			switch aws.StringValue(v.Status) {
+			case securitylake.SettingsStatusFailed:
+				return output, securitylake.SettingsStatusFailed, nil
+			case securitylake.SettingsStatusCompleted:
+			default:
+				status = securitylake.SettingsStatusPending
+			}
+		}
+
+		return output, status, nil
+	}
+}
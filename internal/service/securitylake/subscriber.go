@@ -0,0 +1,270 @@
+package securitylake
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSubscriber() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSubscriberCreate,
+		ReadWithoutTimeout:   resourceSubscriberRead,
+		UpdateWithoutTimeout: resourceSubscriberUpdate,
+		DeleteWithoutTimeout: resourceSubscriberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(securitylake.AccessType_Values(), false),
+				},
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sns_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_type": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_source_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(securitylake.AwsLogSourceType_Values(), false),
+						},
+						"custom_source_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"subscriber_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subscriber_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"subscription_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscription_protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscription_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSubscriberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	name := d.Get("subscriber_name").(string)
+	input := &securitylake.CreateSubscriberInput{
+		AccountId:      aws.String(d.Get("account_id").(string)),
+		ExternalId:     aws.String(d.Get("external_id").(string)),
+		SourceTypes:    expandSourceTypes(d.Get("source_type").(*schema.Set).List()),
+		SubscriberName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("access_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccessTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("subscriber_description"); ok {
+		input.SubscriberDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSubscriberWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Security Lake Subscriber (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SubscriptionId))
+
+	return resourceSubscriberRead(ctx, d, meta)
+}
+
+func resourceSubscriberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	subscriber, err := FindSubscriberByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Subscriber (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Lake Subscriber (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_types", aws.StringValueSlice(subscriber.AccessTypes))
+	d.Set("account_id", subscriber.AccountId)
+	if subscriber.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(subscriber.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("external_id", subscriber.ExternalId)
+	d.Set("role_arn", subscriber.RoleArn)
+	d.Set("s3_bucket_arn", subscriber.S3BucketArn)
+	d.Set("sns_arn", subscriber.SnsArn)
+	if err := d.Set("source_type", flattenSourceTypes(subscriber.SourceTypes)); err != nil {
+		return diag.Errorf("setting source_type: %s", err)
+	}
+	d.Set("subscriber_description", subscriber.SubscriberDescription)
+	d.Set("subscriber_name", subscriber.SubscriberName)
+	d.Set("subscription_endpoint", subscriber.SubscriptionEndpoint)
+	d.Set("subscription_protocol", subscriber.SubscriptionProtocol)
+	d.Set("subscription_status", subscriber.SubscriptionStatus)
+	if subscriber.UpdatedAt != nil {
+		d.Set("updated_at", aws.TimeValue(subscriber.UpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("updated_at", nil)
+	}
+
+	return nil
+}
+
+func resourceSubscriberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	input := &securitylake.UpdateSubscriberInput{
+		ExternalId:            aws.String(d.Get("external_id").(string)),
+		Id:                    aws.String(d.Id()),
+		SourceTypes:           expandSourceTypes(d.Get("source_type").(*schema.Set).List()),
+		SubscriberDescription: aws.String(d.Get("subscriber_description").(string)),
+		SubscriberName:        aws.String(d.Get("subscriber_name").(string)),
+	}
+
+	_, err := conn.UpdateSubscriberWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Security Lake Subscriber (%s): %s", d.Id(), err)
+	}
+
+	return resourceSubscriberRead(ctx, d, meta)
+}
+
+func resourceSubscriberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	log.Printf("[INFO] Deleting Security Lake Subscriber: %s", d.Id())
+	_, err := conn.DeleteSubscriberWithContext(ctx, &securitylake.DeleteSubscriberInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Lake Subscriber (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSourceTypes(tfList []interface{}) []*securitylake.SourceType {
+	var apiObjects []*securitylake.SourceType
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &securitylake.SourceType{}
+
+		if v, ok := tfMap["aws_source_type"].(string); ok && v != "" {
+			apiObject.AwsSourceType = aws.String(v)
+		}
+
+		if v, ok := tfMap["custom_source_type"].(string); ok && v != "" {
+			apiObject.CustomSourceType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSourceTypes(apiObjects []*securitylake.SourceType) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"aws_source_type":    aws.StringValue(apiObject.AwsSourceType),
+			"custom_source_type": aws.StringValue(apiObject.CustomSourceType),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,206 @@
+package securitylake
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSubscriberNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSubscriberNotificationCreate,
+		ReadWithoutTimeout:   resourceSubscriberNotificationRead,
+		UpdateWithoutTimeout: resourceSubscriberNotificationUpdate,
+		DeleteWithoutTimeout: resourceSubscriberNotificationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_sqs": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"subscription_endpoint"},
+			},
+			"https_api_key_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"https_api_key_value": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"https_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(securitylake.HttpsMethod_Values(), false),
+			},
+			"queue_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"subscription_endpoint": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"create_sqs"},
+			},
+			"subscription_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSubscriberNotificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	subscriptionID := d.Get("subscription_id").(string)
+	input := &securitylake.CreateSubscriptionNotificationConfigurationInput{
+		SubscriptionId: aws.String(subscriptionID),
+	}
+
+	if v, ok := d.GetOk("create_sqs"); ok {
+		input.CreateSqs = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("https_api_key_name"); ok {
+		input.HttpsApiKeyName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("https_api_key_value"); ok {
+		input.HttpsApiKeyValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("https_method"); ok {
+		input.HttpsMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subscription_endpoint"); ok {
+		input.SubscriptionEndpoint = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSubscriptionNotificationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Security Lake Subscriber Notification (%s): %s", subscriptionID, err)
+	}
+
+	d.SetId(subscriptionID)
+	d.Set("queue_arn", output.QueueArn)
+
+	return resourceSubscriberNotificationRead(ctx, d, meta)
+}
+
+func resourceSubscriberNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	subscriber, err := FindSubscriberByID(ctx, conn, d.Id())
+
+	if err == nil && aws.StringValue(subscriber.SubscriptionEndpoint) == "" {
+		err = tfresource.NewEmptyResultError(d.Id())
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Subscriber Notification (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Lake Subscriber Notification (%s): %s", d.Id(), err)
+	}
+
+	// When create_sqs is set the service generates the endpoint, which is the
+	// queue ARN.
+	if aws.StringValue(subscriber.SubscriptionProtocol) == securitylake.SubscriptionProtocolTypeSqs {
+		d.Set("queue_arn", subscriber.SubscriptionEndpoint)
+	}
+	d.Set("subscription_endpoint", subscriber.SubscriptionEndpoint)
+	d.Set("subscription_id", subscriber.SubscriptionId)
+
+	return nil
+}
+
+func resourceSubscriberNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	input := &securitylake.UpdateSubscriptionNotificationConfigurationInput{
+		SubscriptionId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("create_sqs"); ok {
+		input.CreateSqs = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("https_api_key_name"); ok {
+		input.HttpsApiKeyName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("https_api_key_value"); ok {
+		input.HttpsApiKeyValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("https_method"); ok {
+		input.HttpsMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subscription_endpoint"); ok && !d.Get("create_sqs").(bool) {
+		input.SubscriptionEndpoint = aws.String(v.(string))
+	}
+
+	output, err := conn.UpdateSubscriptionNotificationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Security Lake Subscriber Notification (%s): %s", d.Id(), err)
+	}
+
+	d.Set("queue_arn", output.QueueArn)
+
+	return resourceSubscriberNotificationRead(ctx, d, meta)
+}
+
+func resourceSubscriberNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeConn
+
+	log.Printf("[INFO] Deleting Security Lake Subscriber Notification: %s", d.Id())
+	_, err := conn.DeleteSubscriptionNotificationConfigurationWithContext(ctx, &securitylake.DeleteSubscriptionNotificationConfigurationInput{
+		SubscriptionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securitylake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Lake Subscriber Notification (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
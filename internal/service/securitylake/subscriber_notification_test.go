@@ -0,0 +1,115 @@
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccSubscriberNotification_sqs(t *testing.T) {
+	resourceName := "aws_securitylake_subscriber_notification.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(securitylake.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securitylake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberNotificationConfig_sqs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberNotificationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "create_sqs", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "queue_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "subscription_endpoint"),
+					resource.TestCheckResourceAttrPair(resourceName, "subscription_id", "aws_securitylake_subscriber.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_sqs"},
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriberNotificationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securitylake_subscriber_notification" {
+			continue
+		}
+
+		output, err := tfsecuritylake.FindSubscriberByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.SubscriptionEndpoint) == "" {
+			continue
+		}
+
+		return fmt.Errorf("Security Lake Subscriber Notification %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSubscriberNotificationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Lake Subscriber Notification ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+		output, err := tfsecuritylake.FindSubscriberByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.SubscriptionEndpoint) == "" {
+			return fmt.Errorf("Security Lake Subscriber Notification %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSubscriberNotificationConfig_sqs(rName string) string {
+	return acctest.ConfigCompose(testAccSubscriberConfig_basic(rName, "test"), `
+resource "aws_securitylake_subscriber_notification" "test" {
+  subscription_id = aws_securitylake_subscriber.test.id
+  create_sqs      = true
+}
+`)
+}
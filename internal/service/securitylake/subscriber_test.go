@@ -0,0 +1,175 @@
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccSubscriber_basic(t *testing.T) {
+	resourceName := "aws_securitylake_subscriber.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(securitylake.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securitylake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "access_types.*", securitylake.AccessTypeS3),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "external_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "role_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_bucket_arn"),
+					resource.TestCheckResourceAttr(resourceName, "source_type.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "source_type.*", map[string]string{
+						"aws_source_type": securitylake.AwsLogSourceTypeRoute53,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSubscriber_disappears(t *testing.T) {
+	resourceName := "aws_securitylake_subscriber.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(securitylake.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securitylake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsecuritylake.ResourceSubscriber(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSubscriber_update(t *testing.T) {
+	resourceName := "aws_securitylake_subscriber.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(securitylake.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securitylake.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_description", "test"),
+				),
+			},
+			{
+				Config: testAccSubscriberConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriberDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securitylake_subscriber" {
+			continue
+		}
+
+		_, err := tfsecuritylake.FindSubscriberByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Security Lake Subscriber %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSubscriberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Lake Subscriber ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeConn
+
+		_, err := tfsecuritylake.FindSubscriberByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSubscriberConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccAWSLogSourceConfig_basic(), fmt.Sprintf(`
+resource "aws_securitylake_subscriber" "test" {
+  account_id             = data.aws_caller_identity.current.account_id
+  access_types           = ["S3"]
+  external_id            = %[1]q
+  subscriber_description = %[2]q
+  subscriber_name        = %[1]q
+
+  source_type {
+    aws_source_type = aws_securitylake_aws_log_source.test.source_type
+  }
+}
+`, rName, description))
+}
@@ -0,0 +1,45 @@
+package securitylake
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/securitylake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitDataLakeCompleted(ctx context.Context, conn *securitylake.SecurityLake, timeout time.Duration) (map[string]*securitylake.LakeConfigurationResponse, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securitylake.SettingsStatusInitialized, securitylake.SettingsStatusPending},
+		Target:  []string{securitylake.SettingsStatusCompleted},
+		Refresh: statusDataLake(ctx, conn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(map[string]*securitylake.LakeConfigurationResponse); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeDeleted(ctx context.Context, conn *securitylake.SecurityLake, timeout time.Duration) (map[string]*securitylake.LakeConfigurationResponse, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securitylake.SettingsStatusInitialized, securitylake.SettingsStatusPending, securitylake.SettingsStatusCompleted},
+		Target:  []string{},
+		Refresh: statusDataLake(ctx, conn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(map[string]*securitylake.LakeConfigurationResponse); ok {
+		return output, err
+	}
+
+	return nil, err
+}
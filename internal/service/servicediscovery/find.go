@@ -36,6 +36,32 @@ func FindInstanceByServiceIDAndInstanceID(ctx context.Context, conn *servicedisc
 	return output.Instance, nil
 }
 
+func FindInstanceHealthStatusByServiceIDAndInstanceID(ctx context.Context, conn *servicediscovery.ServiceDiscovery, serviceID, instanceID string) (string, error) {
+	input := &servicediscovery.GetInstancesHealthStatusInput{
+		Instances: aws.StringSlice([]string{instanceID}),
+		ServiceId: aws.String(serviceID),
+	}
+
+	output, err := conn.GetInstancesHealthStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicediscovery.ErrCodeInstanceNotFound, servicediscovery.ErrCodeServiceNotFound) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Status[instanceID] == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Status[instanceID]), nil
+}
+
 func findNamespaces(ctx context.Context, conn *servicediscovery.ServiceDiscovery, input *servicediscovery.ListNamespacesInput) ([]*servicediscovery.NamespaceSummary, error) {
 	var output []*servicediscovery.NamespaceSummary
 
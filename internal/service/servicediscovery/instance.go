@@ -31,25 +31,17 @@ func ResourceInstance() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"attributes": {
-				Type:     schema.TypeMap,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				ValidateDiagFunc: verify.ValidAllDiag(
-					validation.MapKeyLenBetween(1, 255),
-					validation.MapKeyMatch(regexp.MustCompile(`^[a-zA-Z0-9!-~]+$`), ""),
-					validation.MapValueLenBetween(0, 1024),
-					validation.MapValueMatch(regexp.MustCompile(`^([a-zA-Z0-9!-~][ \ta-zA-Z0-9!-~]*){0,1}[a-zA-Z0-9!-~]{0,1}$`), ""),
-				),
+			"attributes": instanceAttributesSchema(),
+			"custom_health_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(servicediscovery.CustomHealthStatus_Values(), false),
 			},
 			"instance_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.All(
-					validation.StringLenBetween(1, 64),
-					validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z_/:.@-]+$`), ""),
-				),
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validInstanceID,
 			},
 			"service_id": {
 				Type:         schema.TypeString,
@@ -61,29 +53,58 @@ func ResourceInstance() *schema.Resource {
 	}
 }
 
+func instanceAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Required: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		ValidateDiagFunc: verify.ValidAllDiag(
+			validation.MapKeyLenBetween(1, 255),
+			validation.MapKeyMatch(regexp.MustCompile(`^[a-zA-Z0-9!-~]+$`), ""),
+			validation.MapValueLenBetween(0, 1024),
+			validation.MapValueMatch(regexp.MustCompile(`^([a-zA-Z0-9!-~][ \ta-zA-Z0-9!-~]*){0,1}[a-zA-Z0-9!-~]{0,1}$`), ""),
+		),
+	}
+}
+
+var validInstanceID = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z_/:.@-]+$`), ""),
+)
+
 func resourceInstancePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn
 
 	instanceID := d.Get("instance_id").(string)
-	input := &servicediscovery.RegisterInstanceInput{
-		Attributes:       flex.ExpandStringMap(d.Get("attributes").(map[string]interface{})),
-		CreatorRequestId: aws.String(resource.UniqueId()),
-		InstanceId:       aws.String(instanceID),
-		ServiceId:        aws.String(d.Get("service_id").(string)),
-	}
+	serviceID := d.Get("service_id").(string)
+	isNew := d.Id() == ""
 
-	log.Printf("[DEBUG] Registering Service Discovery Instance: %s", input)
-	output, err := conn.RegisterInstanceWithContext(ctx, input)
+	if isNew || d.HasChange("attributes") {
+		if err := registerInstance(ctx, conn, serviceID, instanceID, d.Get("attributes").(map[string]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	if err != nil {
-		return diag.Errorf("registering Service Discovery Instance (%s): %s", instanceID, err)
+	if isNew {
+		d.SetId(instanceID)
 	}
 
-	d.SetId(instanceID)
+	// Re-registering an instance resets its custom health status.
+	if v, ok := d.GetOk("custom_health_status"); ok && (isNew || d.HasChanges("attributes", "custom_health_status")) {
+		status := v.(string)
+		input := &servicediscovery.UpdateInstanceCustomHealthStatusInput{
+			InstanceId: aws.String(instanceID),
+			ServiceId:  aws.String(serviceID),
+			Status:     aws.String(status),
+		}
 
-	if output != nil && output.OperationId != nil {
-		if _, err := WaitOperationSuccess(ctx, conn, aws.StringValue(output.OperationId)); err != nil {
-			return diag.Errorf("waiting for Service Discovery Instance (%s) create: %s", d.Id(), err)
+		log.Printf("[DEBUG] Updating Service Discovery Instance custom health status: %s", input)
+		if _, err := conn.UpdateInstanceCustomHealthStatusWithContext(ctx, input); err != nil {
+			return diag.Errorf("updating Service Discovery Instance (%s) custom health status: %s", d.Id(), err)
+		}
+
+		if _, err := waitInstanceHealthStatusUpdated(ctx, conn, serviceID, instanceID, status); err != nil {
+			return diag.Errorf("waiting for Service Discovery Instance (%s) custom health status update: %s", d.Id(), err)
 		}
 	}
 
@@ -115,6 +136,20 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("attributes", aws.StringValueMap(attributes))
 	d.Set("instance_id", instance.Id)
 
+	// Custom health status is only managed when configured.
+	// Newly registered instances report UNKNOWN until the status has propagated.
+	if _, ok := d.GetOk("custom_health_status"); ok {
+		status, err := FindInstanceHealthStatusByServiceIDAndInstanceID(ctx, conn, d.Get("service_id").(string), d.Id())
+
+		if err != nil && !tfresource.NotFound(err) {
+			return diag.Errorf("reading Service Discovery Instance (%s) health status: %s", d.Id(), err)
+		}
+
+		if status != "" && status != servicediscovery.HealthStatusUnknown {
+			d.Set("custom_health_status", status)
+		}
+	}
+
 	return nil
 }
 
@@ -144,3 +179,27 @@ func resourceInstanceImport(ctx context.Context, d *schema.ResourceData, meta in
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func registerInstance(ctx context.Context, conn *servicediscovery.ServiceDiscovery, serviceID, instanceID string, attributes map[string]interface{}) error {
+	input := &servicediscovery.RegisterInstanceInput{
+		Attributes:       flex.ExpandStringMap(attributes),
+		CreatorRequestId: aws.String(resource.UniqueId()),
+		InstanceId:       aws.String(instanceID),
+		ServiceId:        aws.String(serviceID),
+	}
+
+	log.Printf("[DEBUG] Registering Service Discovery Instance: %s", input)
+	output, err := conn.RegisterInstanceWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("registering Service Discovery Service (%s) Instance (%s): %w", serviceID, instanceID, err)
+	}
+
+	if output != nil && output.OperationId != nil {
+		if _, err := WaitOperationSuccess(ctx, conn, aws.StringValue(output.OperationId)); err != nil {
+			return fmt.Errorf("waiting for Service Discovery Service (%s) Instance (%s) register: %w", serviceID, instanceID, err)
+		}
+	}
+
+	return nil
+}
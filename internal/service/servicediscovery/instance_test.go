@@ -148,6 +148,46 @@ func TestAccServiceDiscoveryInstance_http(t *testing.T) {
 	})
 }
 
+func TestAccServiceDiscoveryInstance_customHealthStatus(t *testing.T) {
+	resourceName := "aws_service_discovery_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicediscovery.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicediscovery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_customHealthStatus(rName, domainName, "UNHEALTHY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_health_status", "UNHEALTHY"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccInstanceImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"custom_health_status"},
+			},
+			{
+				Config: testAccInstanceConfig_customHealthStatus(rName, domainName, "HEALTHY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_health_status", "HEALTHY"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -325,3 +365,19 @@ resource "aws_service_discovery_instance" "test" {
   }
 }`, instanceID, attributes)
 }
+
+func testAccInstanceConfig_customHealthStatus(rName, domainName, status string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_base(rName),
+		testAccInstanceConfig_privateNamespace(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_service_discovery_instance" "test" {
+  service_id           = aws_service_discovery_service.test.id
+  instance_id          = %[1]q
+  custom_health_status = %[2]q
+
+  attributes = {
+    AWS_INSTANCE_IPV4 = "10.0.0.1"
+  }
+}`, rName, status))
+}
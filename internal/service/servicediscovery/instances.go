@@ -0,0 +1,251 @@
+package servicediscovery
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInstances() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstancesCreate,
+		ReadWithoutTimeout:   resourceInstancesRead,
+		UpdateWithoutTimeout: resourceInstancesUpdate,
+		DeleteWithoutTimeout: resourceInstancesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInstancesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": instanceAttributesSchema(),
+						"instance_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validInstanceID,
+						},
+					},
+				},
+			},
+			"service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceInstancesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn
+
+	serviceID := d.Get("service_id").(string)
+	instances, err := expandInstances(d.Get("instance").(*schema.Set).List())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Several resources can manage disjoint sets of instances registered with the same service.
+	d.SetId(instancesCreateResourceID(serviceID))
+
+	for instanceID, attributes := range instances {
+		if err := registerInstance(ctx, conn, serviceID, instanceID, attributes); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceInstancesRead(ctx, d, meta)
+}
+
+func resourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn
+
+	serviceID := d.Get("service_id").(string)
+	var tfList []interface{}
+
+	// Only the instances registered by this resource are read, instances registered
+	// by other means (e.g. ECS service registries) are left alone.
+	for _, tfMapRaw := range d.Get("instance").(*schema.Set).List() {
+		instanceID := tfMapRaw.(map[string]interface{})["instance_id"].(string)
+
+		instance, err := FindInstanceByServiceIDAndInstanceID(ctx, conn, serviceID, instanceID)
+
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] Service Discovery Service (%s) Instance (%s) not found, removing from state", serviceID, instanceID)
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("reading Service Discovery Service (%s) Instance (%s): %s", serviceID, instanceID, err)
+		}
+
+		attributes := instance.Attributes
+		if _, ok := attributes["AWS_EC2_INSTANCE_ID"]; ok {
+			delete(attributes, "AWS_INSTANCE_IPV4")
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attributes":  aws.StringValueMap(attributes),
+			"instance_id": aws.StringValue(instance.Id),
+		})
+	}
+
+	if !d.IsNewResource() && len(tfList) == 0 {
+		log.Printf("[WARN] Service Discovery Instances (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("instance", tfList); err != nil {
+		return diag.Errorf("setting instance: %s", err)
+	}
+
+	return nil
+}
+
+func resourceInstancesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn
+
+	serviceID := d.Get("service_id").(string)
+
+	if d.HasChange("instance") {
+		o, n := d.GetChange("instance")
+
+		oInstances, err := expandInstances(o.(*schema.Set).List())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		nInstances, err := expandInstances(n.(*schema.Set).List())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for instanceID := range oInstances {
+			if _, ok := nInstances[instanceID]; ok {
+				continue
+			}
+
+			if err := deregisterInstanceIfExists(ctx, conn, serviceID, instanceID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		// Registering an existing instance updates its attributes.
+		for instanceID, attributes := range nInstances {
+			if old, ok := oInstances[instanceID]; ok && fmt.Sprint(old) == fmt.Sprint(attributes) {
+				continue
+			}
+
+			if err := registerInstance(ctx, conn, serviceID, instanceID, attributes); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceInstancesRead(ctx, d, meta)
+}
+
+func resourceInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn
+
+	serviceID := d.Get("service_id").(string)
+
+	for _, tfMapRaw := range d.Get("instance").(*schema.Set).List() {
+		instanceID := tfMapRaw.(map[string]interface{})["instance_id"].(string)
+
+		if err := deregisterInstanceIfExists(ctx, conn, serviceID, instanceID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// resourceInstancesImport imports the instances with the specified IDs registered with a service.
+func resourceInstancesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format (%q), expected <service-id>/<instance-id>[,<instance-id>...]", d.Id())
+	}
+
+	serviceID := parts[0]
+	var tfList []interface{}
+
+	for _, instanceID := range strings.Split(parts[1], ",") {
+		if instanceID == "" {
+			return nil, fmt.Errorf("unexpected format (%q), expected <service-id>/<instance-id>[,<instance-id>...]", d.Id())
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"instance_id": instanceID,
+		})
+	}
+
+	d.Set("service_id", serviceID)
+	if err := d.Set("instance", tfList); err != nil {
+		return nil, fmt.Errorf("setting instance: %w", err)
+	}
+	d.SetId(instancesCreateResourceID(serviceID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func deregisterInstanceIfExists(ctx context.Context, conn *servicediscovery.ServiceDiscovery, serviceID, instanceID string) error {
+	err := deregisterInstance(ctx, conn, serviceID, instanceID)
+
+	if tfawserr.ErrCodeEquals(err, servicediscovery.ErrCodeInstanceNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+// expandInstances returns the configured instances' attributes keyed by instance ID.
+func expandInstances(tfList []interface{}) (map[string]map[string]interface{}, error) {
+	instances := make(map[string]map[string]interface{}, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		instanceID := tfMap["instance_id"].(string)
+
+		if _, ok := instances[instanceID]; ok {
+			return nil, fmt.Errorf("duplicate Service Discovery Instance ID (%s)", instanceID)
+		}
+
+		instances[instanceID] = tfMap["attributes"].(map[string]interface{})
+	}
+
+	return instances, nil
+}
+
+// instancesCreateResourceID returns a unique resource ID for a set of instances registered with the specified service.
+func instancesCreateResourceID(serviceID string) string {
+	return serviceID + "/" + resource.UniqueId()
+}
@@ -0,0 +1,325 @@
+package servicediscovery_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicediscovery "github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccServiceDiscoveryInstances_basic(t *testing.T) {
+	resourceName := "aws_service_discovery_instances.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicediscovery.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicediscovery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstancesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesConfig_basic(rName, domainName, "10.0.0.1", "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExist(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", "aws_service_discovery_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "instance.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance.*", map[string]string{
+						"instance_id":                  fmt.Sprintf("%s-1", rName),
+						"attributes.AWS_INSTANCE_IPV4": "10.0.0.1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance.*", map[string]string{
+						"instance_id":                  fmt.Sprintf("%s-2", rName),
+						"attributes.AWS_INSTANCE_IPV4": "10.0.0.2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccInstancesImportStateIdFunc(resourceName),
+				// The resource ID is unique to each resource instance, so the imported state can't be matched by ID.
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+
+					rs := s[0]
+
+					if got, expected := rs.Attributes["instance.#"], "2"; got != expected {
+						return fmt.Errorf("got %s instances, expected %s", got, expected)
+					}
+
+					if rs.Attributes["service_id"] == "" {
+						return fmt.Errorf("expected service_id attribute to be set")
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: testAccInstancesConfig_basic(rName, domainName, "10.0.0.1", "10.0.0.3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExist(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance.*", map[string]string{
+						"instance_id":                  fmt.Sprintf("%s-2", rName),
+						"attributes.AWS_INSTANCE_IPV4": "10.0.0.3",
+					}),
+				),
+			},
+			{
+				Config: testAccInstancesConfig_single(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExist(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceDiscoveryInstances_disappears(t *testing.T) {
+	resourceName := "aws_service_discovery_instances.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicediscovery.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicediscovery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstancesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesConfig_basic(rName, domainName, "10.0.0.1", "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExist(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfservicediscovery.ResourceInstances(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccServiceDiscoveryInstances_multiple(t *testing.T) {
+	resource1Name := "aws_service_discovery_instances.test1"
+	resource2Name := "aws_service_discovery_instances.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicediscovery.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicediscovery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstancesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesConfig_multiple(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExist(resource1Name),
+					testAccCheckInstancesExist(resource2Name),
+					testAccCheckInstancesIDsDiffer(resource1Name, resource2Name),
+					resource.TestCheckResourceAttr(resource1Name, "instance.#", "1"),
+					resource.TestCheckResourceAttr(resource2Name, "instance.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstancesExist(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Discovery Instances ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceDiscoveryConn
+
+		for k, v := range rs.Primary.Attributes {
+			if !instanceIDAttributeKey(k) {
+				continue
+			}
+
+			if _, err := tfservicediscovery.FindInstanceByServiceIDAndInstanceID(context.Background(), conn, rs.Primary.Attributes["service_id"], v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInstancesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceDiscoveryConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_service_discovery_instances" {
+			continue
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !instanceIDAttributeKey(k) {
+				continue
+			}
+
+			_, err := tfservicediscovery.FindInstanceByServiceIDAndInstanceID(context.Background(), conn, rs.Primary.Attributes["service_id"], v)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Service Discovery Service (%s) Instance %s still exists", rs.Primary.Attributes["service_id"], v)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckInstancesIDsDiffer(n1, n2 string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs1, ok := s.RootModule().Resources[n1]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n1)
+		}
+
+		rs2, ok := s.RootModule().Resources[n2]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n2)
+		}
+
+		if rs1.Primary.ID == rs2.Primary.ID {
+			return fmt.Errorf("Service Discovery Instances IDs are the same: %s", rs1.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccInstancesImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		var instanceIDs []string
+
+		for k, v := range rs.Primary.Attributes {
+			if instanceIDAttributeKey(k) {
+				instanceIDs = append(instanceIDs, v)
+			}
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["service_id"], strings.Join(instanceIDs, ",")), nil
+	}
+}
+
+// instanceIDAttributeKey returns whether a flatmap state key is an instance set element's instance_id.
+func instanceIDAttributeKey(k string) bool {
+	return strings.HasPrefix(k, "instance.") && strings.HasSuffix(k, ".instance_id")
+}
+
+func testAccInstancesConfig_basic(rName, domainName, ip1, ip2 string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_base(rName),
+		testAccInstanceConfig_privateNamespace(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_service_discovery_instances" "test" {
+  service_id = aws_service_discovery_service.test.id
+
+  instance {
+    instance_id = "%[1]s-1"
+
+    attributes = {
+      AWS_INSTANCE_IPV4 = %[2]q
+    }
+  }
+
+  instance {
+    instance_id = "%[1]s-2"
+
+    attributes = {
+      AWS_INSTANCE_IPV4 = %[3]q
+    }
+  }
+}`, rName, ip1, ip2))
+}
+
+func testAccInstancesConfig_single(rName, domainName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_base(rName),
+		testAccInstanceConfig_privateNamespace(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_service_discovery_instances" "test" {
+  service_id = aws_service_discovery_service.test.id
+
+  instance {
+    instance_id = "%[1]s-1"
+
+    attributes = {
+      AWS_INSTANCE_IPV4 = "10.0.0.1"
+    }
+  }
+}`, rName))
+}
+
+func testAccInstancesConfig_multiple(rName, domainName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_base(rName),
+		testAccInstanceConfig_privateNamespace(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_service_discovery_instances" "test1" {
+  service_id = aws_service_discovery_service.test.id
+
+  instance {
+    instance_id = "%[1]s-1"
+
+    attributes = {
+      AWS_INSTANCE_IPV4 = "10.0.0.1"
+    }
+  }
+}
+
+resource "aws_service_discovery_instances" "test2" {
+  service_id = aws_service_discovery_service.test.id
+
+  instance {
+    instance_id = "%[1]s-2"
+
+    attributes = {
+      AWS_INSTANCE_IPV4 = "10.0.0.2"
+    }
+  }
+}`, rName))
+}
@@ -25,3 +25,19 @@ func StatusOperation(ctx context.Context, conn *servicediscovery.ServiceDiscover
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusInstanceHealth(ctx context.Context, conn *servicediscovery.ServiceDiscovery, serviceID, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInstanceHealthStatusByServiceIDAndInstanceID(ctx, conn, serviceID, instanceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, output, nil
+	}
+}
@@ -14,6 +14,8 @@ import (
 const (
 	// Maximum amount of time to wait for an Operation to return Success
 	OperationSuccessTimeout = 5 * time.Minute
+
+	instanceHealthStatusUpdatedTimeout = 2 * time.Minute
 )
 
 // WaitOperationSuccess waits for an Operation to return Success
@@ -41,3 +43,29 @@ func WaitOperationSuccess(ctx context.Context, conn *servicediscovery.ServiceDis
 
 	return nil, err
 }
+
+// waitInstanceHealthStatusUpdated waits for an instance's health status to consistently report the expected value.
+func waitInstanceHealthStatusUpdated(ctx context.Context, conn *servicediscovery.ServiceDiscovery, serviceID, instanceID, status string) (string, error) {
+	var pending []string
+	for _, v := range servicediscovery.HealthStatus_Values() {
+		if v != status {
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:                   pending,
+		Target:                    []string{status},
+		Refresh:                   statusInstanceHealth(ctx, conn, serviceID, instanceID),
+		Timeout:                   instanceHealthStatusUpdatedTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(string); ok {
+		return output, err
+	}
+
+	return "", err
+}
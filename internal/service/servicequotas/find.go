@@ -96,3 +96,82 @@ func findServiceQuotaByID(conn *servicequotas.ServiceQuotas, serviceCode, quotaC
 
 	return output.Quota, nil
 }
+
+func findRequestedServiceQuotaChangeByID(conn *servicequotas.ServiceQuotas, requestID string) (*servicequotas.RequestedServiceQuotaChange, error) {
+	input := &servicequotas.GetRequestedServiceQuotaChangeInput{
+		RequestId: aws.String(requestID),
+	}
+
+	output, err := conn.GetRequestedServiceQuotaChange(input)
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RequestedQuota == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RequestedQuota, nil
+}
+
+func FindTemplateByID(conn *servicequotas.ServiceQuotas, region, quotaCode, serviceCode string) (*servicequotas.ServiceQuotaIncreaseRequestInTemplate, error) {
+	input := &servicequotas.GetServiceQuotaIncreaseRequestFromTemplateInput{
+		AwsRegion:   aws.String(region),
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	}
+
+	output, err := conn.GetServiceQuotaIncreaseRequestFromTemplate(input)
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServiceQuotaIncreaseRequestInTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServiceQuotaIncreaseRequestInTemplate, nil
+}
+
+func FindTemplateAssociation(conn *servicequotas.ServiceQuotas) (string, error) {
+	input := &servicequotas.GetAssociationForServiceQuotaTemplateInput{}
+
+	output, err := conn.GetAssociationForServiceQuotaTemplate(input)
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.ServiceQuotaTemplateAssociationStatus); status == servicequotas.ServiceQuotaTemplateAssociationStatusDisassociated {
+		return "", &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return aws.StringValue(output.ServiceQuotaTemplateAssociationStatus), nil
+}
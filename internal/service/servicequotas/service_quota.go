@@ -4,10 +4,10 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicequotas"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -21,7 +21,16 @@ func ResourceServiceQuota() *schema.Resource {
 		Update: resourceServiceQuotaUpdate,
 		Delete: schema.Noop,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_request_completion", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -77,6 +86,11 @@ func ResourceServiceQuota() *schema.Resource {
 				Type:     schema.TypeFloat,
 				Required: true,
 			},
+			"wait_for_request_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -123,7 +137,14 @@ func resourceServiceQuotaCreate(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("error requesting Service Quota (%s) increase: empty result", d.Id())
 		}
 
-		d.Set("request_id", output.RequestedQuota.Id)
+		requestID := aws.StringValue(output.RequestedQuota.Id)
+		d.Set("request_id", requestID)
+
+		if d.Get("wait_for_request_completion").(bool) {
+			if _, err := waitRequestedServiceQuotaChangeApproved(conn, requestID, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return fmt.Errorf("error waiting for Service Quota (%s) increase request (%s) approval: %w", d.Id(), requestID, err)
+			}
+		}
 	}
 
 	return resourceServiceQuotaRead(d, meta)
@@ -154,6 +175,11 @@ func resourceServiceQuotaRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("service_name", defaultQuota.ServiceName)
 	d.Set("value", defaultQuota.Value)
 
+	// Resources created before wait_for_request_completion existed have no value in state.
+	if _, ok := d.GetOkExists("wait_for_request_completion"); !ok {
+		d.Set("wait_for_request_completion", false)
+	}
+
 	serviceQuota, err := findServiceQuotaByID(conn, serviceCode, quotaCode)
 	if err != nil && !tfresource.NotFound(err) {
 		return fmt.Errorf("error getting Service Quota for (%s/%s): %w", serviceCode, quotaCode, err)
@@ -167,13 +193,9 @@ func resourceServiceQuotaRead(d *schema.ResourceData, meta interface{}) error {
 	requestID := d.Get("request_id").(string)
 
 	if requestID != "" {
-		input := &servicequotas.GetRequestedServiceQuotaChangeInput{
-			RequestId: aws.String(requestID),
-		}
+		requestedQuota, err := findRequestedServiceQuotaChangeByID(conn, requestID)
 
-		output, err := conn.GetRequestedServiceQuotaChange(input)
-
-		if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		if tfresource.NotFound(err) {
 			d.Set("request_id", "")
 			d.Set("request_status", "")
 			return nil
@@ -183,18 +205,14 @@ func resourceServiceQuotaRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error getting Service Quotas Requested Service Quota Change (%s): %w", requestID, err)
 		}
 
-		if output == nil || output.RequestedQuota == nil {
-			return fmt.Errorf("error getting Service Quotas Requested Service Quota Change (%s): empty result", requestID)
-		}
-
-		requestStatus := aws.StringValue(output.RequestedQuota.Status)
+		requestStatus := aws.StringValue(requestedQuota.Status)
 		d.Set("request_status", requestStatus)
 
 		switch requestStatus {
 		case servicequotas.RequestStatusApproved, servicequotas.RequestStatusCaseClosed, servicequotas.RequestStatusDenied:
 			d.Set("request_id", "")
 		case servicequotas.RequestStatusCaseOpened, servicequotas.RequestStatusPending:
-			d.Set("value", output.RequestedQuota.DesiredValue)
+			d.Set("value", requestedQuota.DesiredValue)
 		}
 	}
 
@@ -204,30 +222,40 @@ func resourceServiceQuotaRead(d *schema.ResourceData, meta interface{}) error {
 func resourceServiceQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ServiceQuotasConn
 
-	value := d.Get("value").(float64)
-	serviceCode, quotaCode, err := resourceServiceQuotaParseID(d.Id())
+	// wait_for_request_completion only affects client-side behaviour.
+	if d.HasChange("value") {
+		value := d.Get("value").(float64)
+		serviceCode, quotaCode, err := resourceServiceQuotaParseID(d.Id())
 
-	if err != nil {
-		return err
-	}
+		if err != nil {
+			return err
+		}
 
-	input := &servicequotas.RequestServiceQuotaIncreaseInput{
-		DesiredValue: aws.Float64(value),
-		QuotaCode:    aws.String(quotaCode),
-		ServiceCode:  aws.String(serviceCode),
-	}
+		input := &servicequotas.RequestServiceQuotaIncreaseInput{
+			DesiredValue: aws.Float64(value),
+			QuotaCode:    aws.String(quotaCode),
+			ServiceCode:  aws.String(serviceCode),
+		}
 
-	output, err := conn.RequestServiceQuotaIncrease(input)
+		output, err := conn.RequestServiceQuotaIncrease(input)
 
-	if err != nil {
-		return fmt.Errorf("error requesting Service Quota (%s) increase: %w", d.Id(), err)
-	}
+		if err != nil {
+			return fmt.Errorf("error requesting Service Quota (%s) increase: %w", d.Id(), err)
+		}
 
-	if output == nil || output.RequestedQuota == nil {
-		return fmt.Errorf("error requesting Service Quota (%s) increase: empty result", d.Id())
-	}
+		if output == nil || output.RequestedQuota == nil {
+			return fmt.Errorf("error requesting Service Quota (%s) increase: empty result", d.Id())
+		}
+
+		requestID := aws.StringValue(output.RequestedQuota.Id)
+		d.Set("request_id", requestID)
 
-	d.Set("request_id", output.RequestedQuota.Id)
+		if d.Get("wait_for_request_completion").(bool) {
+			if _, err := waitRequestedServiceQuotaChangeApproved(conn, requestID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Service Quota (%s) increase request (%s) approval: %w", d.Id(), requestID, err)
+			}
+		}
+	}
 
 	return resourceServiceQuotaRead(d, meta)
 }
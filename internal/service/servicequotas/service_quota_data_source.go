@@ -2,8 +2,11 @@ package servicequotas
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/servicequotas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -51,6 +54,35 @@ func DataSourceServiceQuota() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"usage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"usage_metric": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_dimensions": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_statistic_recommendation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"value": {
 				Type:     schema.TypeFloat,
 				Computed: true,
@@ -96,6 +128,24 @@ func dataSourceServiceQuotaRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("service_name", defaultQuota.ServiceName)
 	d.Set("value", defaultQuota.Value)
 
+	if v := defaultQuota.UsageMetric; v != nil && v.MetricName != nil {
+		if err := d.Set("usage_metric", []interface{}{flattenMetricInfo(v)}); err != nil {
+			return fmt.Errorf("error setting usage_metric: %w", err)
+		}
+
+		usage, err := findLatestUsage(meta.(*conns.AWSClient).CloudWatchConn, v)
+
+		if err != nil && !tfresource.NotFound(err) {
+			return fmt.Errorf("error getting Service Quota usage for (%s/%s): %w", serviceCode, quotaCode, err)
+		}
+
+		if err == nil {
+			d.Set("usage", usage)
+		}
+	} else {
+		d.Set("usage_metric", nil)
+	}
+
 	serviceQuota, err := findServiceQuotaByID(conn, serviceCode, quotaCode)
 	if tfresource.NotFound(err) {
 		return nil
@@ -109,3 +159,88 @@ func dataSourceServiceQuotaRead(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+// findLatestUsage returns the most recent datapoint from the last hour of the quota's usage metric,
+// aggregated using the recommended statistic.
+func findLatestUsage(conn *cloudwatch.CloudWatch, metric *servicequotas.MetricInfo) (float64, error) {
+	statistic := aws.StringValue(metric.MetricStatisticRecommendation)
+	if statistic == "" {
+		statistic = cloudwatch.StatisticMaximum
+	}
+
+	var dimensions []*cloudwatch.Dimension
+	for k, v := range metric.MetricDimensions {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(k),
+			Value: v,
+		})
+	}
+	sort.Slice(dimensions, func(i, j int) bool {
+		return aws.StringValue(dimensions[i].Name) < aws.StringValue(dimensions[j].Name)
+	})
+
+	endTime := time.Now()
+	input := &cloudwatch.GetMetricStatisticsInput{
+		Dimensions: dimensions,
+		EndTime:    aws.Time(endTime),
+		MetricName: metric.MetricName,
+		Namespace:  metric.MetricNamespace,
+		Period:     aws.Int64(300),
+		StartTime:  aws.Time(endTime.Add(-1 * time.Hour)),
+		Statistics: aws.StringSlice([]string{statistic}),
+	}
+
+	output, err := conn.GetMetricStatistics(input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if output == nil || len(output.Datapoints) == 0 {
+		return 0, tfresource.NewEmptyResultError(input)
+	}
+
+	var latest *cloudwatch.Datapoint
+	for _, v := range output.Datapoints {
+		if latest == nil || aws.TimeValue(v.Timestamp).After(aws.TimeValue(latest.Timestamp)) {
+			latest = v
+		}
+	}
+
+	switch statistic {
+	case cloudwatch.StatisticAverage:
+		return aws.Float64Value(latest.Average), nil
+	case cloudwatch.StatisticMinimum:
+		return aws.Float64Value(latest.Minimum), nil
+	case cloudwatch.StatisticSampleCount:
+		return aws.Float64Value(latest.SampleCount), nil
+	case cloudwatch.StatisticSum:
+		return aws.Float64Value(latest.Sum), nil
+	default:
+		return aws.Float64Value(latest.Maximum), nil
+	}
+}
+
+func flattenMetricInfo(apiObject *servicequotas.MetricInfo) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"metric_dimensions": aws.StringValueMap(apiObject.MetricDimensions),
+	}
+
+	if v := apiObject.MetricName; v != nil {
+		tfMap["metric_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MetricNamespace; v != nil {
+		tfMap["metric_namespace"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MetricStatisticRecommendation; v != nil {
+		tfMap["metric_statistic_recommendation"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -33,6 +33,8 @@ func TestAccServiceQuotasServiceQuotaDataSource_quotaCode(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "quota_name", "VPCs per Region"),
 					resource.TestCheckResourceAttr(dataSourceName, "service_code", setQuotaServiceCode),
 					resource.TestCheckResourceAttr(dataSourceName, "service_name", "Amazon Virtual Private Cloud (Amazon VPC)"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_namespace", "AWS/Usage"),
 					resource.TestMatchResourceAttr(dataSourceName, "value", regexp.MustCompile(`^\d+$`)),
 				),
 			},
@@ -52,6 +52,38 @@ func TestAccServiceQuotasServiceQuota_basic(t *testing.T) {
 	})
 }
 
+func TestAccServiceQuotasServiceQuota_waitForRequestCompletionOnly(t *testing.T) {
+	const resourceName = "aws_servicequotas_service_quota.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			preCheckServiceQuotaSet(setQuotaServiceCode, setQuotaQuotaCode, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceQuotaConfig_sameValue(setQuotaServiceCode, setQuotaQuotaCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_request_completion", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "request_id"),
+				),
+			},
+			{
+				// Changing only the flag must not request a quota increase.
+				Config: testAccServiceQuotaConfig_sameValueWaitForRequestCompletion(setQuotaServiceCode, setQuotaQuotaCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_request_completion", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "request_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceQuotasServiceQuota_basic_Unset(t *testing.T) {
 	const dataSourceName = "data.aws_servicequotas_service_quota.test"
 	const resourceName = "aws_servicequotas_service_quota.test"
@@ -216,6 +248,24 @@ resource "aws_servicequotas_service_quota" "test" {
 `, quotaCode, serviceCode)
 }
 
+// nosemgrep:ci.servicequotas-in-func-name
+func testAccServiceQuotaConfig_sameValueWaitForRequestCompletion(serviceCode, quotaCode string) string {
+	return fmt.Sprintf(`
+data "aws_servicequotas_service_quota" "test" {
+  quota_code   = %[1]q
+  service_code = %[2]q
+}
+
+resource "aws_servicequotas_service_quota" "test" {
+  quota_code   = data.aws_servicequotas_service_quota.test.quota_code
+  service_code = data.aws_servicequotas_service_quota.test.service_code
+  value        = data.aws_servicequotas_service_quota.test.value
+
+  wait_for_request_completion = true
+}
+`, quotaCode, serviceCode)
+}
+
 func testAccServiceQuotaConfig_value(serviceCode, quotaCode, value string) string {
 	return fmt.Sprintf(`
 resource "aws_servicequotas_service_quota" "test" {
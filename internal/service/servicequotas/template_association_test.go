@@ -0,0 +1,93 @@
+package servicequotas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccServiceQuotasTemplateAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicequotas_template_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateAssociationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateAssociationExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicequotas.ServiceQuotaTemplateAssociationStatusAssociated),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicequotas_template_association" {
+			continue
+		}
+
+		_, err := tfservicequotas.FindTemplateAssociation(conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Quotas Template Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTemplateAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Quotas Template Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+
+		_, err := tfservicequotas.FindTemplateAssociation(conn)
+
+		return err
+	}
+}
+
+func testAccTemplateAssociationConfig_basic() string {
+	return `
+resource "aws_servicequotas_template_association" "test" {}
+`
+}
@@ -0,0 +1,130 @@
+package servicequotas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	templateServiceCode = "lambda"
+	templateQuotaCode   = "L-B99A9384" // Concurrent executions
+)
+
+// Service Quotas templates are organization-wide, so these tests are serialized.
+func TestAccServiceQuotasTemplate_basic(t *testing.T) {
+	resourceName := "aws_servicequotas_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic("1100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "global_quota", "false"),
+					resource.TestCheckResourceAttr(resourceName, "quota_code", templateQuotaCode),
+					resource.TestCheckResourceAttr(resourceName, "quota_name", "Concurrent executions"),
+					resource.TestCheckResourceAttr(resourceName, "region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "service_code", templateServiceCode),
+					resource.TestCheckResourceAttr(resourceName, "service_name", "AWS Lambda"),
+					resource.TestCheckResourceAttr(resourceName, "value", "1100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateConfig_basic("1200"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "1200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicequotas_template" {
+			continue
+		}
+
+		region, quotaCode, serviceCode, err := tfservicequotas.TemplateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfservicequotas.FindTemplateByID(conn, region, quotaCode, serviceCode)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Quotas Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Quotas Template ID is set")
+		}
+
+		region, quotaCode, serviceCode, err := tfservicequotas.TemplateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+
+		_, err = tfservicequotas.FindTemplateByID(conn, region, quotaCode, serviceCode)
+
+		return err
+	}
+}
+
+func testAccTemplateConfig_basic(value string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_servicequotas_template" "test" {
+  region       = data.aws_region.current.name
+  quota_code   = %[1]q
+  service_code = %[2]q
+  value        = %[3]s
+}
+`, templateQuotaCode, templateServiceCode, value)
+}
@@ -0,0 +1,26 @@
+package servicequotas
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitRequestedServiceQuotaChangeApproved(conn *servicequotas.ServiceQuotas, requestID string, timeout time.Duration) (*servicequotas.RequestedServiceQuotaChange, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{servicequotas.RequestStatusPending, servicequotas.RequestStatusCaseOpened},
+		Target:     []string{servicequotas.RequestStatusApproved},
+		Refresh:    statusRequestedServiceQuotaChange(conn, requestID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*servicequotas.RequestedServiceQuotaChange); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,6 @@
+package shield
+
+// Exports for use in tests only.
+var (
+	FindProtectionByID = findProtectionByID
+)
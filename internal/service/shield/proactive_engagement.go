@@ -0,0 +1,293 @@
+package shield
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceProactiveEngagement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProactiveEngagementCreate,
+		ReadWithoutTimeout:   resourceProactiveEngagementRead,
+		UpdateWithoutTimeout: resourceProactiveEngagementUpdate,
+		DeleteWithoutTimeout: resourceProactiveEngagementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emergency_contact": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 150),
+						},
+						"phone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 16),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceProactiveEngagementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	status, err := findProactiveEngagementStatus(ctx, conn)
+
+	switch {
+	case tfresource.NotFound(err):
+		// Proactive engagement has never been configured for this account.
+		// Associating the details also enables proactive engagement.
+		input := &shield.AssociateProactiveEngagementDetailsInput{
+			EmergencyContactList: expandEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+		}
+
+		_, err = conn.AssociateProactiveEngagementDetailsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("creating Shield Proactive Engagement: %s", err)
+		}
+
+		status = shield.ProactiveEngagementStatusEnabled
+	case err != nil:
+		return diag.Errorf("reading Shield Proactive Engagement: %s", err)
+	default:
+		input := &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: expandEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+		}
+
+		_, err = conn.UpdateEmergencyContactSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("creating Shield Proactive Engagement: updating emergency contacts: %s", err)
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	if err := updateProactiveEngagementStatus(ctx, conn, status, d.Get("enabled").(bool)); err != nil {
+		return diag.Errorf("creating Shield Proactive Engagement (%s): %s", d.Id(), err)
+	}
+
+	return resourceProactiveEngagementRead(ctx, d, meta)
+}
+
+func resourceProactiveEngagementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	status, err := findProactiveEngagementStatus(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Proactive Engagement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Shield Proactive Engagement (%s): %s", d.Id(), err)
+	}
+
+	output, err := conn.DescribeEmergencyContactSettingsWithContext(ctx, &shield.DescribeEmergencyContactSettingsInput{})
+
+	if err != nil {
+		return diag.Errorf("reading Shield Proactive Engagement (%s) emergency contacts: %s", d.Id(), err)
+	}
+
+	if err := d.Set("emergency_contact", flattenEmergencyContacts(output.EmergencyContactList)); err != nil {
+		return diag.Errorf("setting emergency_contact: %s", err)
+	}
+	d.Set("enabled", status == shield.ProactiveEngagementStatusEnabled)
+
+	return nil
+}
+
+func resourceProactiveEngagementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	if d.HasChange("emergency_contact") {
+		input := &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: expandEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+		}
+
+		_, err := conn.UpdateEmergencyContactSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Shield Proactive Engagement (%s) emergency contacts: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		status, err := findProactiveEngagementStatus(ctx, conn)
+
+		if err != nil {
+			return diag.Errorf("reading Shield Proactive Engagement (%s): %s", d.Id(), err)
+		}
+
+		if err := updateProactiveEngagementStatus(ctx, conn, status, d.Get("enabled").(bool)); err != nil {
+			return diag.Errorf("updating Shield Proactive Engagement (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceProactiveEngagementRead(ctx, d, meta)
+}
+
+func resourceProactiveEngagementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	status, err := findProactiveEngagementStatus(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Shield Proactive Engagement (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Shield Proactive Engagement: %s", d.Id())
+	if err := updateProactiveEngagementStatus(ctx, conn, status, false); err != nil {
+		return diag.Errorf("deleting Shield Proactive Engagement (%s): %s", d.Id(), err)
+	}
+
+	_, err = conn.UpdateEmergencyContactSettingsWithContext(ctx, &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+
+	if err != nil {
+		return diag.Errorf("deleting Shield Proactive Engagement (%s): removing emergency contacts: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateProactiveEngagementStatus enables or disables proactive engagement,
+// skipping the API call if the current status already matches.
+func updateProactiveEngagementStatus(ctx context.Context, conn *shield.Shield, status string, enabled bool) error {
+	if enabled {
+		if status == shield.ProactiveEngagementStatusEnabled {
+			return nil
+		}
+
+		if _, err := conn.EnableProactiveEngagementWithContext(ctx, &shield.EnableProactiveEngagementInput{}); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if status == shield.ProactiveEngagementStatusDisabled {
+		return nil
+	}
+
+	if _, err := conn.DisableProactiveEngagementWithContext(ctx, &shield.DisableProactiveEngagementInput{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func findProactiveEngagementStatus(ctx context.Context, conn *shield.Shield) (string, error) {
+	input := &shield.DescribeSubscriptionInput{}
+
+	output, err := conn.DescribeSubscriptionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Subscription == nil || output.Subscription.ProactiveEngagementStatus == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Subscription.ProactiveEngagementStatus), nil
+}
+
+func expandEmergencyContacts(tfList []interface{}) []*shield.EmergencyContact {
+	var apiObjects []*shield.EmergencyContact
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &shield.EmergencyContact{}
+
+		if v, ok := tfMap["contact_notes"].(string); ok && v != "" {
+			apiObject.ContactNotes = aws.String(v)
+		}
+
+		if v, ok := tfMap["email_address"].(string); ok && v != "" {
+			apiObject.EmailAddress = aws.String(v)
+		}
+
+		if v, ok := tfMap["phone_number"].(string); ok && v != "" {
+			apiObject.PhoneNumber = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEmergencyContacts(apiObjects []*shield.EmergencyContact) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"contact_notes": aws.StringValue(apiObject.ContactNotes),
+			"email_address": aws.StringValue(apiObject.EmailAddress),
+			"phone_number":  aws.StringValue(apiObject.PhoneNumber),
+		})
+	}
+
+	return tfList
+}
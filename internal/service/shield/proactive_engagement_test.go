@@ -0,0 +1,126 @@
+package shield_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccShieldProactiveEngagement_basic(t *testing.T) {
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProactiveEngagementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProactiveEngagementConfig_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.contact_notes", "Notes"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", acctest.DefaultEmailAddress),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.phone_number", "+12358132134"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProactiveEngagementConfig_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccProactiveEngagementConfig_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProactiveEngagementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_proactive_engagement" {
+			continue
+		}
+
+		output, err := conn.DescribeSubscriptionWithContext(context.Background(), &shield.DescribeSubscriptionInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.Subscription.ProactiveEngagementStatus); status == shield.ProactiveEngagementStatusEnabled {
+			return fmt.Errorf("Shield Proactive Engagement (%s) still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckProactiveEngagementExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Proactive Engagement ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+		output, err := conn.DescribeSubscriptionWithContext(context.Background(), &shield.DescribeSubscriptionInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if output.Subscription.ProactiveEngagementStatus == nil {
+			return fmt.Errorf("Shield Proactive Engagement (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccProactiveEngagementConfig_basic(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = %[1]t
+
+  emergency_contact {
+    contact_notes = "Notes"
+    email_address = %[2]q
+    phone_number  = "+12358132134"
+  }
+}
+`, enabled, acctest.DefaultEmailAddress)
+}
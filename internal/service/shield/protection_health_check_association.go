@@ -85,6 +85,7 @@ func ResourceProtectionHealthCheckAssociationRead(d *schema.ResourceData, meta i
 	if !isHealthCheck {
 		log.Printf("[WARN] Shield Protection Health Check Association (%s) not found, removing from state", d.Id())
 		d.SetId("")
+		return nil
 	}
 
 	d.Set("health_check_arn", healthCheckArn)
@@ -109,6 +110,10 @@ func ResourceProtectionHealthCheckAssociationDelete(d *schema.ResourceData, meta
 
 	_, err = conn.DisassociateHealthCheck(input)
 
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("error disassociating Route53 Health Check (%s) from Shield Protected resource (%s): %s", d.Get("health_check_arn"), d.Get("shield_protection_id"), err)
 	}
@@ -0,0 +1,194 @@
+package shield
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProtectionHealthCheckAssociations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProtectionHealthCheckAssociationsCreate,
+		ReadWithoutTimeout:   resourceProtectionHealthCheckAssociationsRead,
+		UpdateWithoutTimeout: resourceProtectionHealthCheckAssociationsUpdate,
+		DeleteWithoutTimeout: resourceProtectionHealthCheckAssociationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"health_check_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"shield_protection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceProtectionHealthCheckAssociationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	protectionID := d.Get("shield_protection_id").(string)
+
+	for _, healthCheckARN := range d.Get("health_check_arns").(*schema.Set).List() {
+		if err := associateHealthCheck(ctx, conn, protectionID, healthCheckARN.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(protectionID)
+
+	return resourceProtectionHealthCheckAssociationsRead(ctx, d, meta)
+}
+
+func resourceProtectionHealthCheckAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	protection, err := findProtectionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Protection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Shield Protection (%s): %s", d.Id(), err)
+	}
+
+	if !d.IsNewResource() && len(protection.HealthCheckIds) == 0 {
+		log.Printf("[WARN] Shield Protection (%s) Health Check Associations not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Shield reports health check IDs, which are converted back to the ARNs used to associate them.
+	var healthCheckARNs []string
+
+	for _, healthCheckID := range aws.StringValueSlice(protection.HealthCheckIds) {
+		healthCheckARNs = append(healthCheckARNs, arn.ARN{
+			Partition: meta.(*conns.AWSClient).Partition,
+			Service:   "route53",
+			Resource:  "healthcheck/" + healthCheckID,
+		}.String())
+	}
+
+	d.Set("health_check_arns", healthCheckARNs)
+	d.Set("shield_protection_id", protection.Id)
+
+	return nil
+}
+
+func resourceProtectionHealthCheckAssociationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	if d.HasChange("health_check_arns") {
+		o, n := d.GetChange("health_check_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		for _, healthCheckARN := range os.Difference(ns).List() {
+			if err := disassociateHealthCheck(ctx, conn, d.Id(), healthCheckARN.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, healthCheckARN := range ns.Difference(os).List() {
+			if err := associateHealthCheck(ctx, conn, d.Id(), healthCheckARN.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceProtectionHealthCheckAssociationsRead(ctx, d, meta)
+}
+
+func resourceProtectionHealthCheckAssociationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	for _, healthCheckARN := range d.Get("health_check_arns").(*schema.Set).List() {
+		if err := disassociateHealthCheck(ctx, conn, d.Id(), healthCheckARN.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func associateHealthCheck(ctx context.Context, conn *shield.Shield, protectionID, healthCheckARN string) error {
+	_, err := conn.AssociateHealthCheckWithContext(ctx, &shield.AssociateHealthCheckInput{
+		HealthCheckArn: aws.String(healthCheckARN),
+		ProtectionId:   aws.String(protectionID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("associating Route53 Health Check (%s) with Shield Protection (%s): %w", healthCheckARN, protectionID, err)
+	}
+
+	return nil
+}
+
+// disassociateHealthCheck removes the association, which may already have been removed, between a health check and a protection.
+func disassociateHealthCheck(ctx context.Context, conn *shield.Shield, protectionID, healthCheckARN string) error {
+	log.Printf("[DEBUG] Disassociating Route53 Health Check (%s) from Shield Protection (%s)", healthCheckARN, protectionID)
+	_, err := conn.DisassociateHealthCheckWithContext(ctx, &shield.DisassociateHealthCheckInput{
+		HealthCheckArn: aws.String(healthCheckARN),
+		ProtectionId:   aws.String(protectionID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("disassociating Route53 Health Check (%s) from Shield Protection (%s): %w", healthCheckARN, protectionID, err)
+	}
+
+	return nil
+}
+
+func findProtectionByID(ctx context.Context, conn *shield.Shield, id string) (*shield.Protection, error) {
+	input := &shield.DescribeProtectionInput{
+		ProtectionId: aws.String(id),
+	}
+
+	output, err := conn.DescribeProtectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Protection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Protection, nil
+}
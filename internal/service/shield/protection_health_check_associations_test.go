@@ -0,0 +1,188 @@
+package shield_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccShieldProtectionHealthCheckAssociations_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_protection_health_check_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProtectionHealthCheckAssociationsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProtectionHealthCheckAssociationsConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProtectionHealthCheckAssociationsExist(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "health_check_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "health_check_arns.*", "aws_route53_health_check.test1", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "shield_protection_id", "aws_shield_protection.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProtectionHealthCheckAssociationsConfig_basic(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProtectionHealthCheckAssociationsExist(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "health_check_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "health_check_arns.*", "aws_route53_health_check.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccShieldProtectionHealthCheckAssociations_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_protection_health_check_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProtectionHealthCheckAssociationsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProtectionHealthCheckAssociationsConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProtectionHealthCheckAssociationsExist(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfshield.ResourceProtectionHealthCheckAssociations(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProtectionHealthCheckAssociationsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_protection_health_check_associations" {
+			continue
+		}
+
+		protection, err := tfshield.FindProtectionByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(protection.HealthCheckIds) > 0 {
+			return fmt.Errorf("Shield Protection (%s) Health Check Associations %v still exist", rs.Primary.ID, aws.StringValueSlice(protection.HealthCheckIds))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckProtectionHealthCheckAssociationsExist(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Protection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+		protection, err := tfshield.FindProtectionByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(protection.HealthCheckIds) == 0 {
+			return fmt.Errorf("Shield Protection (%s) Health Check Associations not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccProtectionHealthCheckAssociationsConfig_basic(rName, healthCheckName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_eip" "test" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_shield_protection" "test" {
+  name         = %[1]q
+  resource_arn = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:eip-allocation/${aws_eip.test.id}"
+}
+
+resource "aws_route53_health_check" "test1" {
+  fqdn              = "example.com"
+  port              = 80
+  type              = "HTTP"
+  resource_path     = "/"
+  failure_threshold = "5"
+  request_interval  = "30"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_health_check" "test2" {
+  fqdn              = "example.org"
+  port              = 80
+  type              = "HTTP"
+  resource_path     = "/"
+  failure_threshold = "5"
+  request_interval  = "30"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_shield_protection_health_check_associations" "test" {
+  shield_protection_id = aws_shield_protection.test.id
+  health_check_arns    = [aws_route53_health_check.%[2]s.arn]
+}
+`, rName, healthCheckName)
+}
@@ -0,0 +1,173 @@
+package snowball
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAddressCreate,
+		ReadWithoutTimeout:   resourceAddressRead,
+		// Snow Family addresses cannot be deleted.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"city": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"company": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_restricted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"landmark": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"postal_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"prefecture_or_district": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"state_or_province": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"street1": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"street2": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"street3": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	address := &snowball.Address{
+		City:            aws.String(d.Get("city").(string)),
+		Country:         aws.String(d.Get("country").(string)),
+		IsRestricted:    aws.Bool(d.Get("is_restricted").(bool)),
+		Name:            aws.String(d.Get("name").(string)),
+		PhoneNumber:     aws.String(d.Get("phone_number").(string)),
+		PostalCode:      aws.String(d.Get("postal_code").(string)),
+		StateOrProvince: aws.String(d.Get("state_or_province").(string)),
+		Street1:         aws.String(d.Get("street1").(string)),
+	}
+
+	if v, ok := d.GetOk("company"); ok {
+		address.Company = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("landmark"); ok {
+		address.Landmark = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefecture_or_district"); ok {
+		address.PrefectureOrDistrict = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("street2"); ok {
+		address.Street2 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("street3"); ok {
+		address.Street3 = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAddressWithContext(ctx, &snowball.CreateAddressInput{
+		Address: address,
+	})
+
+	if err != nil {
+		return diag.Errorf("creating Snow Family Address: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.AddressId))
+
+	return resourceAddressRead(ctx, d, meta)
+}
+
+func resourceAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	address, err := FindAddressByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snow Family Address (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Snow Family Address (%s): %s", d.Id(), err)
+	}
+
+	d.Set("city", address.City)
+	d.Set("company", address.Company)
+	d.Set("country", address.Country)
+	d.Set("is_restricted", address.IsRestricted)
+	d.Set("landmark", address.Landmark)
+	d.Set("name", address.Name)
+	d.Set("phone_number", address.PhoneNumber)
+	d.Set("postal_code", address.PostalCode)
+	d.Set("prefecture_or_district", address.PrefectureOrDistrict)
+	d.Set("state_or_province", address.StateOrProvince)
+	d.Set("street1", address.Street1)
+	d.Set("street2", address.Street2)
+	d.Set("street3", address.Street3)
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package snowball_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/snowball"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsnowball "github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
+)
+
+func TestAccSnowballAddress_basic(t *testing.T) {
+	var v snowball.Address
+	resourceName := "aws_snowball_address.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, snowball.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddressConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddressExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "city", "Seattle"),
+					resource.TestCheckResourceAttr(resourceName, "country", "US"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "postal_code", "98109"),
+					resource.TestCheckResourceAttr(resourceName, "state_or_province", "WA"),
+					resource.TestCheckResourceAttr(resourceName, "street1", "410 Terry Ave N"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAddressExists(n string, v *snowball.Address) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Snow Family Address ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SnowballConn
+
+		output, err := tfsnowball.FindAddressByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAddressConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_snowball_address" "test" {
+  city              = "Seattle"
+  country           = "US"
+  name              = %[1]q
+  phone_number      = "+12065551234"
+  postal_code       = "98109"
+  state_or_province = "WA"
+  street1           = "410 Terry Ave N"
+}
+`, rName)
+}
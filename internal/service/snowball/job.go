@@ -0,0 +1,567 @@
+package snowball
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: resourceJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"forwarding_address_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"job_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.JobType_Values(), false),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"long_term_pricing_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_states_to_notify": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(snowball.JobState_Values(), false),
+							},
+						},
+						"notify_all": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"sns_topic_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"remote_management": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.RemoteManagement_Values(), false),
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2_ami_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ami_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"snowball_ami_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"lambda_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_resource_arns": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"lambda_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"key_range": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"begin_marker": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"end_marker": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"shipping_option": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(snowball.ShippingOption_Values(), false),
+			},
+			"snowball_capacity_preference": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Capacity_Values(), false),
+			},
+			"snowball_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Type_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	input := &snowball.CreateJobInput{
+		AddressId:      aws.String(d.Get("address_id").(string)),
+		JobType:        aws.String(d.Get("job_type").(string)),
+		ShippingOption: aws.String(d.Get("shipping_option").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("forwarding_address_id"); ok {
+		input.ForwardingAddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("long_term_pricing_id"); ok {
+		input.LongTermPricingId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("remote_management"); ok {
+		input.RemoteManagement = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_capacity_preference"); ok {
+		input.SnowballCapacityPreference = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_type"); ok {
+		input.SnowballType = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateJobWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Snow Family Job: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	return resourceJobRead(ctx, d, meta)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	job, err := FindJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snow Family Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Snow Family Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("address_id", job.AddressId)
+	if job.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(job.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("description", job.Description)
+	d.Set("forwarding_address_id", job.ForwardingAddressId)
+	d.Set("job_state", job.JobState)
+	d.Set("job_type", job.JobType)
+	d.Set("kms_key_arn", job.KmsKeyARN)
+	d.Set("long_term_pricing_id", job.LongTermPricingId)
+	if err := d.Set("notification", flattenNotification(job.Notification)); err != nil {
+		return diag.Errorf("setting notification: %s", err)
+	}
+	d.Set("remote_management", job.RemoteManagement)
+	if err := d.Set("resources", flattenJobResource(job.Resources)); err != nil {
+		return diag.Errorf("setting resources: %s", err)
+	}
+	d.Set("role_arn", job.RoleARN)
+	if job.ShippingDetails != nil {
+		d.Set("shipping_option", job.ShippingDetails.ShippingOption)
+	} else {
+		d.Set("shipping_option", nil)
+	}
+	d.Set("snowball_capacity_preference", job.SnowballCapacityPreference)
+	d.Set("snowball_type", job.SnowballType)
+
+	return nil
+}
+
+func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	input := &snowball.UpdateJobInput{
+		AddressId:      aws.String(d.Get("address_id").(string)),
+		Description:    aws.String(d.Get("description").(string)),
+		JobId:          aws.String(d.Id()),
+		ShippingOption: aws.String(d.Get("shipping_option").(string)),
+	}
+
+	if v, ok := d.GetOk("forwarding_address_id"); ok {
+		input.ForwardingAddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		input.Notification = &snowball.Notification{}
+	}
+
+	if v, ok := d.GetOk("resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_capacity_preference"); ok {
+		input.SnowballCapacityPreference = aws.String(v.(string))
+	}
+
+	_, err := conn.UpdateJobWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Snow Family Job (%s): %s", d.Id(), err)
+	}
+
+	return resourceJobRead(ctx, d, meta)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	// Completed jobs cannot be cancelled and remain listed in the account.
+	if d.Get("job_state").(string) == snowball.JobStateComplete {
+		log.Printf("[WARN] Snow Family Job (%s) is complete, removing from state", d.Id())
+		return nil
+	}
+
+	log.Printf("[INFO] Cancelling Snow Family Job: %s", d.Id())
+	_, err := conn.CancelJobWithContext(ctx, &snowball.CancelJobInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("cancelling Snow Family Job (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandNotification(tfMap map[string]interface{}) *snowball.Notification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &snowball.Notification{}
+
+	if v, ok := tfMap["job_states_to_notify"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.JobStatesToNotify = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["notify_all"].(bool); ok {
+		apiObject.NotifyAll = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["sns_topic_arn"].(string); ok && v != "" {
+		apiObject.SnsTopicARN = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobResource(tfMap map[string]interface{}) *snowball.JobResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &snowball.JobResource{}
+
+	if v, ok := tfMap["ec2_ami_resource"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Ec2AmiResources = append(apiObject.Ec2AmiResources, &snowball.Ec2AmiResource{
+				AmiId: aws.String(tfMap["ami_id"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["lambda_resource"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			lambdaResource := &snowball.LambdaResource{
+				LambdaArn: aws.String(tfMap["lambda_arn"].(string)),
+			}
+
+			if v, ok := tfMap["event_resource_arns"].([]interface{}); ok {
+				for _, arn := range flex.ExpandStringValueList(v) {
+					lambdaResource.EventTriggers = append(lambdaResource.EventTriggers, &snowball.EventTriggerDefinition{
+						EventResourceARN: aws.String(arn),
+					})
+				}
+			}
+
+			apiObject.LambdaResources = append(apiObject.LambdaResources, lambdaResource)
+		}
+	}
+
+	if v, ok := tfMap["s3_resource"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			s3Resource := &snowball.S3Resource{
+				BucketArn: aws.String(tfMap["bucket_arn"].(string)),
+			}
+
+			if v, ok := tfMap["key_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				keyRange := &snowball.KeyRange{}
+
+				if v, ok := tfMap["begin_marker"].(string); ok && v != "" {
+					keyRange.BeginMarker = aws.String(v)
+				}
+
+				if v, ok := tfMap["end_marker"].(string); ok && v != "" {
+					keyRange.EndMarker = aws.String(v)
+				}
+
+				s3Resource.KeyRange = keyRange
+			}
+
+			apiObject.S3Resources = append(apiObject.S3Resources, s3Resource)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenNotification(apiObject *snowball.Notification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	if apiObject.SnsTopicARN == nil && len(apiObject.JobStatesToNotify) == 0 && !aws.BoolValue(apiObject.NotifyAll) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"job_states_to_notify": aws.StringValueSlice(apiObject.JobStatesToNotify),
+		"notify_all":           aws.BoolValue(apiObject.NotifyAll),
+		"sns_topic_arn":        aws.StringValue(apiObject.SnsTopicARN),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenJobResource(apiObject *snowball.JobResource) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	if len(apiObject.Ec2AmiResources) == 0 && len(apiObject.LambdaResources) == 0 && len(apiObject.S3Resources) == 0 {
+		return nil
+	}
+
+	var ec2AmiResources []interface{}
+
+	for _, v := range apiObject.Ec2AmiResources {
+		if v == nil {
+			continue
+		}
+
+		ec2AmiResources = append(ec2AmiResources, map[string]interface{}{
+			"ami_id":          aws.StringValue(v.AmiId),
+			"snowball_ami_id": aws.StringValue(v.SnowballAmiId),
+		})
+	}
+
+	var lambdaResources []interface{}
+
+	for _, v := range apiObject.LambdaResources {
+		if v == nil {
+			continue
+		}
+
+		var eventResourceARNs []string
+
+		for _, v := range v.EventTriggers {
+			if v != nil {
+				eventResourceARNs = append(eventResourceARNs, aws.StringValue(v.EventResourceARN))
+			}
+		}
+
+		lambdaResources = append(lambdaResources, map[string]interface{}{
+			"event_resource_arns": eventResourceARNs,
+			"lambda_arn":          aws.StringValue(v.LambdaArn),
+		})
+	}
+
+	var s3Resources []interface{}
+
+	for _, v := range apiObject.S3Resources {
+		if v == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"bucket_arn": aws.StringValue(v.BucketArn),
+		}
+
+		if v := v.KeyRange; v != nil && (v.BeginMarker != nil || v.EndMarker != nil) {
+			tfMap["key_range"] = []interface{}{map[string]interface{}{
+				"begin_marker": aws.StringValue(v.BeginMarker),
+				"end_marker":   aws.StringValue(v.EndMarker),
+			}}
+		}
+
+		s3Resources = append(s3Resources, tfMap)
+	}
+
+	tfMap := map[string]interface{}{
+		"ec2_ami_resource": ec2AmiResources,
+		"lambda_resource":  lambdaResources,
+		"s3_resource":      s3Resources,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 )
@@ -64,7 +66,10 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 
 	conn := d.Meta().STSConn
 
-	output, err := FindCallerIdentity(ctx, conn)
+	// The caller identity cannot change during the lifetime of the provider instance.
+	output, err := conns.CachedRead(d.Meta(), "sts.GetCallerIdentity", func() (*sts.GetCallerIdentityOutput, error) {
+		return FindCallerIdentity(ctx, conn)
+	})
 
 	if err != nil {
 		response.Diagnostics.AddError("reading STS Caller Identity", err.Error())
@@ -0,0 +1,45 @@
+package support
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/support"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindTrustedAdvisorChecks(ctx context.Context, conn *support.Support, language string) ([]*support.TrustedAdvisorCheckDescription, error) {
+	input := &support.DescribeTrustedAdvisorChecksInput{
+		Language: aws.String(language),
+	}
+
+	output, err := conn.DescribeTrustedAdvisorChecksWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Checks, nil
+}
+
+func FindTrustedAdvisorCheckSummaries(ctx context.Context, conn *support.Support, checkIDs []string) ([]*support.TrustedAdvisorCheckSummary, error) {
+	input := &support.DescribeTrustedAdvisorCheckSummariesInput{
+		CheckIds: aws.StringSlice(checkIDs),
+	}
+
+	output, err := conn.DescribeTrustedAdvisorCheckSummariesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Summaries, nil
+}
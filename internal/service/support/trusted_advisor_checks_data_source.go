@@ -0,0 +1,191 @@
+package support
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	trustedAdvisorCheckStatusError        = "error"
+	trustedAdvisorCheckStatusNotAvailable = "not_available"
+	trustedAdvisorCheckStatusOK           = "ok"
+	trustedAdvisorCheckStatusWarning      = "warning"
+)
+
+func trustedAdvisorCheckStatus_Values() []string {
+	return []string{
+		trustedAdvisorCheckStatusError,
+		trustedAdvisorCheckStatusNotAvailable,
+		trustedAdvisorCheckStatusOK,
+		trustedAdvisorCheckStatusWarning,
+	}
+}
+
+func DataSourceTrustedAdvisorChecks() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTrustedAdvisorChecksRead,
+
+		Schema: map[string]*schema.Schema{
+			"categories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"cost_optimizing", "fault_tolerance", "performance", "security", "service_limits"}, false),
+				},
+			},
+			"checks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"estimated_monthly_savings": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resources_flagged": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resources_ignored": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resources_processed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resources_suppressed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "en",
+			},
+			"statuses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(trustedAdvisorCheckStatus_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTrustedAdvisorChecksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SupportConn
+
+	checks, err := FindTrustedAdvisorChecks(ctx, conn, d.Get("language").(string))
+
+	if err != nil {
+		return diag.Errorf("reading Trusted Advisor Checks: %s", err)
+	}
+
+	categories := d.Get("categories").(*schema.Set)
+	tfMaps := make(map[string]map[string]interface{})
+	var checkIDs []string
+
+	for _, check := range checks {
+		if check == nil {
+			continue
+		}
+
+		category := aws.StringValue(check.Category)
+
+		if categories.Len() > 0 && !categories.Contains(category) {
+			continue
+		}
+
+		checkID := aws.StringValue(check.Id)
+		checkIDs = append(checkIDs, checkID)
+		tfMaps[checkID] = map[string]interface{}{
+			"category": category,
+			"id":       checkID,
+			"name":     aws.StringValue(check.Name),
+		}
+	}
+
+	statuses := d.Get("statuses").(*schema.Set)
+	var tfList []interface{}
+
+	if len(checkIDs) > 0 {
+		summaries, err := FindTrustedAdvisorCheckSummaries(ctx, conn, checkIDs)
+
+		if err != nil {
+			return diag.Errorf("reading Trusted Advisor Check Summaries: %s", err)
+		}
+
+		for _, summary := range summaries {
+			if summary == nil {
+				continue
+			}
+
+			status := aws.StringValue(summary.Status)
+
+			if statuses.Len() > 0 && !statuses.Contains(status) {
+				continue
+			}
+
+			tfMap, ok := tfMaps[aws.StringValue(summary.CheckId)]
+
+			if !ok {
+				continue
+			}
+
+			tfMap["status"] = status
+			tfMap["timestamp"] = aws.StringValue(summary.Timestamp)
+
+			if v := summary.ResourcesSummary; v != nil {
+				tfMap["resources_flagged"] = aws.Int64Value(v.ResourcesFlagged)
+				tfMap["resources_ignored"] = aws.Int64Value(v.ResourcesIgnored)
+				tfMap["resources_processed"] = aws.Int64Value(v.ResourcesProcessed)
+				tfMap["resources_suppressed"] = aws.Int64Value(v.ResourcesSuppressed)
+			}
+
+			if v := summary.CategorySpecificSummary; v != nil && v.CostOptimizing != nil {
+				tfMap["estimated_monthly_savings"] = aws.Float64Value(v.CostOptimizing.EstimatedMonthlySavings)
+			}
+
+			tfList = append(tfList, tfMap)
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	if err := d.Set("checks", tfList); err != nil {
+		return diag.Errorf("setting checks: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package support_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/support"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsupport "github.com/hashicorp/terraform-provider-aws/internal/service/support"
+)
+
+func TestAccSupportTrustedAdvisorChecksDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_support_trusted_advisor_checks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, support.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustedAdvisorChecksDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "checks.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "checks.0.category", "security"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "checks.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "checks.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "checks.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSupportTrustedAdvisorChecksDataSource_statuses(t *testing.T) {
+	dataSourceName := "data.aws_support_trusted_advisor_checks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, support.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustedAdvisorChecksDataSourceConfig_statuses,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "checks.*", map[string]string{
+						"status": "ok",
+					}),
+				),
+			},
+		},
+	})
+}
+
+// The Trusted Advisor API requires a Business, Enterprise On-Ramp or Enterprise Support plan.
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SupportConn
+
+	_, err := tfsupport.FindTrustedAdvisorChecks(context.Background(), conn, "en")
+
+	if acctest.PreCheckSkipError(err) || tfawserr.ErrCodeEquals(err, "SubscriptionRequiredException") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+const testAccTrustedAdvisorChecksDataSourceConfig_basic = `
+data "aws_support_trusted_advisor_checks" "test" {
+  categories = ["security"]
+}
+`
+
+const testAccTrustedAdvisorChecksDataSourceConfig_statuses = `
+data "aws_support_trusted_advisor_checks" "test" {
+  statuses = ["ok"]
+}
+`
@@ -0,0 +1,42 @@
+package supportapp
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/supportapp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func FindSlackChannelConfigurationByTwoPartKey(ctx context.Context, conn *supportapp.SupportApp, teamID, channelID string) (*supportapp.SlackChannelConfiguration, error) {
+	input := &supportapp.ListSlackChannelConfigurationsInput{}
+	var output *supportapp.SlackChannelConfiguration
+
+	err := conn.ListSlackChannelConfigurationsPagesWithContext(ctx, input, func(page *supportapp.ListSlackChannelConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SlackChannelConfigurations {
+			if aws.StringValue(v.TeamId) == teamID && aws.StringValue(v.ChannelId) == channelID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,220 @@
+package supportapp
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/supportapp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSlackChannelConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSlackChannelConfigurationCreate,
+		ReadWithoutTimeout:   resourceSlackChannelConfigurationRead,
+		UpdateWithoutTimeout: resourceSlackChannelConfigurationUpdate,
+		DeleteWithoutTimeout: resourceSlackChannelConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"channel_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"channel_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"notify_on_add_correspondence_to_case": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"notify_on_case_severity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(supportapp.NotificationSeverityLevel_Values(), false),
+			},
+			"notify_on_create_or_reopen_case": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"notify_on_resolve_case": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourceSlackChannelConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SupportAppConn
+
+	teamID := d.Get("team_id").(string)
+	channelID := d.Get("channel_id").(string)
+	id := SlackChannelConfigurationCreateResourceID(teamID, channelID)
+	input := &supportapp.CreateSlackChannelConfigurationInput{
+		ChannelId:                       aws.String(channelID),
+		ChannelRoleArn:                  aws.String(d.Get("channel_role_arn").(string)),
+		NotifyOnAddCorrespondenceToCase: aws.Bool(d.Get("notify_on_add_correspondence_to_case").(bool)),
+		NotifyOnCaseSeverity:            aws.String(d.Get("notify_on_case_severity").(string)),
+		NotifyOnCreateOrReopenCase:      aws.Bool(d.Get("notify_on_create_or_reopen_case").(bool)),
+		NotifyOnResolveCase:             aws.Bool(d.Get("notify_on_resolve_case").(bool)),
+		TeamId:                          aws.String(teamID),
+	}
+
+	if v, ok := d.GetOk("channel_name"); ok {
+		input.ChannelName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Support App Slack Channel Configuration: %s", input)
+	_, err := conn.CreateSlackChannelConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Support App Slack Channel Configuration (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceSlackChannelConfigurationRead(ctx, d, meta)
+}
+
+func resourceSlackChannelConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SupportAppConn
+
+	teamID, channelID, err := SlackChannelConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindSlackChannelConfigurationByTwoPartKey(ctx, conn, teamID, channelID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Support App Slack Channel Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Support App Slack Channel Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("channel_id", output.ChannelId)
+	d.Set("channel_name", output.ChannelName)
+	d.Set("channel_role_arn", output.ChannelRoleArn)
+	d.Set("notify_on_add_correspondence_to_case", output.NotifyOnAddCorrespondenceToCase)
+	d.Set("notify_on_case_severity", output.NotifyOnCaseSeverity)
+	d.Set("notify_on_create_or_reopen_case", output.NotifyOnCreateOrReopenCase)
+	d.Set("notify_on_resolve_case", output.NotifyOnResolveCase)
+	d.Set("team_id", output.TeamId)
+
+	return nil
+}
+
+func resourceSlackChannelConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SupportAppConn
+
+	teamID, channelID, err := SlackChannelConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &supportapp.UpdateSlackChannelConfigurationInput{
+		ChannelId:                       aws.String(channelID),
+		ChannelRoleArn:                  aws.String(d.Get("channel_role_arn").(string)),
+		NotifyOnAddCorrespondenceToCase: aws.Bool(d.Get("notify_on_add_correspondence_to_case").(bool)),
+		NotifyOnCaseSeverity:            aws.String(d.Get("notify_on_case_severity").(string)),
+		NotifyOnCreateOrReopenCase:      aws.Bool(d.Get("notify_on_create_or_reopen_case").(bool)),
+		NotifyOnResolveCase:             aws.Bool(d.Get("notify_on_resolve_case").(bool)),
+		TeamId:                          aws.String(teamID),
+	}
+
+	if v, ok := d.GetOk("channel_name"); ok {
+		input.ChannelName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Support App Slack Channel Configuration: %s", input)
+	_, err = conn.UpdateSlackChannelConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Support App Slack Channel Configuration (%s): %s", d.Id(), err)
+	}
+
+	return resourceSlackChannelConfigurationRead(ctx, d, meta)
+}
+
+func resourceSlackChannelConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SupportAppConn
+
+	teamID, channelID, err := SlackChannelConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Support App Slack Channel Configuration: %s", d.Id())
+	_, err = conn.DeleteSlackChannelConfigurationWithContext(ctx, &supportapp.DeleteSlackChannelConfigurationInput{
+		ChannelId: aws.String(channelID),
+		TeamId:    aws.String(teamID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, supportapp.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Support App Slack Channel Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const slackChannelConfigurationResourceIDSeparator = ","
+
+func SlackChannelConfigurationCreateResourceID(teamID, channelID string) string {
+	parts := []string{teamID, channelID}
+	id := strings.Join(parts, slackChannelConfigurationResourceIDSeparator)
+
+	return id
+}
+
+func SlackChannelConfigurationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, slackChannelConfigurationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TEAM-ID%[2]sCHANNEL-ID", id, slackChannelConfigurationResourceIDSeparator)
+}
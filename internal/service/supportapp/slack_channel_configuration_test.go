@@ -0,0 +1,162 @@
+package supportapp_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/supportapp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsupportapp "github.com/hashicorp/terraform-provider-aws/internal/service/supportapp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The Slack workspace must be authorized for the AWS Support App in the Support Center console.
+func testAccSlackChannelConfigurationPreCheck(t *testing.T) (string, string) {
+	teamID := os.Getenv("SUPPORTAPP_SLACK_TEAM_ID")
+	if teamID == "" {
+		t.Skip("Environment variable SUPPORTAPP_SLACK_TEAM_ID is not set")
+	}
+
+	channelID := os.Getenv("SUPPORTAPP_SLACK_CHANNEL_ID")
+	if channelID == "" {
+		t.Skip("Environment variable SUPPORTAPP_SLACK_CHANNEL_ID is not set")
+	}
+
+	return teamID, channelID
+}
+
+func TestAccSupportAppSlackChannelConfiguration_basic(t *testing.T) {
+	teamID, channelID := testAccSlackChannelConfigurationPreCheck(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_supportapp_slack_channel_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(supportapp.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, supportapp.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID, "high", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "channel_id", channelID),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_add_correspondence_to_case", "false"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_case_severity", "high"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_create_or_reopen_case", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_resolve_case", "true"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID, "all", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_add_correspondence_to_case", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_case_severity", "all"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSlackChannelConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_supportapp_slack_channel_configuration" {
+			continue
+		}
+
+		teamID, channelID, err := tfsupportapp.SlackChannelConfigurationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfsupportapp.FindSlackChannelConfigurationByTwoPartKey(context.Background(), conn, teamID, channelID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Support App Slack Channel Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSlackChannelConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Support App Slack Channel Configuration ID is set")
+		}
+
+		teamID, channelID, err := tfsupportapp.SlackChannelConfigurationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppConn
+
+		_, err = tfsupportapp.FindSlackChannelConfigurationByTwoPartKey(context.Background(), conn, teamID, channelID)
+
+		return err
+	}
+}
+
+func testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID, severity string, notifyOnAddCorrespondence bool) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "supportapp.amazonaws.com"
+      }
+    }]
+  })
+
+  managed_policy_arns = ["arn:${data.aws_partition.current.partition}:iam::aws:policy/AWSSupportAppFullAccess"]
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_supportapp_slack_channel_configuration" "test" {
+  team_id          = %[2]q
+  channel_id       = %[3]q
+  channel_role_arn = aws_iam_role.test.arn
+
+  notify_on_add_correspondence_to_case = %[5]t
+  notify_on_case_severity              = %[4]q
+  notify_on_create_or_reopen_case      = true
+  notify_on_resolve_case               = true
+}
+`, rName, teamID, channelID, severity, notifyOnAddCorrespondence)
+}
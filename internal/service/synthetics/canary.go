@@ -1,6 +1,7 @@
 package synthetics
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -257,10 +259,38 @@ func ResourceCanary() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceCanaryCustomizeDiff,
+		),
 	}
 }
 
+// resourceCanaryCustomizeDiff rejects active tracing for runtimes that do not support it.
+// Active X-Ray tracing is only available with the syn-nodejs-2.0 runtime or later.
+func resourceCanaryCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.Get("run_config").([]interface{}); !ok || len(v) == 0 || v[0] == nil || !v[0].(map[string]interface{})["active_tracing"].(bool) {
+		return nil
+	}
+
+	runtimeVersion := diff.Get("runtime_version").(string)
+
+	// The runtime may not be known until apply.
+	if runtimeVersion == "" {
+		return nil
+	}
+
+	if !canaryRuntimeSupportsActiveTracing(runtimeVersion) {
+		return fmt.Errorf("run_config.0.active_tracing is not supported with runtime_version %q, use syn-nodejs-2.0 or later", runtimeVersion)
+	}
+
+	return nil
+}
+
+func canaryRuntimeSupportsActiveTracing(runtimeVersion string) bool {
+	return strings.HasPrefix(runtimeVersion, "syn-nodejs-") && runtimeVersion != "syn-nodejs-2.0-beta"
+}
+
 func resourceCanaryCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SyntheticsConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
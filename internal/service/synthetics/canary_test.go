@@ -385,6 +385,27 @@ func TestAccSyntheticsCanary_runTracing(t *testing.T) {
 	})
 }
 
+func TestAccSyntheticsCanary_runTracingUnsupportedRuntime(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, synthetics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCanaryConfig_runTracingRuntimeVersion(rName, "syn-python-selenium-1.3"),
+				ExpectError: regexp.MustCompile(`active_tracing is not supported with runtime_version`),
+			},
+			{
+				Config:      testAccCanaryConfig_runTracingRuntimeVersion(rName, "syn-1.0"),
+				ExpectError: regexp.MustCompile(`active_tracing is not supported with runtime_version`),
+			},
+		},
+	})
+}
+
 func TestAccSyntheticsCanary_runEnvironmentVariables(t *testing.T) {
 	var conf synthetics.Canary
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
@@ -800,6 +821,31 @@ resource "aws_synthetics_canary" "test" {
 `, rName, tracing))
 }
 
+func testAccCanaryConfig_runTracingRuntimeVersion(rName, runtimeVersion string) string {
+	return acctest.ConfigCompose(testAccCanaryBaseConfig(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/lambdatest.zip"
+  runtime_version      = %[2]q
+  delete_lambda        = true
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  run_config {
+    active_tracing     = true
+    timeout_in_seconds = 60
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, runtimeVersion))
+}
+
 func testAccCanaryConfig_runEnvVariables1(rName string) string {
 	return acctest.ConfigCompose(testAccCanaryBaseConfig(rName), fmt.Sprintf(`
 resource "aws_synthetics_canary" "test" {
@@ -194,23 +194,17 @@ func ResourceServer() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"on_partial_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     workflowDetailSchema(),
+						},
 						"on_upload": {
 							Type:     schema.TypeList,
 							Optional: true,
 							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"execution_role": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"workflow_id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
+							Elem:     workflowDetailSchema(),
 						},
 					},
 				},
@@ -219,6 +213,22 @@ func ResourceServer() *schema.Resource {
 	}
 }
 
+func workflowDetailSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"workflow_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
 func resourceServerCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).TransferConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -608,7 +618,22 @@ func resourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if d.HasChange("workflow_details") {
-			input.WorkflowDetails = expandWorkflowDetails(d.Get("workflow_details").([]interface{}))
+			apiObject := expandWorkflowDetails(d.Get("workflow_details").([]interface{}))
+
+			if apiObject == nil {
+				apiObject = &transfer.WorkflowDetails{}
+			}
+
+			// An empty list of workflow details removes the associated workflow from the server.
+			if apiObject.OnPartialUpload == nil {
+				apiObject.OnPartialUpload = []*transfer.WorkflowDetail{}
+			}
+
+			if apiObject.OnUpload == nil {
+				apiObject.OnUpload = []*transfer.WorkflowDetail{}
+			}
+
+			input.WorkflowDetails = apiObject
 		}
 
 		if offlineUpdate {
@@ -795,7 +820,7 @@ func flattenEndpointDetails(apiObject *transfer.EndpointDetails, securityGroupID
 }
 
 func expandWorkflowDetails(tfMap []interface{}) *transfer.WorkflowDetails {
-	if tfMap == nil {
+	if len(tfMap) == 0 || tfMap[0] == nil {
 		return nil
 	}
 
@@ -803,6 +828,10 @@ func expandWorkflowDetails(tfMap []interface{}) *transfer.WorkflowDetails {
 
 	apiObject := &transfer.WorkflowDetails{}
 
+	if v, ok := tfMapRaw["on_partial_upload"].([]interface{}); ok && len(v) > 0 {
+		apiObject.OnPartialUpload = expandWorkflowDetail(v)
+	}
+
 	if v, ok := tfMapRaw["on_upload"].([]interface{}); ok && len(v) > 0 {
 		apiObject.OnUpload = expandWorkflowDetail(v)
 	}
@@ -811,12 +840,16 @@ func expandWorkflowDetails(tfMap []interface{}) *transfer.WorkflowDetails {
 }
 
 func flattenWorkflowDetails(apiObject *transfer.WorkflowDetails) []interface{} {
-	if apiObject == nil {
+	if apiObject == nil || (len(apiObject.OnPartialUpload) == 0 && len(apiObject.OnUpload) == 0) {
 		return nil
 	}
 
 	tfMap := map[string]interface{}{}
 
+	if v := apiObject.OnPartialUpload; v != nil {
+		tfMap["on_partial_upload"] = flattenWorkflowDetail(v)
+	}
+
 	if v := apiObject.OnUpload; v != nil {
 		tfMap["on_upload"] = flattenWorkflowDetail(v)
 	}
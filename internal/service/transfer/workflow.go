@@ -1,6 +1,7 @@
 package transfer
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -29,6 +30,7 @@ func ResourceWorkflow() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			customizeDiffSteps,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -546,6 +548,49 @@ func resourceWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// workflowStepDetailsAttributes maps each workflow step type to the attribute holding its details.
+var workflowStepDetailsAttributes = map[string]string{
+	transfer.WorkflowStepTypeCopy:   "copy_step_details",
+	transfer.WorkflowStepTypeCustom: "custom_step_details",
+	transfer.WorkflowStepTypeDelete: "delete_step_details",
+	transfer.WorkflowStepTypeTag:    "tag_step_details",
+}
+
+func customizeDiffSteps(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"on_exception_steps", "steps"} {
+		// Unknown values, e.g. from dynamic blocks, are not validated.
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		for i, tfMapRaw := range diff.Get(key).([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			stepType := tfMap["type"].(string)
+
+			for _, t := range transfer.WorkflowStepType_Values() {
+				attr := workflowStepDetailsAttributes[t]
+				v, _ := tfMap[attr].([]interface{})
+				configured := len(v) > 0
+
+				if t == stepType && !configured {
+					return fmt.Errorf("%s.%d: %s must be configured for step type %s", key, i, attr, stepType)
+				}
+
+				if t != stepType && configured {
+					return fmt.Errorf("%s.%d: %s must not be configured for step type %s", key, i, attr, stepType)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandWorkflows(tfList []interface{}) []*transfer.WorkflowStep {
 	if len(tfList) == 0 {
 		return nil
@@ -602,7 +647,7 @@ func flattenWorkflows(apiObjects []*transfer.WorkflowStep) []interface{} {
 			flattenedObject["delete_step_details"] = flattenDeleteStepDetails(apiObject.DeleteStepDetails)
 		}
 
-		if apiObject.DeleteStepDetails != nil {
+		if apiObject.CopyStepDetails != nil {
 			flattenedObject["copy_step_details"] = flattenCopyStepDetails(apiObject.CopyStepDetails)
 		}
 
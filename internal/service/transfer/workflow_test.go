@@ -87,6 +87,23 @@ func TestAccTransferWorkflow_onExecution(t *testing.T) {
 	})
 }
 
+func TestAccTransferWorkflow_stepDetailsMismatch(t *testing.T) {
+	rName := sdkacctest.RandString(25)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWorkflowConfig_stepDetailsMismatch(rName),
+				ExpectError: regexp.MustCompile(`steps.0: copy_step_details must be configured for step type COPY`),
+			},
+		},
+	})
+}
+
 func TestAccTransferWorkflow_description(t *testing.T) {
 	var conf transfer.DescribedWorkflow
 	resourceName := "aws_transfer_workflow.test"
@@ -283,6 +300,20 @@ resource "aws_transfer_workflow" "test" {
 `, rName)
 }
 
+func testAccWorkflowConfig_stepDetailsMismatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_workflow" "test" {
+  steps {
+    delete_step_details {
+      name                 = %[1]q
+      source_file_location = "$${original.file}"
+    }
+    type = "COPY"
+  }
+}
+`, rName)
+}
+
 func testAccWorkflowConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_transfer_workflow" "test" {
@@ -0,0 +1,221 @@
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebBrowserSettings_basic(t *testing.T) {
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig_basic("chrome://settings"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "workspaces-web", regexp.MustCompile(`browserSettings/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "associated_portal_arns.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "browser_policy"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBrowserSettingsConfig_basic("chrome://version"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "browser_policy", regexp.MustCompile(`chrome://version`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebBrowserSettings_disappears(t *testing.T) {
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig_basic("chrome://settings"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourceBrowserSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebBrowserSettings_tags(t *testing.T) {
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBrowserSettingsConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccBrowserSettingsConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBrowserSettingsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_browser_settings" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindBrowserSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Browser Settings %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckBrowserSettingsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Browser Settings ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		_, err := tfworkspacesweb.FindBrowserSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccBrowserSettingsConfig_basic(homepage string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_browser_settings" "test" {
+  browser_policy = jsonencode({
+    chromePolicies = {
+      RestoreOnStartup = {
+        value = 4
+      }
+      RestoreOnStartupURLs = {
+        value = [%[1]q]
+      }
+    }
+  })
+}
+`, homepage)
+}
+
+func testAccBrowserSettingsConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_browser_settings" "test" {
+  browser_policy = jsonencode({
+    chromePolicies = {
+      RestoreOnStartup = {
+        value = 1
+      }
+    }
+  })
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccBrowserSettingsConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_browser_settings" "test" {
+  browser_policy = jsonencode({
+    chromePolicies = {
+      RestoreOnStartup = {
+        value = 1
+      }
+    }
+  })
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
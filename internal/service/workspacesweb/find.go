@@ -0,0 +1,188 @@
+package workspacesweb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindBrowserSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.BrowserSettings, error) {
+	input := &workspacesweb.GetBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetBrowserSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BrowserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BrowserSettings, nil
+}
+
+func FindNetworkSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.NetworkSettings, error) {
+	input := &workspacesweb.GetNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetNetworkSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NetworkSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.NetworkSettings, nil
+}
+
+func FindPortalByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.Portal, error) {
+	input := &workspacesweb.GetPortalInput{
+		PortalArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPortalWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Portal == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Portal, nil
+}
+
+func FindTrustStoreByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.TrustStore, error) {
+	input := &workspacesweb.GetTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustStore, nil
+}
+
+func FindTrustStoreCertificatesByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) ([]*workspacesweb.Certificate, error) {
+	input := &workspacesweb.ListTrustStoreCertificatesInput{
+		TrustStoreArn: aws.String(arn),
+	}
+
+	var thumbprints []string
+
+	err := conn.ListTrustStoreCertificatesPagesWithContext(ctx, input, func(page *workspacesweb.ListTrustStoreCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CertificateList {
+			if v != nil {
+				thumbprints = append(thumbprints, aws.StringValue(v.Thumbprint))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates []*workspacesweb.Certificate
+
+	for _, thumbprint := range thumbprints {
+		output, err := conn.GetTrustStoreCertificateWithContext(ctx, &workspacesweb.GetTrustStoreCertificateInput{
+			Thumbprint:    aws.String(thumbprint),
+			TrustStoreArn: aws.String(arn),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output != nil && output.Certificate != nil {
+			certificates = append(certificates, output.Certificate)
+		}
+	}
+
+	return certificates, nil
+}
+
+func FindUserSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.UserSettings, error) {
+	input := &workspacesweb.GetUserSettingsInput{
+		UserSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetUserSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserSettings, nil
+}
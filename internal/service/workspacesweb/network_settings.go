@@ -0,0 +1,184 @@
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkSettingsCreate,
+		ReadWithoutTimeout:   resourceNetworkSettingsRead,
+		UpdateWithoutTimeout: resourceNetworkSettingsUpdate,
+		DeleteWithoutTimeout: resourceNetworkSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 3,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceNetworkSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &workspacesweb.CreateNetworkSettingsInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		SecurityGroupIds: flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set)),
+		SubnetIds:        flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		VpcId:            aws.String(d.Get("vpc_id").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateNetworkSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkSpaces Web Network Settings: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkSettingsArn))
+
+	return resourceNetworkSettingsRead(ctx, d, meta)
+}
+
+func resourceNetworkSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	settings, err := FindNetworkSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Network Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web Network Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", settings.NetworkSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(settings.AssociatedPortalArns))
+	d.Set("security_group_ids", aws.StringValueSlice(settings.SecurityGroupIds))
+	d.Set("subnet_ids", aws.StringValueSlice(settings.SubnetIds))
+	d.Set("vpc_id", settings.VpcId)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for WorkSpaces Web Network Settings (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChanges("security_group_ids", "subnet_ids", "vpc_id") {
+		input := &workspacesweb.UpdateNetworkSettingsInput{
+			ClientToken:        aws.String(resource.UniqueId()),
+			NetworkSettingsArn: aws.String(d.Id()),
+			SecurityGroupIds:   flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set)),
+			SubnetIds:          flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+			VpcId:              aws.String(d.Get("vpc_id").(string)),
+		}
+
+		_, err := conn.UpdateNetworkSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating WorkSpaces Web Network Settings (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating WorkSpaces Web Network Settings (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkSettingsRead(ctx, d, meta)
+}
+
+func resourceNetworkSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[INFO] Deleting WorkSpaces Web Network Settings: %s", d.Id())
+	_, err := conn.DeleteNetworkSettingsWithContext(ctx, &workspacesweb.DeleteNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkSpaces Web Network Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebNetworkSettings_basic(t *testing.T) {
+	resourceName := "aws_workspacesweb_network_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSettingsConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkSettingsExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetworkSettingsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkSettingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebNetworkSettings_disappears(t *testing.T) {
+	resourceName := "aws_workspacesweb_network_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSettingsConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkSettingsExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourceNetworkSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkSettingsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_network_settings" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindNetworkSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Network Settings %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkSettingsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Network Settings ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		_, err := tfworkspacesweb.FindNetworkSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccNetworkSettingsConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  count = 2
+
+  name   = "%[1]s-${count.index}"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccNetworkSettingsConfig_basic(rName string, securityGroupCount int) string {
+	return acctest.ConfigCompose(testAccNetworkSettingsConfig_base(rName), fmt.Sprintf(`
+resource "aws_workspacesweb_network_settings" "test" {
+  security_group_ids = slice(aws_security_group.test[*].id, 0, %[1]d)
+  subnet_ids         = aws_subnet.test[*].id
+  vpc_id             = aws_vpc.test.id
+}
+`, securityGroupCount))
+}
@@ -0,0 +1,385 @@
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePortal() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePortalCreate,
+		ReadWithoutTimeout:   resourcePortalRead,
+		UpdateWithoutTimeout: resourcePortalUpdate,
+		DeleteWithoutTimeout: resourcePortalDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"browser_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"browser_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"network_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"portal_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portal_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"renderer_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"trust_store_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"user_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePortalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &workspacesweb.CreatePortalInput{
+		ClientToken: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		input.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreatePortalWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkSpaces Web Portal: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PortalArn))
+
+	for _, association := range portalAssociations {
+		if v, ok := d.GetOk(association.attribute); ok {
+			if err := association.associate(ctx, conn, d.Id(), v.(string)); err != nil {
+				return diag.Errorf("creating WorkSpaces Web Portal (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourcePortalRead(ctx, d, meta)
+}
+
+func resourcePortalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	portal, err := FindPortalByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Portal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web Portal (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", portal.PortalArn)
+	d.Set("browser_settings_arn", portal.BrowserSettingsArn)
+	d.Set("browser_type", portal.BrowserType)
+	if portal.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(portal.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("display_name", portal.DisplayName)
+	d.Set("network_settings_arn", portal.NetworkSettingsArn)
+	d.Set("portal_endpoint", portal.PortalEndpoint)
+	d.Set("portal_status", portal.PortalStatus)
+	d.Set("renderer_type", portal.RendererType)
+	d.Set("status_reason", portal.StatusReason)
+	d.Set("trust_store_arn", portal.TrustStoreArn)
+	d.Set("user_settings_arn", portal.UserSettingsArn)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for WorkSpaces Web Portal (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePortalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChange("display_name") {
+		input := &workspacesweb.UpdatePortalInput{
+			DisplayName: aws.String(d.Get("display_name").(string)),
+			PortalArn:   aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdatePortalWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating WorkSpaces Web Portal (%s): %s", d.Id(), err)
+		}
+	}
+
+	for _, association := range portalAssociations {
+		if !d.HasChange(association.attribute) {
+			continue
+		}
+
+		o, n := d.GetChange(association.attribute)
+
+		if o.(string) != "" {
+			if err := association.disassociate(ctx, conn, d.Id()); err != nil {
+				return diag.Errorf("updating WorkSpaces Web Portal (%s): %s", d.Id(), err)
+			}
+		}
+
+		if n.(string) != "" {
+			if err := association.associate(ctx, conn, d.Id(), n.(string)); err != nil {
+				return diag.Errorf("updating WorkSpaces Web Portal (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating WorkSpaces Web Portal (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePortalRead(ctx, d, meta)
+}
+
+func resourcePortalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[INFO] Deleting WorkSpaces Web Portal: %s", d.Id())
+	_, err := conn.DeletePortalWithContext(ctx, &workspacesweb.DeletePortalInput{
+		PortalArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkSpaces Web Portal (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// portalAssociation describes a settings resource that is attached to a
+// portal through its own associate and disassociate operations.
+type portalAssociation struct {
+	attribute    string
+	associate    func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error
+	disassociate func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error
+}
+
+var portalAssociations = []portalAssociation{
+	{
+		attribute: "browser_settings_arn",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateBrowserSettingsWithContext(ctx, &workspacesweb.AssociateBrowserSettingsInput{
+				BrowserSettingsArn: aws.String(arn),
+				PortalArn:          aws.String(portalARN),
+			})
+
+			if err != nil {
+				return fmt.Errorf("associating Browser Settings (%s): %w", arn, err)
+			}
+
+			return nil
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateBrowserSettingsWithContext(ctx, &workspacesweb.DisassociateBrowserSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+
+			if err != nil && !tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+				return fmt.Errorf("disassociating Browser Settings: %w", err)
+			}
+
+			return nil
+		},
+	},
+	{
+		attribute: "network_settings_arn",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateNetworkSettingsWithContext(ctx, &workspacesweb.AssociateNetworkSettingsInput{
+				NetworkSettingsArn: aws.String(arn),
+				PortalArn:          aws.String(portalARN),
+			})
+
+			if err != nil {
+				return fmt.Errorf("associating Network Settings (%s): %w", arn, err)
+			}
+
+			return nil
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateNetworkSettingsWithContext(ctx, &workspacesweb.DisassociateNetworkSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+
+			if err != nil && !tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+				return fmt.Errorf("disassociating Network Settings: %w", err)
+			}
+
+			return nil
+		},
+	},
+	{
+		attribute: "trust_store_arn",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateTrustStoreWithContext(ctx, &workspacesweb.AssociateTrustStoreInput{
+				PortalArn:     aws.String(portalARN),
+				TrustStoreArn: aws.String(arn),
+			})
+
+			if err != nil {
+				return fmt.Errorf("associating Trust Store (%s): %w", arn, err)
+			}
+
+			return nil
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateTrustStoreWithContext(ctx, &workspacesweb.DisassociateTrustStoreInput{
+				PortalArn: aws.String(portalARN),
+			})
+
+			if err != nil && !tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+				return fmt.Errorf("disassociating Trust Store: %w", err)
+			}
+
+			return nil
+		},
+	},
+	{
+		attribute: "user_settings_arn",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateUserSettingsWithContext(ctx, &workspacesweb.AssociateUserSettingsInput{
+				PortalArn:       aws.String(portalARN),
+				UserSettingsArn: aws.String(arn),
+			})
+
+			if err != nil {
+				return fmt.Errorf("associating User Settings (%s): %w", arn, err)
+			}
+
+			return nil
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateUserSettingsWithContext(ctx, &workspacesweb.DisassociateUserSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+
+			if err != nil && !tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+				return fmt.Errorf("disassociating User Settings: %w", err)
+			}
+
+			return nil
+		},
+	},
+}
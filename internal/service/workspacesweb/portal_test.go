@@ -0,0 +1,284 @@
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebPortal_basic(t *testing.T) {
+	resourceName := "aws_workspacesweb_portal.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "workspaces-web", regexp.MustCompile(`portal/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "browser_settings_arn", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "portal_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "portal_status", workspacesweb.PortalStatusIncomplete),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPortalConfig_basic(rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_disappears(t *testing.T) {
+	resourceName := "aws_workspacesweb_portal.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourcePortal(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_associations(t *testing.T) {
+	resourceName := "aws_workspacesweb_portal.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_associations(rName, "aws_workspacesweb_user_settings.test1.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "browser_settings_arn", "aws_workspacesweb_browser_settings.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_settings_arn", "aws_workspacesweb_network_settings.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_settings_arn", "aws_workspacesweb_user_settings.test1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPortalConfig_associations(rName, "aws_workspacesweb_user_settings.test2.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "user_settings_arn", "aws_workspacesweb_user_settings.test2", "arn"),
+				),
+			},
+			{
+				Config: testAccPortalConfig_associations(rName, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_settings_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_tags(t *testing.T) {
+	resourceName := "aws_workspacesweb_portal.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPortalConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPortalConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPortalDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_portal" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindPortalByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Portal %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPortalExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Portal ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		_, err := tfworkspacesweb.FindPortalByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPortalConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+}
+`, rName)
+}
+
+func testAccPortalConfig_associations(rName, userSettingsARN string) string {
+	return acctest.ConfigCompose(
+		testAccNetworkSettingsConfig_basic(rName, 1),
+		testAccBrowserSettingsConfig_basic("chrome://settings"),
+		fmt.Sprintf(`
+resource "aws_workspacesweb_user_settings" "test1" {
+  copy_allowed     = "Enabled"
+  download_allowed = "Enabled"
+  paste_allowed    = "Enabled"
+  print_allowed    = "Enabled"
+  upload_allowed   = "Enabled"
+}
+
+resource "aws_workspacesweb_user_settings" "test2" {
+  copy_allowed     = "Disabled"
+  download_allowed = "Disabled"
+  paste_allowed    = "Disabled"
+  print_allowed    = "Disabled"
+  upload_allowed   = "Disabled"
+}
+
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+
+  browser_settings_arn = aws_workspacesweb_browser_settings.test.arn
+  network_settings_arn = aws_workspacesweb_network_settings.test.arn
+  user_settings_arn    = %[2]s
+}
+`, rName, userSettingsARN))
+}
+
+func testAccPortalConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccPortalConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,285 @@
+package workspacesweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrustStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrustStoreCreate,
+		ReadWithoutTimeout:   resourceTrustStoreRead,
+		UpdateWithoutTimeout: resourceTrustStoreUpdate,
+		DeleteWithoutTimeout: resourceTrustStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"certificate": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_valid_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_valid_before": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thumbprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"certificate_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTrustStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &workspacesweb.CreateTrustStoreInput{
+		CertificateList: expandCertificateList(d.Get("certificate_list").(*schema.Set).List()),
+		ClientToken:     aws.String(resource.UniqueId()),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateTrustStoreWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkSpaces Web Trust Store: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TrustStoreArn))
+
+	return resourceTrustStoreRead(ctx, d, meta)
+}
+
+func resourceTrustStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	trustStore, err := FindTrustStoreByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Trust Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+	}
+
+	certificates, err := FindTrustStoreCertificatesByARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web Trust Store (%s) certificates: %s", d.Id(), err)
+	}
+
+	d.Set("arn", trustStore.TrustStoreArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(trustStore.AssociatedPortalArns))
+	if err := d.Set("certificate", flattenCertificates(certificates)); err != nil {
+		return diag.Errorf("setting certificate: %s", err)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTrustStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChange("certificate_list") {
+		o, n := d.GetChange("certificate_list")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		input := &workspacesweb.UpdateTrustStoreInput{
+			ClientToken:   aws.String(resource.UniqueId()),
+			TrustStoreArn: aws.String(d.Id()),
+		}
+
+		if v := ns.Difference(os).List(); len(v) > 0 {
+			input.CertificatesToAdd = expandCertificateList(v)
+		}
+
+		if v := os.Difference(ns).List(); len(v) > 0 {
+			certificates, err := FindTrustStoreCertificatesByARN(ctx, conn, d.Id())
+
+			if err != nil {
+				return diag.Errorf("reading WorkSpaces Web Trust Store (%s) certificates: %s", d.Id(), err)
+			}
+
+			// Certificates are removed by thumbprint, so match the removed
+			// PEM blocks against the certificates currently in the store.
+			for _, tfRaw := range v {
+				for _, certificate := range certificates {
+					if certificateBodyEqual(tfRaw.(string), certificate.Body) {
+						input.CertificatesToDelete = append(input.CertificatesToDelete, certificate.Thumbprint)
+					}
+				}
+			}
+		}
+
+		_, err := conn.UpdateTrustStoreWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating WorkSpaces Web Trust Store (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTrustStoreRead(ctx, d, meta)
+}
+
+func resourceTrustStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[INFO] Deleting WorkSpaces Web Trust Store: %s", d.Id())
+	_, err := conn.DeleteTrustStoreWithContext(ctx, &workspacesweb.DeleteTrustStoreInput{
+		TrustStoreArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCertificateList(tfList []interface{}) [][]byte {
+	var apiObjects [][]byte
+
+	for _, tfRaw := range tfList {
+		if v, ok := tfRaw.(string); ok && v != "" {
+			apiObjects = append(apiObjects, []byte(v))
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenCertificates(apiObjects []*workspacesweb.Certificate) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"issuer":     aws.StringValue(apiObject.Issuer),
+			"subject":    aws.StringValue(apiObject.Subject),
+			"thumbprint": aws.StringValue(apiObject.Thumbprint),
+		}
+
+		if v := apiObject.NotValidAfter; v != nil {
+			tfMap["not_valid_after"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.NotValidBefore; v != nil {
+			tfMap["not_valid_before"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// certificateBodyEqual reports whether a PEM encoded certificate from
+// configuration matches a certificate body returned by the API, which may be
+// either PEM or DER encoded.
+func certificateBodyEqual(tfPEM string, body []byte) bool {
+	tfBlock, _ := pem.Decode([]byte(tfPEM))
+
+	if tfBlock == nil {
+		return false
+	}
+
+	if apiBlock, _ := pem.Decode(body); apiBlock != nil {
+		return bytes.Equal(tfBlock.Bytes, apiBlock.Bytes)
+	}
+
+	return bytes.Equal(tfBlock.Bytes, body)
+}
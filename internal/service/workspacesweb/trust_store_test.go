@@ -0,0 +1,108 @@
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebTrustStore_basic(t *testing.T) {
+	resourceName := "aws_workspacesweb_trust_store.test"
+	certificate1 := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, acctest.TLSRSAPrivateKeyPEM(t, 2048))
+	certificate2 := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, acctest.TLSRSAPrivateKeyPEM(t, 2048))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_basic(acctest.TLSPEMEscapeNewlines(certificate1)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate.0.thumbprint"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_list.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate_list"},
+			},
+			{
+				Config: testAccTrustStoreConfig_basic(acctest.TLSPEMEscapeNewlines(certificate2)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_list.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTrustStoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_trust_store" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindTrustStoreByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Trust Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTrustStoreExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Trust Store ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		_, err := tfworkspacesweb.FindTrustStoreByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccTrustStoreConfig_basic(certificate string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_trust_store" "test" {
+  certificate_list = ["%[1]s"]
+}
+`, certificate)
+}
@@ -0,0 +1,227 @@
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUserSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserSettingsCreate,
+		ReadWithoutTimeout:   resourceUserSettingsRead,
+		UpdateWithoutTimeout: resourceUserSettingsUpdate,
+		DeleteWithoutTimeout: resourceUserSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"copy_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"disconnect_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 600),
+			},
+			"download_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"idle_disconnect_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 60),
+			},
+			"paste_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"print_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"upload_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceUserSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &workspacesweb.CreateUserSettingsInput{
+		ClientToken:     aws.String(resource.UniqueId()),
+		CopyAllowed:     aws.String(d.Get("copy_allowed").(string)),
+		DownloadAllowed: aws.String(d.Get("download_allowed").(string)),
+		PasteAllowed:    aws.String(d.Get("paste_allowed").(string)),
+		PrintAllowed:    aws.String(d.Get("print_allowed").(string)),
+		UploadAllowed:   aws.String(d.Get("upload_allowed").(string)),
+	}
+
+	if v, ok := d.GetOk("disconnect_timeout_in_minutes"); ok {
+		input.DisconnectTimeoutInMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("idle_disconnect_timeout_in_minutes"); ok {
+		input.IdleDisconnectTimeoutInMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateUserSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkSpaces Web User Settings: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.UserSettingsArn))
+
+	return resourceUserSettingsRead(ctx, d, meta)
+}
+
+func resourceUserSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	settings, err := FindUserSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web User Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", settings.UserSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(settings.AssociatedPortalArns))
+	d.Set("copy_allowed", settings.CopyAllowed)
+	d.Set("disconnect_timeout_in_minutes", settings.DisconnectTimeoutInMinutes)
+	d.Set("download_allowed", settings.DownloadAllowed)
+	d.Set("idle_disconnect_timeout_in_minutes", settings.IdleDisconnectTimeoutInMinutes)
+	d.Set("paste_allowed", settings.PasteAllowed)
+	d.Set("print_allowed", settings.PrintAllowed)
+	d.Set("upload_allowed", settings.UploadAllowed)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceUserSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &workspacesweb.UpdateUserSettingsInput{
+			ClientToken:     aws.String(resource.UniqueId()),
+			CopyAllowed:     aws.String(d.Get("copy_allowed").(string)),
+			DownloadAllowed: aws.String(d.Get("download_allowed").(string)),
+			PasteAllowed:    aws.String(d.Get("paste_allowed").(string)),
+			PrintAllowed:    aws.String(d.Get("print_allowed").(string)),
+			UploadAllowed:   aws.String(d.Get("upload_allowed").(string)),
+			UserSettingsArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("disconnect_timeout_in_minutes") {
+			input.DisconnectTimeoutInMinutes = aws.Int64(int64(d.Get("disconnect_timeout_in_minutes").(int)))
+		}
+
+		if d.HasChange("idle_disconnect_timeout_in_minutes") {
+			input.IdleDisconnectTimeoutInMinutes = aws.Int64(int64(d.Get("idle_disconnect_timeout_in_minutes").(int)))
+		}
+
+		_, err := conn.UpdateUserSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating WorkSpaces Web User Settings (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceUserSettingsRead(ctx, d, meta)
+}
+
+func resourceUserSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[INFO] Deleting WorkSpaces Web User Settings: %s", d.Id())
+	_, err := conn.DeleteUserSettingsWithContext(ctx, &workspacesweb.DeleteUserSettingsInput{
+		UserSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
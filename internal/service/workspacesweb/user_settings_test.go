@@ -0,0 +1,137 @@
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebUserSettings_basic(t *testing.T) {
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic(workspacesweb.EnabledTypeEnabled, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserSettingsExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "copy_allowed", workspacesweb.EnabledTypeEnabled),
+					resource.TestCheckResourceAttr(resourceName, "disconnect_timeout_in_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, "download_allowed", workspacesweb.EnabledTypeDisabled),
+					resource.TestCheckResourceAttr(resourceName, "paste_allowed", workspacesweb.EnabledTypeEnabled),
+					resource.TestCheckResourceAttr(resourceName, "print_allowed", workspacesweb.EnabledTypeDisabled),
+					resource.TestCheckResourceAttr(resourceName, "upload_allowed", workspacesweb.EnabledTypeDisabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserSettingsConfig_basic(workspacesweb.EnabledTypeDisabled, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserSettingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "copy_allowed", workspacesweb.EnabledTypeDisabled),
+					resource.TestCheckResourceAttr(resourceName, "disconnect_timeout_in_minutes", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserSettings_disappears(t *testing.T) {
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic(workspacesweb.EnabledTypeEnabled, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserSettingsExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourceUserSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUserSettingsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_user_settings" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindUserSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web User Settings %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckUserSettingsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web User Settings ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		_, err := tfworkspacesweb.FindUserSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccUserSettingsConfig_basic(copyAllowed string, disconnectTimeout int) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_user_settings" "test" {
+  copy_allowed                  = %[1]q
+  disconnect_timeout_in_minutes = %[2]d
+  download_allowed              = "Disabled"
+  paste_allowed                 = "Enabled"
+  print_allowed                 = "Disabled"
+  upload_allowed                = "Disabled"
+}
+`, copyAllowed, disconnectTimeout)
+}
@@ -31,7 +31,7 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 		return true
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := PoliciesAreEquivalent(old, new)
 	if err != nil {
 		return false
 	}
@@ -39,6 +39,106 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 	return equivalent
 }
 
+// PoliciesAreEquivalent tests for the semantic equivalence of two AWS IAM policies.
+// In addition to the structural equivalence checks (element ordering, single element arrays, account ID principals)
+// of the awspolicyequivalence package, both policies are first normalized with NormalizePolicyDocument.
+func PoliciesAreEquivalent(policy1, policy2 string) (bool, error) {
+	return awspolicy.PoliciesAreEquivalent(NormalizePolicyDocument(policy1), NormalizePolicyDocument(policy2))
+}
+
+// policyStatementStringSetKeys are the policy statement elements whose values are sets of strings.
+var policyStatementStringSetKeys = []string{
+	"Action",
+	"NotAction",
+	"NotResource",
+	"Resource",
+}
+
+// NormalizePolicyDocument returns an AWS IAM policy document with forms that AWS treats as equivalent rewritten to a single form:
+//   - A wildcard principal ("*") is rewritten as {"AWS": "*"}
+//   - Duplicate values in actions, resources and principals are removed
+//
+// The policy is returned unchanged if it is not a JSON object.
+func NormalizePolicyDocument(policy string) string {
+	var document map[string]interface{}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return policy
+	}
+
+	var statements []interface{}
+
+	switch v := document["Statement"].(type) {
+	case []interface{}:
+		statements = v
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	}
+
+	for _, v := range statements {
+		statement, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, key := range policyStatementStringSetKeys {
+			if v, ok := statement[key]; ok {
+				statement[key] = uniquePolicyStrings(v)
+			}
+		}
+
+		for _, key := range []string{"NotPrincipal", "Principal"} {
+			switch v := statement[key].(type) {
+			case string:
+				if v == "*" {
+					statement[key] = map[string]interface{}{"AWS": "*"}
+				}
+			case map[string]interface{}:
+				for principalType, principals := range v {
+					v[principalType] = uniquePolicyStrings(principals)
+				}
+			}
+		}
+	}
+
+	b, err := json.Marshal(document)
+
+	if err != nil {
+		return policy
+	}
+
+	return string(b)
+}
+
+// uniquePolicyStrings removes duplicate strings from a policy element's list of values.
+// Any other value is returned unchanged.
+func uniquePolicyStrings(v interface{}) interface{} {
+	values, ok := v.([]interface{})
+
+	if !ok {
+		return v
+	}
+
+	seen := make(map[interface{}]struct{}, len(values))
+	var unique []interface{}
+
+	for _, value := range values {
+		if _, ok := value.(string); !ok {
+			return v
+		}
+
+		if _, ok := seen[value]; ok {
+			continue
+		}
+
+		seen[value] = struct{}{}
+		unique = append(unique, value)
+	}
+
+	return unique
+}
+
 func SuppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
 	return JSONStringsEqual(old, new)
 }
@@ -116,7 +216,7 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 		return new, nil
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := PoliciesAreEquivalent(old, new)
 
 	if err != nil {
 		return "", err
@@ -599,3 +599,71 @@ Outputs:
 		}
 	}
 }
+
+func TestPoliciesAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		description string
+		policy1     string
+		policy2     string
+		equivalent  bool
+	}{
+		{
+			description: "reordered actions",
+			policy1:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			policy2:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			equivalent:  true,
+		},
+		{
+			description: "wildcard principal",
+			policy1:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}]}`,
+			policy2:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+			equivalent:  true,
+		},
+		{
+			description: "wildcard not principal",
+			policy1:     `{"Version":"2012-10-17","Statement":{"Effect":"Deny","NotPrincipal":"*","Action":"sqs:SendMessage","Resource":"*"}}`,
+			policy2:     `{"Version":"2012-10-17","Statement":{"Effect":"Deny","NotPrincipal":{"AWS":["*"]},"Action":"sqs:SendMessage","Resource":"*"}}`,
+			equivalent:  true,
+		},
+		{
+			description: "account ID principal",
+			policy1:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"kms:*","Resource":"*"}]}`,
+			policy2:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`,
+			equivalent:  true,
+		},
+		{
+			description: "duplicate values",
+			policy1:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::123456789012:root"]},"Action":["ecr:BatchGetImage","ecr:BatchGetImage"],"Resource":"*"}]}`,
+			policy2:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"ecr:BatchGetImage","Resource":"*"}]}`,
+			equivalent:  true,
+		},
+		{
+			description: "service principal is not a wildcard",
+			policy1:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sns:Publish","Resource":"*"}]}`,
+			policy2:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"*"},"Action":"sns:Publish","Resource":"*"}]}`,
+			equivalent:  false,
+		},
+		{
+			description: "different actions",
+			policy1:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"secretsmanager:GetSecretValue","Resource":"*"}]}`,
+			policy2:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"secretsmanager:*","Resource":"*"}]}`,
+			equivalent:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		equivalent, err := PoliciesAreEquivalent(tc.policy1, tc.policy2)
+
+		if err != nil {
+			t.Fatalf("unexpected error for test case (%s): %s", tc.description, err)
+		}
+
+		if tc.equivalent && !equivalent {
+			t.Errorf("expected test case (%s) to be equivalent", tc.description)
+		}
+
+		if !tc.equivalent && equivalent {
+			t.Errorf("expected test case (%s) to not be equivalent", tc.description)
+		}
+	}
+}
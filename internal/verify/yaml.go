@@ -0,0 +1,28 @@
+package verify
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+// SuppressEquivalentYAMLDiffs suppresses differences between YAML documents that
+// decode to the same value, e.g. changes in indentation, quoting or mapping key order.
+func SuppressEquivalentYAMLDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return YAMLStringsEqual(old, new)
+}
+
+func YAMLStringsEqual(s1, s2 string) bool {
+	var v1, v2 interface{}
+
+	if err := yaml.Unmarshal([]byte(s1), &v1); err != nil {
+		return false
+	}
+
+	if err := yaml.Unmarshal([]byte(s2), &v2); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
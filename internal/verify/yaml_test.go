@@ -0,0 +1,74 @@
+package verify
+
+import (
+	"testing"
+)
+
+func TestYAMLStringsEqual(t *testing.T) {
+	testCases := []struct {
+		description string
+		equivalent  bool
+		s1          string
+		s2          string
+	}{
+		{
+			description: "empty",
+			equivalent:  true,
+			s1:          "",
+			s2:          "",
+		},
+		{
+			description: "no change",
+			equivalent:  true,
+			s1:          "groups:\n  - name: test\n    rules:\n      - record: metric:recording_rule\n        expr: avg(rate(container_cpu_usage_seconds_total[5m]))\n",
+			s2:          "groups:\n  - name: test\n    rules:\n      - record: metric:recording_rule\n        expr: avg(rate(container_cpu_usage_seconds_total[5m]))\n",
+		},
+		{
+			description: "indentation",
+			equivalent:  true,
+			s1:          "groups:\n  - name: test\n    rules:\n      - record: metric:recording_rule\n        expr: up\n",
+			s2:          "groups:\n- name: test\n  rules:\n  - record: metric:recording_rule\n    expr: up\n",
+		},
+		{
+			description: "quoting and key order",
+			equivalent:  true,
+			s1:          "groups:\n  - name: test\n    rules:\n      - record: metric:recording_rule\n        expr: up\n",
+			s2:          "groups:\n  - rules:\n      - expr: \"up\"\n        record: 'metric:recording_rule'\n    name: test\n",
+		},
+		{
+			description: "trailing whitespace and comments",
+			equivalent:  true,
+			s1:          "groups:\n  - name: test\n    rules: []\n",
+			s2:          "# rules\ngroups:   \n  - name: test\n    rules: []\n\n\n",
+		},
+		{
+			description: "value change",
+			equivalent:  false,
+			s1:          "groups:\n  - name: test\n    rules:\n      - record: metric:recording_rule\n        expr: up\n",
+			s2:          "groups:\n  - name: test\n    rules:\n      - record: metric:recording_rule\n        expr: down\n",
+		},
+		{
+			description: "list order",
+			equivalent:  false,
+			s1:          "a:\n  - 1\n  - 2\n",
+			s2:          "a:\n  - 2\n  - 1\n",
+		},
+		{
+			description: "invalid",
+			equivalent:  false,
+			s1:          "abc: [",
+			s2:          "abc: [",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := YAMLStringsEqual(tc.s1, tc.s2), tc.equivalent; got != want {
+				t.Errorf("YAMLStringsEqual(%q, %q) = %t, want %t", tc.s1, tc.s2, got, want)
+			}
+		})
+	}
+}
@@ -178,6 +178,7 @@ const (
 	LookoutEquipment             = "lookoutequipment"
 	LookoutMetrics               = "lookoutmetrics"
 	LookoutVision                = "lookoutvision"
+	M2                           = "m2"
 	MQ                           = "mq"
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"
@@ -208,6 +209,7 @@ const (
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
 	Nimble                       = "nimble"
+	ObservabilityAccessManager   = "oam"
 	OpenSearch                   = "opensearch"
 	OpenSearchServerless         = "opensearchserverless"
 	OpsWorks                     = "opsworks"
@@ -222,6 +224,7 @@ const (
 	Pinpoint                     = "pinpoint"
 	PinpointEmail                = "pinpointemail"
 	PinpointSMSVoice             = "pinpointsmsvoice"
+	PinpointSMSVoiceV2           = "pinpointsmsvoicev2"
 	Pipes                        = "pipes"
 	Polly                        = "polly"
 	Pricing                      = "pricing"
@@ -277,6 +280,7 @@ const (
 	Schemas                      = "schemas"
 	SecretsManager               = "secretsmanager"
 	SecurityHub                  = "securityhub"
+	SecurityLake                 = "securitylake"
 	ServerlessRepo               = "serverlessrepo"
 	ServiceCatalog               = "servicecatalog"
 	ServiceCatalogAppRegistry    = "servicecatalogappregistry"
@@ -289,6 +293,7 @@ const (
 	Snowball                     = "snowball"
 	StorageGateway               = "storagegateway"
 	Support                      = "support"
+	SupportApp                   = "supportapp"
 	Synthetics                   = "synthetics"
 	Textract                     = "textract"
 	TimestreamQuery              = "timestreamquery"